@@ -14,6 +14,18 @@ func (this apiService) CloseSession(ctx context.Context, req interface{}) (inter
 	return this.gamesvc.CloseSession(ctx, req.(*gamepb.CloseSessionRequest))
 }
 
+func (this apiService) ListSessions(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.ListSessions(ctx, req.(*gamepb.ListSessionsRequest))
+}
+
+func (this apiService) TerminateSession(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.TerminateSession(ctx, req.(*gamepb.TerminateSessionRequest))
+}
+
+func (this apiService) TakeSeatControl(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.TakeSeatControl(ctx, req.(*gamepb.TakeSeatControlRequest))
+}
+
 func (this apiService) ChangePassword(ctx context.Context, req interface{}) (interface{}, error) {
 	return this.gamesvc.ChangePassword(ctx, req.(*gamepb.ChangePasswordRequest))
 }
@@ -26,6 +38,14 @@ func (this apiService) GetOpenTables(ctx context.Context, req interface{}) (inte
 	return this.gamesvc.GetOpenTables(ctx, req.(*gamepb.GetOpenTablesRequest))
 }
 
+func (this apiService) SubscribeLobby(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.SubscribeLobby(ctx, req.(*gamepb.SubscribeLobbyRequest))
+}
+
+func (this apiService) UnsubscribeLobby(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.UnsubscribeLobby(ctx, req.(*gamepb.UnsubscribeLobbyRequest))
+}
+
 func (this apiService) JoinTable(ctx context.Context, req interface{}) (interface{}, error) {
 	return this.gamesvc.JoinTable(ctx, req.(*gamepb.JoinTableRequest))
 }
@@ -41,3 +61,43 @@ func (this apiService) Ready(ctx context.Context, req interface{}) (interface{},
 func (this apiService) MakeMove(ctx context.Context, req interface{}) (interface{}, error) {
 	return this.gamesvc.MakeMove(ctx, req.(*gamepb.MakeMoveRequest))
 }
+
+func (this apiService) LeaveTable(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.LeaveTable(ctx, req.(*gamepb.LeaveTableRequest))
+}
+
+func (this apiService) StandUp(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.StandUp(ctx, req.(*gamepb.StandUpRequest))
+}
+
+func (this apiService) KickParticipant(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.KickParticipant(ctx, req.(*gamepb.KickParticipantRequest))
+}
+
+func (this apiService) LockSeat(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.LockSeat(ctx, req.(*gamepb.LockSeatRequest))
+}
+
+func (this apiService) CloseTable(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.CloseTable(ctx, req.(*gamepb.CloseTableRequest))
+}
+
+func (this apiService) MoveToSeat(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.MoveToSeat(ctx, req.(*gamepb.MoveToSeatRequest))
+}
+
+func (this apiService) RequestSeatSwap(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.RequestSeatSwap(ctx, req.(*gamepb.RequestSeatSwapRequest))
+}
+
+func (this apiService) AcceptSeatSwap(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.AcceptSeatSwap(ctx, req.(*gamepb.AcceptSeatSwapRequest))
+}
+
+func (this apiService) Rematch(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.Rematch(ctx, req.(*gamepb.RematchRequest))
+}
+
+func (this apiService) GetTableEvents(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.GetTableEvents(ctx, req.(*gamepb.GetTableEventsRequest))
+}
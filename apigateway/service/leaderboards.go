@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+
+	gamepb "github.com/Handzo/gogame/gameservice/proto"
+)
+
+func (this apiService) GetLeaderboard(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.GetLeaderboard(ctx, req.(*gamepb.GetLeaderboardRequest))
+}
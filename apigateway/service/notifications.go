@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+
+	gamepb "github.com/Handzo/gogame/gameservice/proto"
+)
+
+func (this apiService) GetNotifications(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.GetNotifications(ctx, req.(*gamepb.GetNotificationsRequest))
+}
+
+func (this apiService) MarkRead(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.MarkRead(ctx, req.(*gamepb.MarkReadRequest))
+}
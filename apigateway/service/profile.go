@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+
+	gamepb "github.com/Handzo/gogame/gameservice/proto"
+)
+
+func (this apiService) UpdateProfile(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.UpdateProfile(ctx, req.(*gamepb.UpdateProfileRequest))
+}
+
+func (this apiService) SetAvatar(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.SetAvatar(ctx, req.(*gamepb.SetAvatarRequest))
+}
+
+func (this apiService) GetPlayerProfile(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.GetPlayerProfile(ctx, req.(*gamepb.GetPlayerProfileRequest))
+}
@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+
+	gamepb "github.com/Handzo/gogame/gameservice/proto"
+)
+
+func (this apiService) ClaimDailyReward(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.ClaimDailyReward(ctx, req.(*gamepb.ClaimDailyRewardRequest))
+}
+
+func (this apiService) GetQuests(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.GetQuests(ctx, req.(*gamepb.GetQuestsRequest))
+}
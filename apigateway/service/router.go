@@ -17,6 +17,12 @@ type MsgInfo struct {
 
 type grpcHandler func(context.Context, interface{}) (interface{}, error)
 
+// validator is implemented by payloads which check their fields
+// before being passed to handler.
+type validator interface {
+	Validate() error
+}
+
 type GRPCRouter struct {
 	routes map[string]*MsgInfo
 }
@@ -57,6 +63,12 @@ func (this *GRPCRouter) Route(ctx context.Context, req *apipb.Request) (*apipb.R
 		return nil, code.InvalidRequestPayloadError
 	}
 
+	if v, ok := reqPayload.(validator); ok {
+		if err = v.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	response, err := i.reqHandler(ctx, reqPayload)
 	if err != nil {
 		return nil, err
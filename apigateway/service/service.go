@@ -24,19 +24,74 @@ func NewApiService(authsvc authpb.AuthServiceClient, gamesvc gamepb.GameServiceC
 
 	svc.router.Register("OpenSession", &gamepb.OpenSessionRequest{}, svc.OpenSession)
 	svc.router.Register("CloseSession", &gamepb.CloseSessionRequest{}, svc.CloseSession)
+	svc.router.Register("ListSessions", &gamepb.ListSessionsRequest{}, svc.ListSessions)
+	svc.router.Register("TerminateSession", &gamepb.TerminateSessionRequest{}, svc.TerminateSession)
+	svc.router.Register("TakeSeatControl", &gamepb.TakeSeatControlRequest{}, svc.TakeSeatControl)
 	svc.router.Register("ChangePassword", &gamepb.ChangePasswordRequest{}, svc.ChangePassword)
 
+	// profile
+	svc.router.Register("UpdateProfile", &gamepb.UpdateProfileRequest{}, svc.UpdateProfile)
+	svc.router.Register("SetAvatar", &gamepb.SetAvatarRequest{}, svc.SetAvatar)
+	svc.router.Register("GetPlayerProfile", &gamepb.GetPlayerProfileRequest{}, svc.GetPlayerProfile)
+
+	// social
+	svc.router.Register("GetFriends", &gamepb.GetFriendsRequest{}, svc.GetFriends)
+	svc.router.Register("AddFriend", &gamepb.AddFriendRequest{}, svc.AddFriend)
+	svc.router.Register("AcceptFriend", &gamepb.AcceptFriendRequest{}, svc.AcceptFriend)
+	svc.router.Register("RemoveFriend", &gamepb.RemoveFriendRequest{}, svc.RemoveFriend)
+	svc.router.Register("BlockPlayer", &gamepb.BlockPlayerRequest{}, svc.BlockPlayer)
+	svc.router.Register("InviteToTable", &gamepb.InviteToTableRequest{}, svc.InviteToTable)
+	svc.router.Register("AcceptInvitation", &gamepb.AcceptInvitationRequest{}, svc.AcceptInvitation)
+
+	// leaderboards
+	svc.router.Register("GetLeaderboard", &gamepb.GetLeaderboardRequest{}, svc.GetLeaderboard)
+
+	// statistics
+	svc.router.Register("GetMatchHistory", &gamepb.GetMatchHistoryRequest{}, svc.GetMatchHistory)
+	svc.router.Register("GetPlayerStats", &gamepb.GetPlayerStatsRequest{}, svc.GetPlayerStats)
+	svc.router.Register("GetAchievements", &gamepb.GetAchievementsRequest{}, svc.GetAchievements)
+
+	// rewards
+	svc.router.Register("ClaimDailyReward", &gamepb.ClaimDailyRewardRequest{}, svc.ClaimDailyReward)
+	svc.router.Register("GetQuests", &gamepb.GetQuestsRequest{}, svc.GetQuests)
+
+	// tournaments
+	svc.router.Register("GetTournaments", &gamepb.GetTournamentsRequest{}, svc.GetTournaments)
+	svc.router.Register("GetTournament", &gamepb.GetTournamentRequest{}, svc.GetTournament)
+	svc.router.Register("RegisterTournament", &gamepb.RegisterTournamentRequest{}, svc.RegisterTournament)
+	svc.router.Register("UnregisterTournament", &gamepb.UnregisterTournamentRequest{}, svc.UnregisterTournament)
+
+	// notifications
+	svc.router.Register("GetNotifications", &gamepb.GetNotificationsRequest{}, svc.GetNotifications)
+	svc.router.Register("MarkRead", &gamepb.MarkReadRequest{}, svc.MarkRead)
+
 	// shop
 
 	svc.router.Register("GetProducts", &gamepb.GetProductsRequest{}, svc.GetProducts)
+	svc.router.Register("PurchaseProduct", &gamepb.PurchaseProductRequest{}, svc.PurchaseProduct)
+	svc.router.Register("CreateCheckout", &gamepb.CreateCheckoutRequest{}, svc.CreateCheckout)
+	svc.router.Register("VerifyReceipt", &gamepb.VerifyReceiptRequest{}, svc.VerifyReceipt)
+	svc.router.Register("GetInventory", &gamepb.GetInventoryRequest{}, svc.GetInventory)
 
 	// table handlers
 	svc.router.Register("CreateTable", &gamepb.CreateTableRequest{}, svc.CreateTable)
 	svc.router.Register("GetOpenTables", &gamepb.GetOpenTablesRequest{}, svc.GetOpenTables)
+	svc.router.Register("SubscribeLobby", &gamepb.SubscribeLobbyRequest{}, svc.SubscribeLobby)
+	svc.router.Register("UnsubscribeLobby", &gamepb.UnsubscribeLobbyRequest{}, svc.UnsubscribeLobby)
 	svc.router.Register("JoinTable", &gamepb.JoinTableRequest{}, svc.JoinTable)
 	svc.router.Register("BecomeParticipant", &gamepb.BecomeParticipantRequest{}, svc.BecomeParticipant)
 	svc.router.Register("Ready", &gamepb.ReadyRequest{}, svc.Ready)
 	svc.router.Register("MakeMove", &gamepb.MakeMoveRequest{}, svc.MakeMove)
+	svc.router.Register("LeaveTable", &gamepb.LeaveTableRequest{}, svc.LeaveTable)
+	svc.router.Register("StandUp", &gamepb.StandUpRequest{}, svc.StandUp)
+	svc.router.Register("KickParticipant", &gamepb.KickParticipantRequest{}, svc.KickParticipant)
+	svc.router.Register("LockSeat", &gamepb.LockSeatRequest{}, svc.LockSeat)
+	svc.router.Register("CloseTable", &gamepb.CloseTableRequest{}, svc.CloseTable)
+	svc.router.Register("MoveToSeat", &gamepb.MoveToSeatRequest{}, svc.MoveToSeat)
+	svc.router.Register("RequestSeatSwap", &gamepb.RequestSeatSwapRequest{}, svc.RequestSeatSwap)
+	svc.router.Register("AcceptSeatSwap", &gamepb.AcceptSeatSwapRequest{}, svc.AcceptSeatSwap)
+	svc.router.Register("Rematch", &gamepb.RematchRequest{}, svc.Rematch)
+	svc.router.Register("GetTableEvents", &gamepb.GetTableEventsRequest{}, svc.GetTableEvents)
 
 	return svc
 }
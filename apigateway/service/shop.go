@@ -9,3 +9,19 @@ import (
 func (this apiService) GetProducts(ctx context.Context, req interface{}) (interface{}, error) {
 	return this.gamesvc.GetProducts(ctx, req.(*gamepb.GetProductsRequest))
 }
+
+func (this apiService) PurchaseProduct(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.PurchaseProduct(ctx, req.(*gamepb.PurchaseProductRequest))
+}
+
+func (this apiService) CreateCheckout(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.CreateCheckout(ctx, req.(*gamepb.CreateCheckoutRequest))
+}
+
+func (this apiService) VerifyReceipt(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.VerifyReceipt(ctx, req.(*gamepb.VerifyReceiptRequest))
+}
+
+func (this apiService) GetInventory(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.GetInventory(ctx, req.(*gamepb.GetInventoryRequest))
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+
+	gamepb "github.com/Handzo/gogame/gameservice/proto"
+)
+
+func (this apiService) GetFriends(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.GetFriends(ctx, req.(*gamepb.GetFriendsRequest))
+}
+
+func (this apiService) AddFriend(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.AddFriend(ctx, req.(*gamepb.AddFriendRequest))
+}
+
+func (this apiService) AcceptFriend(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.AcceptFriend(ctx, req.(*gamepb.AcceptFriendRequest))
+}
+
+func (this apiService) RemoveFriend(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.RemoveFriend(ctx, req.(*gamepb.RemoveFriendRequest))
+}
+
+func (this apiService) BlockPlayer(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.BlockPlayer(ctx, req.(*gamepb.BlockPlayerRequest))
+}
+
+func (this apiService) InviteToTable(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.InviteToTable(ctx, req.(*gamepb.InviteToTableRequest))
+}
+
+func (this apiService) AcceptInvitation(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.AcceptInvitation(ctx, req.(*gamepb.AcceptInvitationRequest))
+}
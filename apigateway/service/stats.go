@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+
+	gamepb "github.com/Handzo/gogame/gameservice/proto"
+)
+
+func (this apiService) GetMatchHistory(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.GetMatchHistory(ctx, req.(*gamepb.GetMatchHistoryRequest))
+}
+
+func (this apiService) GetPlayerStats(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.GetPlayerStats(ctx, req.(*gamepb.GetPlayerStatsRequest))
+}
+
+func (this apiService) GetAchievements(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.GetAchievements(ctx, req.(*gamepb.GetAchievementsRequest))
+}
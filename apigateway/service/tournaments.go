@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+
+	gamepb "github.com/Handzo/gogame/gameservice/proto"
+)
+
+func (this apiService) GetTournaments(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.GetTournaments(ctx, req.(*gamepb.GetTournamentsRequest))
+}
+
+func (this apiService) GetTournament(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.GetTournament(ctx, req.(*gamepb.GetTournamentRequest))
+}
+
+func (this apiService) RegisterTournament(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.RegisterTournament(ctx, req.(*gamepb.RegisterTournamentRequest))
+}
+
+func (this apiService) UnregisterTournament(ctx context.Context, req interface{}) (interface{}, error) {
+	return this.gamesvc.UnregisterTournament(ctx, req.(*gamepb.UnregisterTournamentRequest))
+}
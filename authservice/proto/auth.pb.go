@@ -244,6 +244,7 @@ func (m *ValidateRequest) GetToken() string {
 type ValidateResponse struct {
 	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Username             string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Role                 string   `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -288,6 +289,13 @@ func (m *ValidateResponse) GetUsername() string {
 	return ""
 }
 
+func (m *ValidateResponse) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
 type GetVerificationCodeRequest struct {
 	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -548,33 +556,34 @@ func init() {
 func init() { proto.RegisterFile("proto/auth.proto", fileDescriptor_a9d38a2cdbb4f144) }
 
 var fileDescriptor_a9d38a2cdbb4f144 = []byte{
-	// 413 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x4d, 0x8f, 0xda, 0x30,
-	0x14, 0x14, 0x6c, 0x4b, 0xb7, 0x6f, 0x77, 0x59, 0xea, 0x2e, 0x10, 0x65, 0x7b, 0xe8, 0xfa, 0xd0,
-	0x8f, 0x8b, 0x51, 0xe9, 0xbd, 0x12, 0xe2, 0x80, 0x38, 0x54, 0x42, 0xa0, 0x72, 0xab, 0x90, 0x8b,
-	0x5f, 0xc1, 0x6a, 0xb0, 0xd3, 0xc4, 0x81, 0xdf, 0xdc, 0x7f, 0x51, 0xe5, 0xc3, 0x2c, 0x24, 0x26,
-	0x37, 0x3f, 0xf9, 0x79, 0x66, 0x32, 0x33, 0x0a, 0x74, 0xc2, 0x48, 0x1b, 0x3d, 0xe0, 0x89, 0xd9,
-	0xb2, 0xec, 0x48, 0x7f, 0xc2, 0xdd, 0x42, 0x6e, 0xd4, 0x8f, 0x70, 0x8e, 0x7f, 0x13, 0x8c, 0x0d,
-	0x79, 0x80, 0x97, 0xb8, 0xe3, 0x32, 0xf0, 0x1a, 0xef, 0x1b, 0x9f, 0x5e, 0xcf, 0xf3, 0x81, 0xf8,
-	0x70, 0x9d, 0xc4, 0x18, 0x29, 0xbe, 0x43, 0xaf, 0x99, 0x5d, 0x1c, 0xe7, 0xf4, 0x2e, 0xe4, 0x71,
-	0x7c, 0xd0, 0x91, 0xf0, 0xae, 0xf2, 0x3b, 0x3b, 0xd3, 0x0f, 0xd0, 0xb6, 0xf0, 0x71, 0xa8, 0x55,
-	0x8c, 0x29, 0xbe, 0xd1, 0x7f, 0x50, 0x59, 0xfc, 0x6c, 0xa0, 0x93, 0x5c, 0xc6, 0x54, 0x59, 0x19,
-	0xa7, 0x84, 0x8d, 0x1a, 0xc2, 0xa6, 0x9b, 0x30, 0x05, 0xaa, 0x25, 0xfc, 0x08, 0xf7, 0x4b, 0x1e,
-	0x48, 0xc1, 0x0d, 0x9e, 0x7c, 0xb9, 0x53, 0x59, 0xe7, 0x79, 0xb1, 0x80, 0xec, 0xc3, 0xab, 0x54,
-	0xcc, 0x4a, 0x8a, 0x62, 0xb7, 0x95, 0x8e, 0x53, 0x51, 0x67, 0x13, 0x1d, 0x82, 0x3f, 0x41, 0xb3,
-	0xc4, 0x48, 0xfe, 0x96, 0x6b, 0x6e, 0xa4, 0x56, 0x63, 0x2d, 0xb0, 0xd6, 0x76, 0xfa, 0x05, 0x1e,
-	0x9d, 0x6f, 0x0a, 0x1d, 0x04, 0x5e, 0xac, 0xb5, 0xb0, 0x06, 0x65, 0x67, 0xfa, 0x1d, 0x1e, 0xe6,
-	0x18, 0xa3, 0x99, 0x15, 0x8e, 0x58, 0x02, 0xc7, 0x2e, 0x79, 0x82, 0x5b, 0x85, 0x87, 0x55, 0xc9,
-	0xcc, 0x1b, 0x85, 0x07, 0xfb, 0x9a, 0xf6, 0xa1, 0x5b, 0x82, 0xcb, 0xb9, 0xe9, 0x1e, 0xba, 0xe3,
-	0x2d, 0x57, 0x1b, 0x2c, 0x13, 0x5d, 0x34, 0xe7, 0x09, 0x6e, 0x75, 0x20, 0x2a, 0x6c, 0x3a, 0x10,
-	0x16, 0xa2, 0x22, 0xe8, 0xaa, 0x2a, 0xc8, 0x83, 0x5e, 0x99, 0x37, 0x57, 0x34, 0xfc, 0xd7, 0x84,
-	0x9b, 0x51, 0x62, 0xb6, 0x0b, 0x8c, 0xf6, 0x72, 0x8d, 0xe4, 0x33, 0xb4, 0xf2, 0xee, 0x91, 0x36,
-	0x3b, 0xeb, 0xb8, 0x7f, 0xcf, 0x4a, 0xa5, 0x2c, 0x56, 0xa7, 0xaa, 0x58, 0x3d, 0xf6, 0xb0, 0x58,
-	0x3d, 0xa9, 0xd3, 0x00, 0xae, 0x6d, 0x1f, 0x48, 0x87, 0x95, 0x3a, 0xe4, 0xbf, 0x61, 0x95, 0xb2,
-	0xcc, 0xe0, 0xad, 0x23, 0x43, 0xf2, 0xc8, 0x2e, 0xb7, 0xc1, 0x7f, 0xc7, 0xea, 0x62, 0xff, 0x06,
-	0x77, 0x67, 0x99, 0x90, 0x2e, 0x73, 0x45, 0xee, 0xf7, 0x98, 0x33, 0x3a, 0x32, 0x82, 0xf6, 0xb9,
-	0x85, 0xa4, 0xc7, 0x9c, 0x59, 0xfa, 0x7d, 0xe6, 0xf6, 0xfa, 0x57, 0x2b, 0xfb, 0x7b, 0x7c, 0xfd,
-	0x1f, 0x00, 0x00, 0xff, 0xff, 0x3d, 0xd4, 0x90, 0xf3, 0x51, 0x04, 0x00, 0x00,
+	// 422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
+	0x18, 0x55, 0xbb, 0x51, 0xc6, 0xb7, 0xad, 0x2b, 0x66, 0x6d, 0xa3, 0x8c, 0x03, 0xf3, 0x81, 0x1f,
+	0x17, 0x4f, 0x8c, 0x3b, 0xd2, 0xb4, 0x43, 0x95, 0x03, 0x52, 0xd5, 0x8a, 0x5e, 0x10, 0xaa, 0x4c,
+	0xfd, 0xd1, 0x5a, 0xa4, 0x76, 0x48, 0x9c, 0xf6, 0x6f, 0xe6, 0xbf, 0x40, 0xf9, 0x61, 0x68, 0x12,
+	0x37, 0xbb, 0xf9, 0x93, 0x9f, 0xbf, 0xf7, 0xf2, 0xde, 0x53, 0x60, 0x10, 0xc5, 0xda, 0xe8, 0x3b,
+	0x9e, 0x9a, 0x0d, 0xcb, 0x8f, 0xf4, 0x3b, 0x5c, 0xce, 0xe5, 0x5a, 0x7d, 0x8d, 0x66, 0xf8, 0x3b,
+	0xc5, 0xc4, 0x90, 0x6b, 0x78, 0x86, 0x5b, 0x2e, 0x43, 0xaf, 0xf3, 0xa6, 0xf3, 0xfe, 0xc5, 0xac,
+	0x18, 0x88, 0x0f, 0x67, 0x69, 0x82, 0xb1, 0xe2, 0x5b, 0xf4, 0xba, 0xf9, 0xc5, 0xbf, 0x39, 0xbb,
+	0x8b, 0x78, 0x92, 0xec, 0x75, 0x2c, 0xbc, 0x93, 0xe2, 0xce, 0xce, 0xf4, 0x2d, 0xf4, 0xed, 0xfa,
+	0x24, 0xd2, 0x2a, 0xc1, 0x6c, 0xbf, 0xd1, 0xbf, 0x50, 0xd9, 0xfd, 0xf9, 0x40, 0x27, 0x85, 0x8c,
+	0x40, 0x59, 0x19, 0x87, 0x84, 0x9d, 0x16, 0xc2, 0xae, 0x9b, 0x30, 0x50, 0x4f, 0x10, 0xbe, 0x83,
+	0xab, 0x05, 0x0f, 0xa5, 0xe0, 0x06, 0x0f, 0xbe, 0xdc, 0x01, 0xfc, 0x06, 0x83, 0xff, 0xc0, 0x72,
+	0xe5, 0x18, 0x9e, 0x67, 0x62, 0x96, 0x52, 0x94, 0xd8, 0x5e, 0x36, 0x06, 0xa2, 0xd5, 0x26, 0x02,
+	0xa7, 0xb1, 0x0e, 0xb1, 0xb4, 0x28, 0x3f, 0xd3, 0x7b, 0xf0, 0x27, 0x68, 0x16, 0x18, 0xcb, 0x9f,
+	0x72, 0xc5, 0x8d, 0xd4, 0xea, 0x51, 0x0b, 0x6c, 0x8d, 0x82, 0x7e, 0x84, 0x1b, 0xe7, 0x9b, 0x52,
+	0x1b, 0x81, 0xd3, 0x95, 0x16, 0xd6, 0xb4, 0xfc, 0x4c, 0xbf, 0xc0, 0xf5, 0x0c, 0x13, 0x34, 0xd3,
+	0xd2, 0x25, 0x4b, 0xe0, 0xc0, 0x92, 0x5b, 0xb8, 0x50, 0xb8, 0x5f, 0xd6, 0x0c, 0x3e, 0x57, 0xb8,
+	0xb7, 0xaf, 0xe9, 0x18, 0x86, 0xb5, 0x75, 0x05, 0x37, 0xdd, 0xc1, 0xf0, 0x71, 0xc3, 0xd5, 0x1a,
+	0xeb, 0x44, 0x47, 0x0d, 0xbb, 0x85, 0x0b, 0x1d, 0x8a, 0x06, 0x9b, 0x0e, 0x85, 0x5d, 0xd1, 0x10,
+	0x74, 0xd2, 0x14, 0xe4, 0xc1, 0xa8, 0xce, 0x5b, 0x28, 0xba, 0xff, 0xd3, 0x85, 0xf3, 0x87, 0xd4,
+	0x6c, 0xe6, 0x18, 0xef, 0xe4, 0x0a, 0xc9, 0x07, 0xe8, 0x15, 0x7d, 0x24, 0x7d, 0x56, 0xe9, 0xbd,
+	0x7f, 0xc5, 0x6a, 0x45, 0x2d, 0xa1, 0x81, 0x2a, 0xa1, 0x81, 0xaa, 0x42, 0x0f, 0x2a, 0x76, 0x07,
+	0x67, 0xb6, 0x23, 0x64, 0xc0, 0x6a, 0xbd, 0xf2, 0x5f, 0xb2, 0x46, 0x81, 0xa6, 0xf0, 0xca, 0x91,
+	0x21, 0xb9, 0x61, 0xc7, 0xdb, 0xe0, 0xbf, 0x66, 0x6d, 0xb1, 0x7f, 0x86, 0xcb, 0x4a, 0x26, 0x64,
+	0xc8, 0x5c, 0x91, 0xfb, 0x23, 0xe6, 0x8c, 0x8e, 0x3c, 0x40, 0xbf, 0x6a, 0x21, 0x19, 0x31, 0x67,
+	0x96, 0xfe, 0x98, 0xb9, 0xbd, 0xfe, 0xd1, 0xcb, 0xff, 0x28, 0x9f, 0xfe, 0x0e, 0x00, 0x8c, 0x0a,
+	0xb5, 0xff, 0x65, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -6,15 +6,27 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+type Role string
+
+var (
+	PLAYER Role = "player"
+	ADMIN  Role = "admin"
+)
+
 type User struct {
 	basemodel.BaseModel
 	Email    string `pg:",notnull,unique"`
 	Username string `pg:",notnull,unique"`
 	Password string `pg:",notnull"`
+	Role     Role   `pg:",notnull,type:user_role,default:'player'"`
 }
 
-func (User) Prepare(*pg.DB, bool) error {
-	return nil
+func (User) Prepare(db *pg.DB, force bool) error {
+	return basemodel.CreateEnum(
+		db, force, "user_role",
+		string(PLAYER),
+		string(ADMIN),
+	)
 }
 
 func (User) Sync(*pg.DB, bool) error {
@@ -23,10 +35,10 @@ func (User) Sync(*pg.DB, bool) error {
 
 func (User) Populate(db *pg.DB, force bool) error {
 	users := []*User{
-		&User{Email: "handzo@test.ru", Username: "Handzo", Password: "testpass"},
-		&User{Email: "h1@test.ru", Username: "h1", Password: "123"},
-		&User{Email: "h2@test.ru", Username: "h2", Password: "123"},
-		&User{Email: "h3@test.ru", Username: "h3", Password: "123"},
+		&User{Email: "handzo@test.ru", Username: "Handzo", Password: "testpass", Role: ADMIN},
+		&User{Email: "h1@test.ru", Username: "h1", Password: "123", Role: PLAYER},
+		&User{Email: "h2@test.ru", Username: "h2", Password: "123", Role: PLAYER},
+		&User{Email: "h3@test.ru", Username: "h3", Password: "123", Role: PLAYER},
 	}
 
 	for _, user := range users {
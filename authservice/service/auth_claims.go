@@ -5,5 +5,6 @@ import "github.com/dgrijalva/jwt-go"
 type AuthClaims struct {
 	UserId   string
 	Username string
+	Role     string
 	jwt.StandardClaims
 }
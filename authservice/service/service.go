@@ -46,6 +46,7 @@ func (s *authService) SignUp(ctx context.Context, req *pb.SignUpRequest) (*pb.Si
 		Email:    req.Email,
 		Username: req.Username,
 		Password: req.Password,
+		Role:     model.PLAYER,
 	}
 	if err := user.HashPassword(); err != nil {
 		return nil, err
@@ -55,7 +56,7 @@ func (s *authService) SignUp(ctx context.Context, req *pb.SignUpRequest) (*pb.Si
 		return nil, err
 	}
 
-	token, err := s.GetToken(ctx, user.Id, user.Username)
+	token, err := s.GetToken(ctx, user.Id, user.Username, string(user.Role))
 	if err != nil {
 		return nil, err
 	}
@@ -79,7 +80,7 @@ func (s *authService) SignIn(ctx context.Context, req *pb.SignInRequest) (*pb.Si
 		return nil, code.InvalidPassword
 	}
 
-	token, err := s.GetToken(ctx, user.Id, user.Username)
+	token, err := s.GetToken(ctx, user.Id, user.Username, string(user.Role))
 	if err != nil {
 		return nil, err
 	}
@@ -102,18 +103,20 @@ func (s *authService) Validate(ctx context.Context, req *pb.ValidateRequest) (*p
 		return &pb.ValidateResponse{
 			UserId:   claims.UserId,
 			Username: claims.Username,
+			Role:     claims.Role,
 		}, nil
 	}
 
 	return nil, code.InvalidToken
 }
 
-func (s *authService) GetToken(ctx context.Context, userId, username string) (string, error) {
+func (s *authService) GetToken(ctx context.Context, userId, username, role string) (string, error) {
 	expireToken := time.Now().Add(time.Hour * 1).Unix()
 
 	jwttoken := jwt.NewWithClaims(jwt.SigningMethodHS256, AuthClaims{
 		UserId:   userId,
 		Username: username,
+		Role:     role,
 		StandardClaims: jwt.StandardClaims{
 			ExpiresAt: expireToken,
 		},
@@ -0,0 +1,33 @@
+package service
+
+import "github.com/Handzo/gogame/gameengine/service/deck"
+
+// Rules below take cards in signature notation and are used to evaluate
+// stored moves outside of the engine.
+
+// Points returns points card is worth.
+func Points(card string) int {
+	return getScore(deck.GetCard(card))
+}
+
+// TrickWinner returns index of card which takes the trick.
+func TrickWinner(cards []string, trump string) int {
+	best := 0
+	for i := range cards {
+		if !stronger(deck.GetCard(cards[best]), deck.GetCard(cards[i]), deck.GetSuit(trump)) {
+			best = i
+		}
+	}
+
+	return best
+}
+
+// ValidMove reports whether card may be played from hand onto table.
+func ValidMove(table, hand, card, trump string) bool {
+	return validMove(
+		deck.New(deck.Unshuffled, deck.FromSignature(table)),
+		deck.New(deck.Unshuffled, deck.FromSignature(hand)),
+		deck.GetCard(card),
+		deck.GetSuit(trump),
+	)
+}
@@ -0,0 +1,28 @@
+package service
+
+import "testing"
+
+// cards are face and suit in hex: c3 is ace of diamonds, 90 jack of clubs
+func TestRules(t *testing.T) {
+	if Points("c3") != 11 || Points("83") != 10 || Points("53") != 0 {
+		t.Fatal("unexpected points")
+	}
+
+	// spades are trump, jack beats trump and trump beats ace
+	if w := TrickWinner([]string{"c3", "51", "93", "83"}, "1"); w != 2 {
+		t.Fatalf("expected jack to win, got %d", w)
+	}
+
+	if w := TrickWinner([]string{"53", "c3", "51", "83"}, "1"); w != 2 {
+		t.Fatalf("expected trump to win, got %d", w)
+	}
+
+	// suit of the first card must be followed
+	if ValidMove("53", "c3c2", "c2", "1") || !ValidMove("53", "c3c2", "c3", "1") {
+		t.Fatal("unexpected follow suit check")
+	}
+
+	if !ValidMove("", "c3c2", "c2", "1") || ValidMove("", "c3", "c2", "1") {
+		t.Fatal("unexpected lead check")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"flag"
 	"fmt"
 	"net"
+	"os"
 
 	authpb "github.com/Handzo/gogame/authservice/proto"
 	"github.com/Handzo/gogame/common/interceptor"
@@ -11,6 +12,8 @@ import (
 	"github.com/Handzo/gogame/common/tracing"
 	enginepb "github.com/Handzo/gogame/gameengine/proto"
 	"github.com/Handzo/gogame/gameservice/service"
+	"github.com/Handzo/gogame/gameservice/service/avatar"
+	"github.com/Handzo/gogame/gameservice/service/payment"
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
 	"github.com/uber/jaeger-lib/metrics"
 	jprom "github.com/uber/jaeger-lib/metrics/prometheus"
@@ -18,12 +21,20 @@ import (
 )
 
 var (
-	port       = flag.Int("port", 7003, "game service port")
-	authport   = flag.Int("auth", 7002, "auth service port")
-	engineport = flag.Int("engine", 7004, "game engine service port")
+	port            = flag.Int("port", 7003, "game service port")
+	authport        = flag.Int("auth", 7002, "auth service port")
+	engineport      = flag.Int("engine", 7004, "game engine service port")
+	httpport        = flag.Int("http", 7005, "payment webhook and avatars port")
+	env             = flag.String("env", "production", "deployment environment, fake payments require dev")
+	paymentProvider = flag.String("provider", "fake", "payment provider")
+	payments        = flag.String("payments", "payments.json", "fake payment provider storage file")
+	secret          = flag.String("webhook-secret", os.Getenv("PAYMENT_WEBHOOK_SECRET"), "payment webhook signing secret")
+	avatars         = flag.String("avatars", "avatars", "uploaded avatars directory")
 )
 
 func main() {
+	flag.Parse()
+
 	logger := log.NewFactory(log.NewEntry()).With(log.String("service", "game"))
 	metricsFactory := jprom.New().Namespace(metrics.NSOptions{Name: "gogame", Tags: nil})
 	tracer := tracing.New("gameservice", metricsFactory, logger)
@@ -60,8 +71,25 @@ func main() {
 		enginesvc = enginepb.NewGameEngineClient(conn)
 	}
 
+	// payment provider
+	provider, err := payment.New(payment.Config{
+		Provider: *paymentProvider,
+		Env:      *env,
+		Path:     *payments,
+	})
+	if err != nil {
+		logger.Bg().Fatal(err)
+	}
+
+	// uploaded avatars
+	storage, err := avatar.NewDiskStorage(*avatars, "/avatars/players")
+	if err != nil {
+		logger.Bg().Fatal(err)
+	}
+
 	host := net.JoinHostPort("localhost", fmt.Sprintf("%d", *port))
-	server := service.NewServer(host, authsvc, enginesvc, tracer, metricsFactory, logger)
+	httpHost := net.JoinHostPort("localhost", fmt.Sprintf("%d", *httpport))
+	server := service.NewServer(host, httpHost, service.DefaultConfig(), provider, *secret, storage, authsvc, enginesvc, tracer, metricsFactory, logger)
 
 	logger.Bg().Fatal(server.Run())
 }
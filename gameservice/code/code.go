@@ -19,4 +19,65 @@ var (
 	PlayerAlreadyParticipant  = status.Error(313, "player already at the table")
 	ParticipantIsNotFree      = status.Error(314, "player is not free")
 	ParticipantReady          = status.Error(315, "participant already ready")
+	ProductNotFound           = status.Error(316, "product not found")
+	NotEnoughFunds            = status.Error(317, "not enough funds")
+	ProductNotAvailable       = status.Error(318, "product can not be purchased with in-game currency")
+	InvalidPurchaseId         = status.Error(319, "invalid purchase id")
+	PurchaseIdConflict        = status.Error(320, "purchase id already used for another product")
+	UnknownGoodItem           = status.Error(321, "unknown good item")
+	CheckoutNotRequired       = status.Error(322, "product is purchased with in-game currency")
+	PurchaseNotFound          = status.Error(323, "purchase not found")
+	InvalidPurchaseState      = status.Error(324, "invalid purchase state")
+	PaymentNotCompleted       = status.Error(325, "payment has not been completed")
+	InvalidReceipt            = status.Error(326, "invalid receipt")
+	EntitlementRequired       = status.Error(327, "feature is not available for player")
+	InvalidProfile            = status.Error(328, "invalid profile")
+	InvalidName               = status.Error(329, "invalid name")
+	InvalidAge                = status.Error(330, "invalid age")
+	InvalidGender             = status.Error(331, "invalid gender")
+	InvalidCountry            = status.Error(332, "invalid country")
+	InvalidLanguage           = status.Error(333, "invalid language")
+	InvalidAvatar             = status.Error(334, "invalid avatar")
+	PlayerNotFound            = status.Error(335, "player not found")
+	CannotBefriendSelf        = status.Error(336, "can not add yourself as a friend")
+	PlayerBlocked             = status.Error(337, "player is blocked")
+	AlreadyFriends            = status.Error(338, "players are already friends")
+	FriendRequestNotFound     = status.Error(339, "friend request not found")
+	NotFriends                = status.Error(340, "players are not friends")
+	InvitationNotFound        = status.Error(341, "invitation not found or expired")
+	InvalidLeaderboard        = status.Error(342, "unknown leaderboard scope, window or metric")
+	CountryNotSet             = status.Error(343, "country is not set in profile")
+	RewardAlreadyClaimed      = status.Error(344, "daily reward has already been claimed")
+	NotTableCreator           = status.Error(345, "only table creator can manage the table")
+	CannotKickSelf            = status.Error(346, "can not kick yourself")
+	InvalidStateTransition    = status.Error(347, "participant state can not be changed")
+	ParticipantNotFound       = status.Error(348, "participant not found at the table")
+	NotParticipant            = status.Error(349, "player is not a participant of the table")
+	SeatSwapNotFound          = status.Error(350, "seat swap request not found or expired")
+	SeatIsFree                = status.Error(351, "seat is free, move to it instead")
+	SameSeat                  = status.Error(352, "player already sits at the seat")
+	RematchNotFound           = status.Error(353, "rematch vote not found or expired")
+	TournamentNotFound        = status.Error(354, "tournament not found")
+	RegistrationClosed        = status.Error(355, "tournament registration is closed")
+	TournamentFull            = status.Error(356, "tournament is full")
+	AlreadyRegistered         = status.Error(357, "player already registered for tournament")
+	NotRegistered             = status.Error(358, "player is not registered for tournament")
+	InvalidPartner            = status.Error(359, "invalid tournament partner")
+	TournamentNotRunning      = status.Error(360, "tournament is not running")
+	InvalidTableState         = status.Error(361, "action is not allowed in current table state")
+	TableVersionConflict      = status.Error(362, "table has been changed concurrently")
+	UnknownVariant            = status.Error(363, "unknown rule variant")
+	InvalidTableFilter        = status.Error(364, "invalid table filter")
+	NotSeatController         = status.Error(365, "session does not control player's seat")
+	TableNotClosed            = status.Error(366, "table has not been closed yet")
+	PlayerBanned              = status.Error(367, "player is banned")
+	PlayerMuted               = status.Error(368, "player is muted")
+	AdminRequired             = status.Error(369, "admin role is required")
+	ReasonRequired            = status.Error(370, "reason is required")
+	InvalidAdjustment         = status.Error(371, "invalid balance adjustment")
+	EmptyMessage              = status.Error(372, "message is empty")
+	ReviewCaseNotFound        = status.Error(373, "open review case not found")
+	InvalidReviewState        = status.Error(374, "invalid review state")
+	TableLogConflict          = status.Error(375, "table log sequence conflict")
+	AuditFailed               = status.Error(376, "admin action could not be audited")
 )
@@ -0,0 +1,2469 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: proto/admin.proto
+
+package game
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// query matches part of nickname, player id or user id
+type SearchPlayersRequest struct {
+	Query                string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Offset               uint32   `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                uint32   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchPlayersRequest) Reset()         { *m = SearchPlayersRequest{} }
+func (m *SearchPlayersRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchPlayersRequest) ProtoMessage()    {}
+func (*SearchPlayersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{0}
+}
+
+func (m *SearchPlayersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchPlayersRequest.Unmarshal(m, b)
+}
+func (m *SearchPlayersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchPlayersRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchPlayersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchPlayersRequest.Merge(m, src)
+}
+func (m *SearchPlayersRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchPlayersRequest.Size(m)
+}
+func (m *SearchPlayersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchPlayersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchPlayersRequest proto.InternalMessageInfo
+
+func (m *SearchPlayersRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SearchPlayersRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *SearchPlayersRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type SearchPlayersResponse struct {
+	Players              []*AdminPlayer `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	Total                uint32         `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SearchPlayersResponse) Reset()         { *m = SearchPlayersResponse{} }
+func (m *SearchPlayersResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchPlayersResponse) ProtoMessage()    {}
+func (*SearchPlayersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{1}
+}
+
+func (m *SearchPlayersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchPlayersResponse.Unmarshal(m, b)
+}
+func (m *SearchPlayersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchPlayersResponse.Marshal(b, m, deterministic)
+}
+func (m *SearchPlayersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchPlayersResponse.Merge(m, src)
+}
+func (m *SearchPlayersResponse) XXX_Size() int {
+	return xxx_messageInfo_SearchPlayersResponse.Size(m)
+}
+func (m *SearchPlayersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchPlayersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchPlayersResponse proto.InternalMessageInfo
+
+func (m *SearchPlayersResponse) GetPlayers() []*AdminPlayer {
+	if m != nil {
+		return m.Players
+	}
+	return nil
+}
+
+func (m *SearchPlayersResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type GetPlayerSessionsRequest struct {
+	PlayerId             string   `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Offset               uint32   `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                uint32   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPlayerSessionsRequest) Reset()         { *m = GetPlayerSessionsRequest{} }
+func (m *GetPlayerSessionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPlayerSessionsRequest) ProtoMessage()    {}
+func (*GetPlayerSessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{2}
+}
+
+func (m *GetPlayerSessionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPlayerSessionsRequest.Unmarshal(m, b)
+}
+func (m *GetPlayerSessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPlayerSessionsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPlayerSessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPlayerSessionsRequest.Merge(m, src)
+}
+func (m *GetPlayerSessionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPlayerSessionsRequest.Size(m)
+}
+func (m *GetPlayerSessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPlayerSessionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPlayerSessionsRequest proto.InternalMessageInfo
+
+func (m *GetPlayerSessionsRequest) GetPlayerId() string {
+	if m != nil {
+		return m.PlayerId
+	}
+	return ""
+}
+
+func (m *GetPlayerSessionsRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *GetPlayerSessionsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetPlayerSessionsResponse struct {
+	Sessions             []*AdminSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	Total                uint32          `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetPlayerSessionsResponse) Reset()         { *m = GetPlayerSessionsResponse{} }
+func (m *GetPlayerSessionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPlayerSessionsResponse) ProtoMessage()    {}
+func (*GetPlayerSessionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{3}
+}
+
+func (m *GetPlayerSessionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPlayerSessionsResponse.Unmarshal(m, b)
+}
+func (m *GetPlayerSessionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPlayerSessionsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPlayerSessionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPlayerSessionsResponse.Merge(m, src)
+}
+func (m *GetPlayerSessionsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPlayerSessionsResponse.Size(m)
+}
+func (m *GetPlayerSessionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPlayerSessionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPlayerSessionsResponse proto.InternalMessageInfo
+
+func (m *GetPlayerSessionsResponse) GetSessions() []*AdminSession {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+func (m *GetPlayerSessionsResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type GetPlayerTablesRequest struct {
+	PlayerId             string   `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Offset               uint32   `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                uint32   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPlayerTablesRequest) Reset()         { *m = GetPlayerTablesRequest{} }
+func (m *GetPlayerTablesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPlayerTablesRequest) ProtoMessage()    {}
+func (*GetPlayerTablesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{4}
+}
+
+func (m *GetPlayerTablesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPlayerTablesRequest.Unmarshal(m, b)
+}
+func (m *GetPlayerTablesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPlayerTablesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPlayerTablesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPlayerTablesRequest.Merge(m, src)
+}
+func (m *GetPlayerTablesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPlayerTablesRequest.Size(m)
+}
+func (m *GetPlayerTablesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPlayerTablesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPlayerTablesRequest proto.InternalMessageInfo
+
+func (m *GetPlayerTablesRequest) GetPlayerId() string {
+	if m != nil {
+		return m.PlayerId
+	}
+	return ""
+}
+
+func (m *GetPlayerTablesRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *GetPlayerTablesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetPlayerTablesResponse struct {
+	Tables               []*AdminTable `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	Total                uint32        `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetPlayerTablesResponse) Reset()         { *m = GetPlayerTablesResponse{} }
+func (m *GetPlayerTablesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPlayerTablesResponse) ProtoMessage()    {}
+func (*GetPlayerTablesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{5}
+}
+
+func (m *GetPlayerTablesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPlayerTablesResponse.Unmarshal(m, b)
+}
+func (m *GetPlayerTablesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPlayerTablesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPlayerTablesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPlayerTablesResponse.Merge(m, src)
+}
+func (m *GetPlayerTablesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPlayerTablesResponse.Size(m)
+}
+func (m *GetPlayerTablesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPlayerTablesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPlayerTablesResponse proto.InternalMessageInfo
+
+func (m *GetPlayerTablesResponse) GetTables() []*AdminTable {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+func (m *GetPlayerTablesResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type GetPlayerWalletRequest struct {
+	PlayerId             string   `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPlayerWalletRequest) Reset()         { *m = GetPlayerWalletRequest{} }
+func (m *GetPlayerWalletRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPlayerWalletRequest) ProtoMessage()    {}
+func (*GetPlayerWalletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{6}
+}
+
+func (m *GetPlayerWalletRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPlayerWalletRequest.Unmarshal(m, b)
+}
+func (m *GetPlayerWalletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPlayerWalletRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPlayerWalletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPlayerWalletRequest.Merge(m, src)
+}
+func (m *GetPlayerWalletRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPlayerWalletRequest.Size(m)
+}
+func (m *GetPlayerWalletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPlayerWalletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPlayerWalletRequest proto.InternalMessageInfo
+
+func (m *GetPlayerWalletRequest) GetPlayerId() string {
+	if m != nil {
+		return m.PlayerId
+	}
+	return ""
+}
+
+type GetPlayerWalletResponse struct {
+	Nuts                 uint64               `protobuf:"varint,1,opt,name=nuts,proto3" json:"nuts,omitempty"`
+	Gold                 uint64               `protobuf:"varint,2,opt,name=gold,proto3" json:"gold,omitempty"`
+	Purchases            []*AdminPurchase     `protobuf:"bytes,3,rep,name=purchases,proto3" json:"purchases,omitempty"`
+	Adjustments          []*BalanceAdjustment `protobuf:"bytes,4,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetPlayerWalletResponse) Reset()         { *m = GetPlayerWalletResponse{} }
+func (m *GetPlayerWalletResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPlayerWalletResponse) ProtoMessage()    {}
+func (*GetPlayerWalletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{7}
+}
+
+func (m *GetPlayerWalletResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPlayerWalletResponse.Unmarshal(m, b)
+}
+func (m *GetPlayerWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPlayerWalletResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPlayerWalletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPlayerWalletResponse.Merge(m, src)
+}
+func (m *GetPlayerWalletResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPlayerWalletResponse.Size(m)
+}
+func (m *GetPlayerWalletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPlayerWalletResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPlayerWalletResponse proto.InternalMessageInfo
+
+func (m *GetPlayerWalletResponse) GetNuts() uint64 {
+	if m != nil {
+		return m.Nuts
+	}
+	return 0
+}
+
+func (m *GetPlayerWalletResponse) GetGold() uint64 {
+	if m != nil {
+		return m.Gold
+	}
+	return 0
+}
+
+func (m *GetPlayerWalletResponse) GetPurchases() []*AdminPurchase {
+	if m != nil {
+		return m.Purchases
+	}
+	return nil
+}
+
+func (m *GetPlayerWalletResponse) GetAdjustments() []*BalanceAdjustment {
+	if m != nil {
+		return m.Adjustments
+	}
+	return nil
+}
+
+// zero duration lifts the ban, banned player's sessions are closed
+type BanPlayerRequest struct {
+	PlayerId             string   `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Duration             uint64   `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BanPlayerRequest) Reset()         { *m = BanPlayerRequest{} }
+func (m *BanPlayerRequest) String() string { return proto.CompactTextString(m) }
+func (*BanPlayerRequest) ProtoMessage()    {}
+func (*BanPlayerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{8}
+}
+
+func (m *BanPlayerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BanPlayerRequest.Unmarshal(m, b)
+}
+func (m *BanPlayerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BanPlayerRequest.Marshal(b, m, deterministic)
+}
+func (m *BanPlayerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BanPlayerRequest.Merge(m, src)
+}
+func (m *BanPlayerRequest) XXX_Size() int {
+	return xxx_messageInfo_BanPlayerRequest.Size(m)
+}
+func (m *BanPlayerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BanPlayerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BanPlayerRequest proto.InternalMessageInfo
+
+func (m *BanPlayerRequest) GetPlayerId() string {
+	if m != nil {
+		return m.PlayerId
+	}
+	return ""
+}
+
+func (m *BanPlayerRequest) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *BanPlayerRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type BanPlayerResponse struct {
+	BannedUntil          int64    `protobuf:"varint,1,opt,name=banned_until,json=bannedUntil,proto3" json:"banned_until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BanPlayerResponse) Reset()         { *m = BanPlayerResponse{} }
+func (m *BanPlayerResponse) String() string { return proto.CompactTextString(m) }
+func (*BanPlayerResponse) ProtoMessage()    {}
+func (*BanPlayerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{9}
+}
+
+func (m *BanPlayerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BanPlayerResponse.Unmarshal(m, b)
+}
+func (m *BanPlayerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BanPlayerResponse.Marshal(b, m, deterministic)
+}
+func (m *BanPlayerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BanPlayerResponse.Merge(m, src)
+}
+func (m *BanPlayerResponse) XXX_Size() int {
+	return xxx_messageInfo_BanPlayerResponse.Size(m)
+}
+func (m *BanPlayerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BanPlayerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BanPlayerResponse proto.InternalMessageInfo
+
+func (m *BanPlayerResponse) GetBannedUntil() int64 {
+	if m != nil {
+		return m.BannedUntil
+	}
+	return 0
+}
+
+// muted player can not add friends or invite them to table, nothing
+// else is restricted. Zero duration lifts the mute.
+type MutePlayerRequest struct {
+	PlayerId             string   `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Duration             uint64   `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MutePlayerRequest) Reset()         { *m = MutePlayerRequest{} }
+func (m *MutePlayerRequest) String() string { return proto.CompactTextString(m) }
+func (*MutePlayerRequest) ProtoMessage()    {}
+func (*MutePlayerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{10}
+}
+
+func (m *MutePlayerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MutePlayerRequest.Unmarshal(m, b)
+}
+func (m *MutePlayerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MutePlayerRequest.Marshal(b, m, deterministic)
+}
+func (m *MutePlayerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MutePlayerRequest.Merge(m, src)
+}
+func (m *MutePlayerRequest) XXX_Size() int {
+	return xxx_messageInfo_MutePlayerRequest.Size(m)
+}
+func (m *MutePlayerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MutePlayerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MutePlayerRequest proto.InternalMessageInfo
+
+func (m *MutePlayerRequest) GetPlayerId() string {
+	if m != nil {
+		return m.PlayerId
+	}
+	return ""
+}
+
+func (m *MutePlayerRequest) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *MutePlayerRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type MutePlayerResponse struct {
+	MutedUntil           int64    `protobuf:"varint,1,opt,name=muted_until,json=mutedUntil,proto3" json:"muted_until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MutePlayerResponse) Reset()         { *m = MutePlayerResponse{} }
+func (m *MutePlayerResponse) String() string { return proto.CompactTextString(m) }
+func (*MutePlayerResponse) ProtoMessage()    {}
+func (*MutePlayerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{11}
+}
+
+func (m *MutePlayerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MutePlayerResponse.Unmarshal(m, b)
+}
+func (m *MutePlayerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MutePlayerResponse.Marshal(b, m, deterministic)
+}
+func (m *MutePlayerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MutePlayerResponse.Merge(m, src)
+}
+func (m *MutePlayerResponse) XXX_Size() int {
+	return xxx_messageInfo_MutePlayerResponse.Size(m)
+}
+func (m *MutePlayerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MutePlayerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MutePlayerResponse proto.InternalMessageInfo
+
+func (m *MutePlayerResponse) GetMutedUntil() int64 {
+	if m != nil {
+		return m.MutedUntil
+	}
+	return 0
+}
+
+type AdjustBalanceRequest struct {
+	PlayerId             string   `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Currency             string   `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AdjustBalanceRequest) Reset()         { *m = AdjustBalanceRequest{} }
+func (m *AdjustBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*AdjustBalanceRequest) ProtoMessage()    {}
+func (*AdjustBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{12}
+}
+
+func (m *AdjustBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdjustBalanceRequest.Unmarshal(m, b)
+}
+func (m *AdjustBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdjustBalanceRequest.Marshal(b, m, deterministic)
+}
+func (m *AdjustBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdjustBalanceRequest.Merge(m, src)
+}
+func (m *AdjustBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_AdjustBalanceRequest.Size(m)
+}
+func (m *AdjustBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdjustBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdjustBalanceRequest proto.InternalMessageInfo
+
+func (m *AdjustBalanceRequest) GetPlayerId() string {
+	if m != nil {
+		return m.PlayerId
+	}
+	return ""
+}
+
+func (m *AdjustBalanceRequest) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *AdjustBalanceRequest) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *AdjustBalanceRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type AdjustBalanceResponse struct {
+	Nuts                 uint64   `protobuf:"varint,1,opt,name=nuts,proto3" json:"nuts,omitempty"`
+	Gold                 uint64   `protobuf:"varint,2,opt,name=gold,proto3" json:"gold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AdjustBalanceResponse) Reset()         { *m = AdjustBalanceResponse{} }
+func (m *AdjustBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*AdjustBalanceResponse) ProtoMessage()    {}
+func (*AdjustBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{13}
+}
+
+func (m *AdjustBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdjustBalanceResponse.Unmarshal(m, b)
+}
+func (m *AdjustBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdjustBalanceResponse.Marshal(b, m, deterministic)
+}
+func (m *AdjustBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdjustBalanceResponse.Merge(m, src)
+}
+func (m *AdjustBalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_AdjustBalanceResponse.Size(m)
+}
+func (m *AdjustBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdjustBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdjustBalanceResponse proto.InternalMessageInfo
+
+func (m *AdjustBalanceResponse) GetNuts() uint64 {
+	if m != nil {
+		return m.Nuts
+	}
+	return 0
+}
+
+func (m *AdjustBalanceResponse) GetGold() uint64 {
+	if m != nil {
+		return m.Gold
+	}
+	return 0
+}
+
+// closes table without winner. Bets are not escrowed and only finished
+// games settle them, so nothing is refunded. Tournament table counts as
+// lost by every entry seated at it.
+type ForceCloseTableRequest struct {
+	TableId              string   `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForceCloseTableRequest) Reset()         { *m = ForceCloseTableRequest{} }
+func (m *ForceCloseTableRequest) String() string { return proto.CompactTextString(m) }
+func (*ForceCloseTableRequest) ProtoMessage()    {}
+func (*ForceCloseTableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{14}
+}
+
+func (m *ForceCloseTableRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForceCloseTableRequest.Unmarshal(m, b)
+}
+func (m *ForceCloseTableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForceCloseTableRequest.Marshal(b, m, deterministic)
+}
+func (m *ForceCloseTableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForceCloseTableRequest.Merge(m, src)
+}
+func (m *ForceCloseTableRequest) XXX_Size() int {
+	return xxx_messageInfo_ForceCloseTableRequest.Size(m)
+}
+func (m *ForceCloseTableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForceCloseTableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForceCloseTableRequest proto.InternalMessageInfo
+
+func (m *ForceCloseTableRequest) GetTableId() string {
+	if m != nil {
+		return m.TableId
+	}
+	return ""
+}
+
+func (m *ForceCloseTableRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type ForceCloseTableResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForceCloseTableResponse) Reset()         { *m = ForceCloseTableResponse{} }
+func (m *ForceCloseTableResponse) String() string { return proto.CompactTextString(m) }
+func (*ForceCloseTableResponse) ProtoMessage()    {}
+func (*ForceCloseTableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{15}
+}
+
+func (m *ForceCloseTableResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForceCloseTableResponse.Unmarshal(m, b)
+}
+func (m *ForceCloseTableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForceCloseTableResponse.Marshal(b, m, deterministic)
+}
+func (m *ForceCloseTableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForceCloseTableResponse.Merge(m, src)
+}
+func (m *ForceCloseTableResponse) XXX_Size() int {
+	return xxx_messageInfo_ForceCloseTableResponse.Size(m)
+}
+func (m *ForceCloseTableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForceCloseTableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForceCloseTableResponse proto.InternalMessageInfo
+
+type BroadcastMessageRequest struct {
+	Text                 string   `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BroadcastMessageRequest) Reset()         { *m = BroadcastMessageRequest{} }
+func (m *BroadcastMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*BroadcastMessageRequest) ProtoMessage()    {}
+func (*BroadcastMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{16}
+}
+
+func (m *BroadcastMessageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BroadcastMessageRequest.Unmarshal(m, b)
+}
+func (m *BroadcastMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BroadcastMessageRequest.Marshal(b, m, deterministic)
+}
+func (m *BroadcastMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BroadcastMessageRequest.Merge(m, src)
+}
+func (m *BroadcastMessageRequest) XXX_Size() int {
+	return xxx_messageInfo_BroadcastMessageRequest.Size(m)
+}
+func (m *BroadcastMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BroadcastMessageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BroadcastMessageRequest proto.InternalMessageInfo
+
+func (m *BroadcastMessageRequest) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+type BroadcastMessageResponse struct {
+	Recipients           uint32   `protobuf:"varint,1,opt,name=recipients,proto3" json:"recipients,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BroadcastMessageResponse) Reset()         { *m = BroadcastMessageResponse{} }
+func (m *BroadcastMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*BroadcastMessageResponse) ProtoMessage()    {}
+func (*BroadcastMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{17}
+}
+
+func (m *BroadcastMessageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BroadcastMessageResponse.Unmarshal(m, b)
+}
+func (m *BroadcastMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BroadcastMessageResponse.Marshal(b, m, deterministic)
+}
+func (m *BroadcastMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BroadcastMessageResponse.Merge(m, src)
+}
+func (m *BroadcastMessageResponse) XXX_Size() int {
+	return xxx_messageInfo_BroadcastMessageResponse.Size(m)
+}
+func (m *BroadcastMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BroadcastMessageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BroadcastMessageResponse proto.InternalMessageInfo
+
+func (m *BroadcastMessageResponse) GetRecipients() uint32 {
+	if m != nil {
+		return m.Recipients
+	}
+	return 0
+}
+
+type GetAuditLogRequest struct {
+	PlayerId             string   `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	TableId              string   `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Offset               uint32   `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                uint32   `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAuditLogRequest) Reset()         { *m = GetAuditLogRequest{} }
+func (m *GetAuditLogRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAuditLogRequest) ProtoMessage()    {}
+func (*GetAuditLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{18}
+}
+
+func (m *GetAuditLogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAuditLogRequest.Unmarshal(m, b)
+}
+func (m *GetAuditLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAuditLogRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAuditLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuditLogRequest.Merge(m, src)
+}
+func (m *GetAuditLogRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAuditLogRequest.Size(m)
+}
+func (m *GetAuditLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuditLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAuditLogRequest proto.InternalMessageInfo
+
+func (m *GetAuditLogRequest) GetPlayerId() string {
+	if m != nil {
+		return m.PlayerId
+	}
+	return ""
+}
+
+func (m *GetAuditLogRequest) GetTableId() string {
+	if m != nil {
+		return m.TableId
+	}
+	return ""
+}
+
+func (m *GetAuditLogRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *GetAuditLogRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetAuditLogResponse struct {
+	Actions              []*AdminAction `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	Total                uint32         `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetAuditLogResponse) Reset()         { *m = GetAuditLogResponse{} }
+func (m *GetAuditLogResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAuditLogResponse) ProtoMessage()    {}
+func (*GetAuditLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{19}
+}
+
+func (m *GetAuditLogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAuditLogResponse.Unmarshal(m, b)
+}
+func (m *GetAuditLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAuditLogResponse.Marshal(b, m, deterministic)
+}
+func (m *GetAuditLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuditLogResponse.Merge(m, src)
+}
+func (m *GetAuditLogResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAuditLogResponse.Size(m)
+}
+func (m *GetAuditLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuditLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAuditLogResponse proto.InternalMessageInfo
+
+func (m *GetAuditLogResponse) GetActions() []*AdminAction {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+func (m *GetAuditLogResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type GetReviewQueueRequest struct {
+	State                string   `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Kind                 string   `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Offset               uint32   `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                uint32   `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetReviewQueueRequest) Reset()         { *m = GetReviewQueueRequest{} }
+func (m *GetReviewQueueRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReviewQueueRequest) ProtoMessage()    {}
+func (*GetReviewQueueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{20}
+}
+
+func (m *GetReviewQueueRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReviewQueueRequest.Unmarshal(m, b)
+}
+func (m *GetReviewQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReviewQueueRequest.Marshal(b, m, deterministic)
+}
+func (m *GetReviewQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReviewQueueRequest.Merge(m, src)
+}
+func (m *GetReviewQueueRequest) XXX_Size() int {
+	return xxx_messageInfo_GetReviewQueueRequest.Size(m)
+}
+func (m *GetReviewQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReviewQueueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReviewQueueRequest proto.InternalMessageInfo
+
+func (m *GetReviewQueueRequest) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *GetReviewQueueRequest) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *GetReviewQueueRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *GetReviewQueueRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetReviewQueueResponse struct {
+	Cases                []*ReviewCase `protobuf:"bytes,1,rep,name=cases,proto3" json:"cases,omitempty"`
+	Total                uint32        `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetReviewQueueResponse) Reset()         { *m = GetReviewQueueResponse{} }
+func (m *GetReviewQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReviewQueueResponse) ProtoMessage()    {}
+func (*GetReviewQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{21}
+}
+
+func (m *GetReviewQueueResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReviewQueueResponse.Unmarshal(m, b)
+}
+func (m *GetReviewQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReviewQueueResponse.Marshal(b, m, deterministic)
+}
+func (m *GetReviewQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReviewQueueResponse.Merge(m, src)
+}
+func (m *GetReviewQueueResponse) XXX_Size() int {
+	return xxx_messageInfo_GetReviewQueueResponse.Size(m)
+}
+func (m *GetReviewQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReviewQueueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReviewQueueResponse proto.InternalMessageInfo
+
+func (m *GetReviewQueueResponse) GetCases() []*ReviewCase {
+	if m != nil {
+		return m.Cases
+	}
+	return nil
+}
+
+func (m *GetReviewQueueResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type ResolveReviewCaseRequest struct {
+	CaseId               string   `protobuf:"bytes,1,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+	State                string   `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResolveReviewCaseRequest) Reset()         { *m = ResolveReviewCaseRequest{} }
+func (m *ResolveReviewCaseRequest) String() string { return proto.CompactTextString(m) }
+func (*ResolveReviewCaseRequest) ProtoMessage()    {}
+func (*ResolveReviewCaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{22}
+}
+
+func (m *ResolveReviewCaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResolveReviewCaseRequest.Unmarshal(m, b)
+}
+func (m *ResolveReviewCaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResolveReviewCaseRequest.Marshal(b, m, deterministic)
+}
+func (m *ResolveReviewCaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveReviewCaseRequest.Merge(m, src)
+}
+func (m *ResolveReviewCaseRequest) XXX_Size() int {
+	return xxx_messageInfo_ResolveReviewCaseRequest.Size(m)
+}
+func (m *ResolveReviewCaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveReviewCaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolveReviewCaseRequest proto.InternalMessageInfo
+
+func (m *ResolveReviewCaseRequest) GetCaseId() string {
+	if m != nil {
+		return m.CaseId
+	}
+	return ""
+}
+
+func (m *ResolveReviewCaseRequest) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ResolveReviewCaseRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type ResolveReviewCaseResponse struct {
+	Case                 *ReviewCase `protobuf:"bytes,1,opt,name=case,proto3" json:"case,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ResolveReviewCaseResponse) Reset()         { *m = ResolveReviewCaseResponse{} }
+func (m *ResolveReviewCaseResponse) String() string { return proto.CompactTextString(m) }
+func (*ResolveReviewCaseResponse) ProtoMessage()    {}
+func (*ResolveReviewCaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{23}
+}
+
+func (m *ResolveReviewCaseResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResolveReviewCaseResponse.Unmarshal(m, b)
+}
+func (m *ResolveReviewCaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResolveReviewCaseResponse.Marshal(b, m, deterministic)
+}
+func (m *ResolveReviewCaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveReviewCaseResponse.Merge(m, src)
+}
+func (m *ResolveReviewCaseResponse) XXX_Size() int {
+	return xxx_messageInfo_ResolveReviewCaseResponse.Size(m)
+}
+func (m *ResolveReviewCaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveReviewCaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolveReviewCaseResponse proto.InternalMessageInfo
+
+func (m *ResolveReviewCaseResponse) GetCase() *ReviewCase {
+	if m != nil {
+		return m.Case
+	}
+	return nil
+}
+
+type AdminPlayer struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId               string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Nickname             string   `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Level                uint32   `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`
+	Rating               int32    `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"`
+	Nuts                 uint64   `protobuf:"varint,6,opt,name=nuts,proto3" json:"nuts,omitempty"`
+	Gold                 uint64   `protobuf:"varint,7,opt,name=gold,proto3" json:"gold,omitempty"`
+	BannedUntil          int64    `protobuf:"varint,8,opt,name=banned_until,json=bannedUntil,proto3" json:"banned_until,omitempty"`
+	MutedUntil           int64    `protobuf:"varint,9,opt,name=muted_until,json=mutedUntil,proto3" json:"muted_until,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AdminPlayer) Reset()         { *m = AdminPlayer{} }
+func (m *AdminPlayer) String() string { return proto.CompactTextString(m) }
+func (*AdminPlayer) ProtoMessage()    {}
+func (*AdminPlayer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{24}
+}
+
+func (m *AdminPlayer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdminPlayer.Unmarshal(m, b)
+}
+func (m *AdminPlayer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdminPlayer.Marshal(b, m, deterministic)
+}
+func (m *AdminPlayer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminPlayer.Merge(m, src)
+}
+func (m *AdminPlayer) XXX_Size() int {
+	return xxx_messageInfo_AdminPlayer.Size(m)
+}
+func (m *AdminPlayer) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminPlayer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminPlayer proto.InternalMessageInfo
+
+func (m *AdminPlayer) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AdminPlayer) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *AdminPlayer) GetNickname() string {
+	if m != nil {
+		return m.Nickname
+	}
+	return ""
+}
+
+func (m *AdminPlayer) GetLevel() uint32 {
+	if m != nil {
+		return m.Level
+	}
+	return 0
+}
+
+func (m *AdminPlayer) GetRating() int32 {
+	if m != nil {
+		return m.Rating
+	}
+	return 0
+}
+
+func (m *AdminPlayer) GetNuts() uint64 {
+	if m != nil {
+		return m.Nuts
+	}
+	return 0
+}
+
+func (m *AdminPlayer) GetGold() uint64 {
+	if m != nil {
+		return m.Gold
+	}
+	return 0
+}
+
+func (m *AdminPlayer) GetBannedUntil() int64 {
+	if m != nil {
+		return m.BannedUntil
+	}
+	return 0
+}
+
+func (m *AdminPlayer) GetMutedUntil() int64 {
+	if m != nil {
+		return m.MutedUntil
+	}
+	return 0
+}
+
+func (m *AdminPlayer) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type AdminSession struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Remote               string   `protobuf:"bytes,2,opt,name=remote,proto3" json:"remote,omitempty"`
+	Device               string   `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
+	Controller           bool     `protobuf:"varint,4,opt,name=controller,proto3" json:"controller,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ClosedAt             int64    `protobuf:"varint,6,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AdminSession) Reset()         { *m = AdminSession{} }
+func (m *AdminSession) String() string { return proto.CompactTextString(m) }
+func (*AdminSession) ProtoMessage()    {}
+func (*AdminSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{25}
+}
+
+func (m *AdminSession) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdminSession.Unmarshal(m, b)
+}
+func (m *AdminSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdminSession.Marshal(b, m, deterministic)
+}
+func (m *AdminSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminSession.Merge(m, src)
+}
+func (m *AdminSession) XXX_Size() int {
+	return xxx_messageInfo_AdminSession.Size(m)
+}
+func (m *AdminSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminSession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminSession proto.InternalMessageInfo
+
+func (m *AdminSession) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AdminSession) GetRemote() string {
+	if m != nil {
+		return m.Remote
+	}
+	return ""
+}
+
+func (m *AdminSession) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
+
+func (m *AdminSession) GetController() bool {
+	if m != nil {
+		return m.Controller
+	}
+	return false
+}
+
+func (m *AdminSession) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *AdminSession) GetClosedAt() int64 {
+	if m != nil {
+		return m.ClosedAt
+	}
+	return 0
+}
+
+type AdminTable struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	State                string   `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Result               string   `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	Currency             string   `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Bet                  uint32   `protobuf:"varint,5,opt,name=bet,proto3" json:"bet,omitempty"`
+	Private              bool     `protobuf:"varint,6,opt,name=private,proto3" json:"private,omitempty"`
+	TournamentId         string   `protobuf:"bytes,7,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	PlayerIds            []string `protobuf:"bytes,8,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EndTime              int64    `protobuf:"varint,10,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AdminTable) Reset()         { *m = AdminTable{} }
+func (m *AdminTable) String() string { return proto.CompactTextString(m) }
+func (*AdminTable) ProtoMessage()    {}
+func (*AdminTable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{26}
+}
+
+func (m *AdminTable) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdminTable.Unmarshal(m, b)
+}
+func (m *AdminTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdminTable.Marshal(b, m, deterministic)
+}
+func (m *AdminTable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminTable.Merge(m, src)
+}
+func (m *AdminTable) XXX_Size() int {
+	return xxx_messageInfo_AdminTable.Size(m)
+}
+func (m *AdminTable) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminTable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminTable proto.InternalMessageInfo
+
+func (m *AdminTable) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AdminTable) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *AdminTable) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *AdminTable) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *AdminTable) GetBet() uint32 {
+	if m != nil {
+		return m.Bet
+	}
+	return 0
+}
+
+func (m *AdminTable) GetPrivate() bool {
+	if m != nil {
+		return m.Private
+	}
+	return false
+}
+
+func (m *AdminTable) GetTournamentId() string {
+	if m != nil {
+		return m.TournamentId
+	}
+	return ""
+}
+
+func (m *AdminTable) GetPlayerIds() []string {
+	if m != nil {
+		return m.PlayerIds
+	}
+	return nil
+}
+
+func (m *AdminTable) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *AdminTable) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+type AdminPurchase struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId            string   `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Price                uint32   `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Currency             string   `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	State                string   `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AdminPurchase) Reset()         { *m = AdminPurchase{} }
+func (m *AdminPurchase) String() string { return proto.CompactTextString(m) }
+func (*AdminPurchase) ProtoMessage()    {}
+func (*AdminPurchase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{27}
+}
+
+func (m *AdminPurchase) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdminPurchase.Unmarshal(m, b)
+}
+func (m *AdminPurchase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdminPurchase.Marshal(b, m, deterministic)
+}
+func (m *AdminPurchase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminPurchase.Merge(m, src)
+}
+func (m *AdminPurchase) XXX_Size() int {
+	return xxx_messageInfo_AdminPurchase.Size(m)
+}
+func (m *AdminPurchase) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminPurchase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminPurchase proto.InternalMessageInfo
+
+func (m *AdminPurchase) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AdminPurchase) GetProductId() string {
+	if m != nil {
+		return m.ProductId
+	}
+	return ""
+}
+
+func (m *AdminPurchase) GetPrice() uint32 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *AdminPurchase) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *AdminPurchase) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *AdminPurchase) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type BalanceAdjustment struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdminId              string   `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Currency             string   `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount               int64    `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason               string   `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BalanceAdjustment) Reset()         { *m = BalanceAdjustment{} }
+func (m *BalanceAdjustment) String() string { return proto.CompactTextString(m) }
+func (*BalanceAdjustment) ProtoMessage()    {}
+func (*BalanceAdjustment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{28}
+}
+
+func (m *BalanceAdjustment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BalanceAdjustment.Unmarshal(m, b)
+}
+func (m *BalanceAdjustment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BalanceAdjustment.Marshal(b, m, deterministic)
+}
+func (m *BalanceAdjustment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceAdjustment.Merge(m, src)
+}
+func (m *BalanceAdjustment) XXX_Size() int {
+	return xxx_messageInfo_BalanceAdjustment.Size(m)
+}
+func (m *BalanceAdjustment) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceAdjustment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceAdjustment proto.InternalMessageInfo
+
+func (m *BalanceAdjustment) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *BalanceAdjustment) GetAdminId() string {
+	if m != nil {
+		return m.AdminId
+	}
+	return ""
+}
+
+func (m *BalanceAdjustment) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *BalanceAdjustment) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *BalanceAdjustment) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *BalanceAdjustment) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type AdminAction struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdminId              string   `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	AdminName            string   `protobuf:"bytes,3,opt,name=admin_name,json=adminName,proto3" json:"admin_name,omitempty"`
+	Method               string   `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	PlayerId             string   `protobuf:"bytes,5,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	TableId              string   `protobuf:"bytes,6,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Reason               string   `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Request              string   `protobuf:"bytes,8,opt,name=request,proto3" json:"request,omitempty"`
+	Error                string   `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AdminAction) Reset()         { *m = AdminAction{} }
+func (m *AdminAction) String() string { return proto.CompactTextString(m) }
+func (*AdminAction) ProtoMessage()    {}
+func (*AdminAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{29}
+}
+
+func (m *AdminAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdminAction.Unmarshal(m, b)
+}
+func (m *AdminAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdminAction.Marshal(b, m, deterministic)
+}
+func (m *AdminAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminAction.Merge(m, src)
+}
+func (m *AdminAction) XXX_Size() int {
+	return xxx_messageInfo_AdminAction.Size(m)
+}
+func (m *AdminAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminAction proto.InternalMessageInfo
+
+func (m *AdminAction) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AdminAction) GetAdminId() string {
+	if m != nil {
+		return m.AdminId
+	}
+	return ""
+}
+
+func (m *AdminAction) GetAdminName() string {
+	if m != nil {
+		return m.AdminName
+	}
+	return ""
+}
+
+func (m *AdminAction) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *AdminAction) GetPlayerId() string {
+	if m != nil {
+		return m.PlayerId
+	}
+	return ""
+}
+
+func (m *AdminAction) GetTableId() string {
+	if m != nil {
+		return m.TableId
+	}
+	return ""
+}
+
+func (m *AdminAction) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *AdminAction) GetRequest() string {
+	if m != nil {
+		return m.Request
+	}
+	return ""
+}
+
+func (m *AdminAction) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *AdminAction) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type ReviewCase struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind                 string   `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	PlayerIds            []string `protobuf:"bytes,3,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"`
+	TableIds             []string `protobuf:"bytes,4,rep,name=table_ids,json=tableIds,proto3" json:"table_ids,omitempty"`
+	Score                float64  `protobuf:"fixed64,5,opt,name=score,proto3" json:"score,omitempty"`
+	Details              string   `protobuf:"bytes,6,opt,name=details,proto3" json:"details,omitempty"`
+	State                string   `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	ReviewerId           string   `protobuf:"bytes,8,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Note                 string   `protobuf:"bytes,9,opt,name=note,proto3" json:"note,omitempty"`
+	ReviewedAt           int64    `protobuf:"varint,10,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            int64    `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReviewCase) Reset()         { *m = ReviewCase{} }
+func (m *ReviewCase) String() string { return proto.CompactTextString(m) }
+func (*ReviewCase) ProtoMessage()    {}
+func (*ReviewCase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92c9b71229522f37, []int{30}
+}
+
+func (m *ReviewCase) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReviewCase.Unmarshal(m, b)
+}
+func (m *ReviewCase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReviewCase.Marshal(b, m, deterministic)
+}
+func (m *ReviewCase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReviewCase.Merge(m, src)
+}
+func (m *ReviewCase) XXX_Size() int {
+	return xxx_messageInfo_ReviewCase.Size(m)
+}
+func (m *ReviewCase) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReviewCase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReviewCase proto.InternalMessageInfo
+
+func (m *ReviewCase) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ReviewCase) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *ReviewCase) GetPlayerIds() []string {
+	if m != nil {
+		return m.PlayerIds
+	}
+	return nil
+}
+
+func (m *ReviewCase) GetTableIds() []string {
+	if m != nil {
+		return m.TableIds
+	}
+	return nil
+}
+
+func (m *ReviewCase) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *ReviewCase) GetDetails() string {
+	if m != nil {
+		return m.Details
+	}
+	return ""
+}
+
+func (m *ReviewCase) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ReviewCase) GetReviewerId() string {
+	if m != nil {
+		return m.ReviewerId
+	}
+	return ""
+}
+
+func (m *ReviewCase) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+func (m *ReviewCase) GetReviewedAt() int64 {
+	if m != nil {
+		return m.ReviewedAt
+	}
+	return 0
+}
+
+func (m *ReviewCase) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *ReviewCase) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SearchPlayersRequest)(nil), "SearchPlayersRequest")
+	proto.RegisterType((*SearchPlayersResponse)(nil), "SearchPlayersResponse")
+	proto.RegisterType((*GetPlayerSessionsRequest)(nil), "GetPlayerSessionsRequest")
+	proto.RegisterType((*GetPlayerSessionsResponse)(nil), "GetPlayerSessionsResponse")
+	proto.RegisterType((*GetPlayerTablesRequest)(nil), "GetPlayerTablesRequest")
+	proto.RegisterType((*GetPlayerTablesResponse)(nil), "GetPlayerTablesResponse")
+	proto.RegisterType((*GetPlayerWalletRequest)(nil), "GetPlayerWalletRequest")
+	proto.RegisterType((*GetPlayerWalletResponse)(nil), "GetPlayerWalletResponse")
+	proto.RegisterType((*BanPlayerRequest)(nil), "BanPlayerRequest")
+	proto.RegisterType((*BanPlayerResponse)(nil), "BanPlayerResponse")
+	proto.RegisterType((*MutePlayerRequest)(nil), "MutePlayerRequest")
+	proto.RegisterType((*MutePlayerResponse)(nil), "MutePlayerResponse")
+	proto.RegisterType((*AdjustBalanceRequest)(nil), "AdjustBalanceRequest")
+	proto.RegisterType((*AdjustBalanceResponse)(nil), "AdjustBalanceResponse")
+	proto.RegisterType((*ForceCloseTableRequest)(nil), "ForceCloseTableRequest")
+	proto.RegisterType((*ForceCloseTableResponse)(nil), "ForceCloseTableResponse")
+	proto.RegisterType((*BroadcastMessageRequest)(nil), "BroadcastMessageRequest")
+	proto.RegisterType((*BroadcastMessageResponse)(nil), "BroadcastMessageResponse")
+	proto.RegisterType((*GetAuditLogRequest)(nil), "GetAuditLogRequest")
+	proto.RegisterType((*GetAuditLogResponse)(nil), "GetAuditLogResponse")
+	proto.RegisterType((*GetReviewQueueRequest)(nil), "GetReviewQueueRequest")
+	proto.RegisterType((*GetReviewQueueResponse)(nil), "GetReviewQueueResponse")
+	proto.RegisterType((*ResolveReviewCaseRequest)(nil), "ResolveReviewCaseRequest")
+	proto.RegisterType((*ResolveReviewCaseResponse)(nil), "ResolveReviewCaseResponse")
+	proto.RegisterType((*AdminPlayer)(nil), "AdminPlayer")
+	proto.RegisterType((*AdminSession)(nil), "AdminSession")
+	proto.RegisterType((*AdminTable)(nil), "AdminTable")
+	proto.RegisterType((*AdminPurchase)(nil), "AdminPurchase")
+	proto.RegisterType((*BalanceAdjustment)(nil), "BalanceAdjustment")
+	proto.RegisterType((*AdminAction)(nil), "AdminAction")
+	proto.RegisterType((*ReviewCase)(nil), "ReviewCase")
+}
+
+func init() { proto.RegisterFile("proto/admin.proto", fileDescriptor_92c9b71229522f37) }
+
+var fileDescriptor_92c9b71229522f37 = []byte{
+	// 1441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdd, 0x8e, 0xd4, 0xc6,
+	0x12, 0xd6, 0xfc, 0x8f, 0x6b, 0x76, 0x80, 0x69, 0x76, 0x67, 0x3c, 0x46, 0x1c, 0xf6, 0x18, 0xe9,
+	0x68, 0x8f, 0x74, 0x8e, 0x23, 0x91, 0x10, 0x45, 0x51, 0x44, 0x34, 0x0b, 0xca, 0x6a, 0x49, 0x88,
+	0x42, 0x03, 0x8a, 0x84, 0x22, 0xad, 0x7a, 0xed, 0x66, 0x71, 0xf0, 0xd8, 0x83, 0xbb, 0xbd, 0x09,
+	0xb9, 0xc9, 0x03, 0x44, 0x8a, 0xf2, 0x12, 0xb9, 0x20, 0x0f, 0x92, 0xe7, 0x8a, 0xfa, 0xc7, 0x9e,
+	0xf6, 0x1f, 0xec, 0x5e, 0x70, 0x37, 0x55, 0xdd, 0xee, 0xfe, 0xaa, 0xba, 0xea, 0xab, 0xaa, 0x81,
+	0xd9, 0x26, 0x4d, 0x78, 0xf2, 0x11, 0x09, 0xd6, 0x61, 0xec, 0xc9, 0xdf, 0xee, 0x73, 0xd8, 0x7d,
+	0x42, 0x49, 0xea, 0xbf, 0xfc, 0x2e, 0x22, 0x6f, 0x68, 0xca, 0x30, 0x7d, 0x9d, 0x51, 0xc6, 0xd1,
+	0x2e, 0x0c, 0x5e, 0x67, 0x34, 0x7d, 0x63, 0x77, 0xf6, 0x3b, 0x07, 0x16, 0x56, 0x02, 0x9a, 0xc3,
+	0x30, 0x79, 0xf1, 0x82, 0x51, 0x6e, 0x77, 0xf7, 0x3b, 0x07, 0x53, 0xac, 0x25, 0xb1, 0x3b, 0x0a,
+	0xd7, 0x21, 0xb7, 0x7b, 0x52, 0xad, 0x04, 0xf7, 0x19, 0xec, 0x55, 0xce, 0x66, 0x9b, 0x24, 0x66,
+	0x14, 0xfd, 0x07, 0x46, 0x1b, 0xa5, 0xb2, 0x3b, 0xfb, 0xbd, 0x83, 0xc9, 0x9d, 0x1d, 0x6f, 0x25,
+	0x30, 0xa9, 0x7d, 0x38, 0x5f, 0x14, 0xc7, 0xf2, 0x84, 0x93, 0x48, 0xdf, 0xa6, 0x04, 0x97, 0x82,
+	0x7d, 0x44, 0xb9, 0xda, 0xfb, 0x84, 0x32, 0x16, 0x26, 0x71, 0x01, 0xfb, 0x06, 0x58, 0xea, 0xe3,
+	0x93, 0x30, 0xd0, 0xd0, 0xc7, 0x4a, 0x71, 0x1c, 0x5c, 0x12, 0xfd, 0x0f, 0xb0, 0x6c, 0xb8, 0x46,
+	0x5b, 0xf0, 0x5f, 0x18, 0x33, 0xad, 0xd3, 0x26, 0x4c, 0x95, 0x09, 0x7a, 0x27, 0x2e, 0x96, 0x5b,
+	0x8c, 0xf0, 0x61, 0x5e, 0x9c, 0xfe, 0x94, 0x9c, 0x46, 0xf4, 0x43, 0x98, 0xf0, 0x14, 0x16, 0xb5,
+	0x4b, 0xb4, 0x01, 0xb7, 0x61, 0xc8, 0xa5, 0x46, 0xc3, 0x9f, 0x28, 0xf8, 0x72, 0x17, 0xd6, 0x4b,
+	0x2d, 0xd0, 0xef, 0x1a, 0xd0, 0xbf, 0x27, 0x51, 0x44, 0xf9, 0x45, 0xa0, 0xbb, 0x7f, 0x76, 0x60,
+	0x51, 0xfb, 0x4e, 0xa3, 0x41, 0xd0, 0x8f, 0x33, 0xce, 0xe4, 0x37, 0x7d, 0x2c, 0x7f, 0x0b, 0xdd,
+	0x59, 0x12, 0x05, 0xf2, 0xee, 0x3e, 0x96, 0xbf, 0xd1, 0xff, 0xc0, 0xda, 0x64, 0xa9, 0xff, 0x92,
+	0x30, 0xca, 0xec, 0x9e, 0x04, 0x7e, 0x45, 0x87, 0x8e, 0x56, 0xe3, 0xed, 0x06, 0xf4, 0x09, 0x4c,
+	0x48, 0xf0, 0x63, 0xc6, 0xf8, 0x9a, 0xc6, 0x9c, 0xd9, 0x7d, 0xb9, 0x1f, 0x79, 0x87, 0x24, 0x22,
+	0xb1, 0x4f, 0x57, 0xc5, 0x12, 0x36, 0xb7, 0xb9, 0x3e, 0x5c, 0x3b, 0x24, 0x79, 0x28, 0x5e, 0xe4,
+	0x4d, 0x1c, 0x18, 0x07, 0x59, 0x4a, 0x78, 0x98, 0xc4, 0x1a, 0x6c, 0x21, 0x8b, 0xf7, 0x4a, 0x29,
+	0x61, 0x49, 0x2c, 0x1f, 0xc6, 0xc2, 0x5a, 0x72, 0x3f, 0x85, 0x99, 0x71, 0x89, 0xf6, 0xc2, 0xbf,
+	0x61, 0xe7, 0x94, 0xc4, 0x31, 0x0d, 0x4e, 0xb2, 0x98, 0x87, 0x91, 0xbc, 0xa8, 0x87, 0x27, 0x4a,
+	0xf7, 0x4c, 0xa8, 0xdc, 0x00, 0x66, 0x8f, 0x32, 0x4e, 0x3f, 0x30, 0xba, 0xbb, 0x80, 0xcc, 0x5b,
+	0x34, 0xbc, 0x5b, 0x30, 0x59, 0x67, 0xbc, 0x82, 0x0e, 0xa4, 0x4a, 0x81, 0xfb, 0x15, 0x76, 0x95,
+	0x53, 0xb5, 0x87, 0x2f, 0x8a, 0xcf, 0xcf, 0xd2, 0x94, 0xc6, 0xfe, 0x1b, 0x89, 0xcf, 0xc2, 0x85,
+	0x2c, 0xf0, 0x91, 0x75, 0x92, 0xc5, 0x2a, 0xac, 0x7b, 0x58, 0x4b, 0x06, 0xee, 0x7e, 0x09, 0xf7,
+	0x97, 0xb0, 0x57, 0x01, 0x70, 0xb9, 0xf8, 0x72, 0xbf, 0x86, 0xf9, 0x57, 0x49, 0xea, 0xd3, 0xfb,
+	0x51, 0xc2, 0xa8, 0xca, 0x05, 0x6d, 0xc3, 0x12, 0xc6, 0x32, 0x29, 0xb6, 0x26, 0x8c, 0xa4, 0xac,
+	0x72, 0x52, 0xa3, 0xe9, 0x96, 0xd0, 0x2c, 0x61, 0x51, 0x3b, 0x4c, 0xe1, 0x71, 0xff, 0x0f, 0x8b,
+	0xc3, 0x34, 0x21, 0x81, 0x4f, 0x18, 0x7f, 0x44, 0x19, 0x23, 0x67, 0xc5, 0x45, 0x08, 0xfa, 0x9c,
+	0xfe, 0xcc, 0xf5, 0x25, 0xf2, 0xb7, 0xfb, 0x39, 0xd8, 0xf5, 0xed, 0xda, 0xb4, 0x7f, 0x01, 0xa4,
+	0xd4, 0x0f, 0x37, 0xa1, 0x8c, 0xf1, 0x8e, 0x4c, 0x54, 0x43, 0xe3, 0xfe, 0x02, 0xe8, 0x88, 0xf2,
+	0x55, 0x16, 0x84, 0xfc, 0x9b, 0xe4, 0xec, 0x42, 0x4f, 0x62, 0xda, 0xda, 0xad, 0xd9, 0xaa, 0xf9,
+	0xa7, 0xd7, 0xcc, 0x3f, 0x7d, 0x93, 0x7f, 0x9e, 0xc0, 0xf5, 0xd2, 0xdd, 0x5b, 0xfa, 0x27, 0x3e,
+	0x37, 0xb8, 0x53, 0xd3, 0xff, 0x4a, 0x2a, 0x71, 0xbe, 0xd8, 0x42, 0x3f, 0x09, 0xec, 0x1d, 0x51,
+	0x8e, 0xe9, 0x79, 0x48, 0x7f, 0x7a, 0x9c, 0xd1, 0x8c, 0x1a, 0x25, 0x8b, 0x71, 0xc2, 0x69, 0x5e,
+	0xb2, 0xa4, 0x20, 0xfc, 0xf9, 0x2a, 0x8c, 0x73, 0x43, 0xe4, 0xef, 0x4b, 0x5a, 0xf1, 0x18, 0xe6,
+	0xd5, 0x0b, 0x8b, 0x84, 0x1d, 0xf8, 0x92, 0x8a, 0x72, 0x0e, 0x55, 0x9b, 0xee, 0x13, 0x46, 0xb1,
+	0x5a, 0x69, 0xb1, 0x81, 0x80, 0x8d, 0x29, 0x4b, 0xa2, 0x73, 0x6a, 0x7c, 0xa1, 0xcd, 0x58, 0xc0,
+	0x48, 0x7c, 0xba, 0x7d, 0x98, 0xa1, 0x10, 0x8f, 0x83, 0xad, 0x7d, 0x5d, 0xd3, 0xbe, 0xb6, 0x1c,
+	0xfe, 0x02, 0x96, 0x0d, 0x57, 0x14, 0xa9, 0xdc, 0x17, 0x87, 0xca, 0x0b, 0x2a, 0xb8, 0xe5, 0x82,
+	0xfb, 0x5b, 0x17, 0x26, 0x46, 0x49, 0x46, 0x57, 0xa0, 0x5b, 0xe0, 0xe9, 0x86, 0x81, 0x00, 0x99,
+	0x31, 0x15, 0x3d, 0x3a, 0xe8, 0x33, 0x96, 0xa7, 0x73, 0x1c, 0xfa, 0xaf, 0x62, 0xb2, 0xa6, 0x1a,
+	0x50, 0x21, 0x4b, 0xf7, 0xd2, 0x73, 0x1a, 0x15, 0xee, 0x15, 0x82, 0x34, 0x80, 0xf0, 0x30, 0x3e,
+	0xb3, 0x07, 0xfb, 0x9d, 0x83, 0x01, 0xd6, 0x52, 0x91, 0xb3, 0xc3, 0x86, 0x9c, 0x1d, 0x19, 0x35,
+	0xa1, 0xca, 0x9a, 0xe3, 0x1a, 0x6b, 0x56, 0x99, 0xcb, 0xaa, 0x32, 0x17, 0xba, 0x09, 0xe0, 0xa7,
+	0x94, 0x88, 0x2d, 0x84, 0xdb, 0x20, 0xd7, 0x2d, 0xad, 0x59, 0x71, 0xf7, 0xaf, 0x0e, 0xec, 0x98,
+	0xd5, 0xbd, 0xe6, 0x0e, 0xf9, 0x08, 0xeb, 0xa4, 0x78, 0x1b, 0x2d, 0x09, 0x7d, 0x40, 0xcf, 0x43,
+	0x3f, 0xf7, 0x85, 0x96, 0x44, 0xd2, 0xfa, 0x49, 0xcc, 0xd3, 0x24, 0x8a, 0x68, 0x2a, 0xdd, 0x31,
+	0xc6, 0x86, 0xa6, 0x82, 0x67, 0x50, 0xc1, 0x23, 0xb2, 0xd7, 0x17, 0xa4, 0x22, 0x57, 0x87, 0x72,
+	0x75, 0xac, 0x14, 0x2b, 0xee, 0xfe, 0xd1, 0x05, 0xd8, 0xd6, 0xf2, 0x1a, 0xd4, 0x77, 0x44, 0x11,
+	0xcb, 0x22, 0xbe, 0x8d, 0x22, 0x21, 0x95, 0xd8, 0xb9, 0x5f, 0x61, 0xe7, 0x6b, 0xd0, 0x3b, 0xa5,
+	0x0a, 0xdd, 0x14, 0x8b, 0x9f, 0xc8, 0x86, 0xd1, 0x26, 0x0d, 0xcf, 0xc5, 0xe9, 0x43, 0x69, 0x53,
+	0x2e, 0xa2, 0xdb, 0x30, 0xe5, 0x49, 0x96, 0x8a, 0x30, 0x88, 0xf9, 0x49, 0xa8, 0x5e, 0xd0, 0xc2,
+	0x3b, 0x5b, 0xe5, 0x71, 0x20, 0xac, 0x2e, 0x48, 0x89, 0xd9, 0xe3, 0xfd, 0xde, 0x81, 0x85, 0xad,
+	0x9c, 0x95, 0x58, 0xc5, 0x29, 0x56, 0xd5, 0x29, 0x4b, 0x18, 0xd3, 0x38, 0x38, 0xe1, 0xe1, 0x9a,
+	0xea, 0x17, 0x1c, 0xd1, 0x38, 0x78, 0x1a, 0xae, 0xa9, 0x68, 0x3d, 0xa6, 0xa5, 0x2e, 0xa1, 0xe6,
+	0x15, 0x71, 0x75, 0x9a, 0x04, 0x99, 0xcf, 0xb7, 0x21, 0x6d, 0x69, 0x8d, 0x4a, 0xbd, 0x4d, 0x9a,
+	0x3f, 0xe3, 0x14, 0x2b, 0xe1, 0x9d, 0xce, 0x29, 0xdc, 0x3c, 0x30, 0xdd, 0x5c, 0x36, 0x61, 0x58,
+	0x8d, 0xb3, 0xb7, 0x1d, 0x98, 0xe9, 0xd2, 0xb5, 0xed, 0x4e, 0x6a, 0x58, 0x97, 0x30, 0x96, 0x1d,
+	0xbc, 0x41, 0xcf, 0x52, 0xae, 0x14, 0xd3, 0x5e, 0x6b, 0x31, 0xed, 0xb7, 0x14, 0xd3, 0x81, 0x49,
+	0x20, 0xef, 0xc3, 0xfa, 0x7b, 0xce, 0x10, 0x8a, 0xb5, 0x2f, 0x83, 0xf2, 0x26, 0x80, 0x5a, 0x32,
+	0x58, 0xc2, 0x92, 0x9a, 0x6f, 0x05, 0x4d, 0xcc, 0x61, 0xb8, 0xa6, 0xfc, 0x65, 0x12, 0xe4, 0xd5,
+	0x5d, 0x49, 0xe5, 0x9a, 0x35, 0x78, 0x47, 0xcd, 0x1a, 0xb6, 0xd5, 0xe7, 0x51, 0xc9, 0x40, 0x1b,
+	0x46, 0xa9, 0xe2, 0x5c, 0xc9, 0x19, 0x16, 0xce, 0x45, 0xf1, 0x78, 0x34, 0x4d, 0x93, 0x54, 0x06,
+	0x99, 0x85, 0x95, 0xf0, 0x3e, 0x92, 0xf8, 0xbb, 0x0b, 0xb0, 0xe5, 0xd1, 0x9a, 0x3f, 0x9a, 0xea,
+	0x50, 0x39, 0xe0, 0x7b, 0xd5, 0x80, 0xbf, 0x01, 0x56, 0x6e, 0x93, 0xea, 0x5e, 0x2d, 0x3c, 0xd6,
+	0x46, 0xc9, 0xc2, 0xc2, 0xfc, 0x24, 0x55, 0x01, 0xd6, 0xc1, 0x4a, 0x10, 0x36, 0x05, 0x94, 0x93,
+	0x30, 0x62, 0xb9, 0x17, 0xb4, 0xb8, 0x0d, 0xc8, 0x91, 0x19, 0x90, 0xb7, 0x60, 0x92, 0x4a, 0xcc,
+	0xca, 0xab, 0xca, 0x0f, 0x90, 0xab, 0x8e, 0x25, 0xec, 0x58, 0xf0, 0x9a, 0xf2, 0x84, 0xfc, 0x6d,
+	0x7c, 0x64, 0x78, 0x22, 0xff, 0x48, 0xa4, 0x62, 0xd9, 0x53, 0x93, 0x6a, 0xa6, 0xde, 0x04, 0xc8,
+	0x36, 0x41, 0xbe, 0xbc, 0xa3, 0x96, 0xb5, 0x66, 0xc5, 0xef, 0xbc, 0x1d, 0x16, 0x6c, 0x9b, 0x4a,
+	0xb6, 0xbc, 0x07, 0xd3, 0xd2, 0x1c, 0x89, 0xf6, 0xbc, 0xa6, 0x99, 0xd5, 0x99, 0x7b, 0xcd, 0xe3,
+	0xe6, 0x43, 0x98, 0xd5, 0x26, 0x39, 0xb4, 0xf4, 0xda, 0x86, 0x48, 0xc7, 0xf1, 0xda, 0x07, 0xbf,
+	0x07, 0x70, 0xb5, 0x32, 0x52, 0xa1, 0x85, 0xd7, 0x3c, 0xc9, 0x39, 0xb6, 0xd7, 0x36, 0x7d, 0x99,
+	0xa7, 0xa8, 0x51, 0xc8, 0x3c, 0xa5, 0x34, 0x54, 0x39, 0x76, 0x7d, 0x41, 0x9f, 0x72, 0x07, 0xac,
+	0x62, 0x88, 0x40, 0x33, 0xaf, 0x3a, 0xb5, 0x38, 0xc8, 0xab, 0xcf, 0x18, 0x77, 0x01, 0xb6, 0xad,
+	0x3d, 0x42, 0x5e, 0x6d, 0x9a, 0x70, 0xae, 0x7b, 0x0d, 0xbd, 0xff, 0x3d, 0x98, 0x2a, 0x46, 0xd2,
+	0xf4, 0x84, 0xf6, 0xbc, 0xa6, 0x56, 0xdf, 0x99, 0x7b, 0xcd, 0x0d, 0xf8, 0x03, 0xb8, 0x5a, 0xe9,
+	0x85, 0xd1, 0xc2, 0x6b, 0x6e, 0xb5, 0x1d, 0xdb, 0x6b, 0x69, 0x9b, 0xd1, 0x11, 0x5c, 0xab, 0xf6,
+	0xc1, 0xc8, 0xf6, 0x5a, 0x3a, 0x69, 0x67, 0xe9, 0xb5, 0x36, 0xcd, 0x9f, 0xc1, 0xc4, 0x68, 0x4c,
+	0xd1, 0x75, 0xaf, 0xde, 0x22, 0x3b, 0xbb, 0x5e, 0x53, 0xef, 0xba, 0x82, 0x2b, 0xe5, 0x66, 0x10,
+	0xcd, 0xbd, 0xc6, 0x76, 0xd4, 0x59, 0x78, 0x2d, 0x5d, 0xe3, 0x43, 0x98, 0xd5, 0x3a, 0x33, 0xb4,
+	0xf4, 0xda, 0x1a, 0x42, 0xc7, 0xf1, 0x5a, 0x1b, 0xb9, 0xc3, 0xe1, 0xf3, 0xfe, 0x19, 0x59, 0xd3,
+	0xd3, 0xa1, 0xfc, 0x37, 0xe7, 0xe3, 0x7f, 0x06, 0x00, 0xed, 0x1b, 0xbb, 0x66, 0xe2, 0x11, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	SearchPlayers(ctx context.Context, in *SearchPlayersRequest, opts ...grpc.CallOption) (*SearchPlayersResponse, error)
+	GetPlayerSessions(ctx context.Context, in *GetPlayerSessionsRequest, opts ...grpc.CallOption) (*GetPlayerSessionsResponse, error)
+	GetPlayerTables(ctx context.Context, in *GetPlayerTablesRequest, opts ...grpc.CallOption) (*GetPlayerTablesResponse, error)
+	GetPlayerWallet(ctx context.Context, in *GetPlayerWalletRequest, opts ...grpc.CallOption) (*GetPlayerWalletResponse, error)
+	BanPlayer(ctx context.Context, in *BanPlayerRequest, opts ...grpc.CallOption) (*BanPlayerResponse, error)
+	MutePlayer(ctx context.Context, in *MutePlayerRequest, opts ...grpc.CallOption) (*MutePlayerResponse, error)
+	AdjustBalance(ctx context.Context, in *AdjustBalanceRequest, opts ...grpc.CallOption) (*AdjustBalanceResponse, error)
+	ForceCloseTable(ctx context.Context, in *ForceCloseTableRequest, opts ...grpc.CallOption) (*ForceCloseTableResponse, error)
+	BroadcastMessage(ctx context.Context, in *BroadcastMessageRequest, opts ...grpc.CallOption) (*BroadcastMessageResponse, error)
+	GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error)
+	GetReviewQueue(ctx context.Context, in *GetReviewQueueRequest, opts ...grpc.CallOption) (*GetReviewQueueResponse, error)
+	ResolveReviewCase(ctx context.Context, in *ResolveReviewCaseRequest, opts ...grpc.CallOption) (*ResolveReviewCaseResponse, error)
+}
+
+type adminServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAdminServiceClient(cc *grpc.ClientConn) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) SearchPlayers(ctx context.Context, in *SearchPlayersRequest, opts ...grpc.CallOption) (*SearchPlayersResponse, error) {
+	out := new(SearchPlayersResponse)
+	err := c.cc.Invoke(ctx, "/AdminService/SearchPlayers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetPlayerSessions(ctx context.Context, in *GetPlayerSessionsRequest, opts ...grpc.CallOption) (*GetPlayerSessionsResponse, error) {
+	out := new(GetPlayerSessionsResponse)
+	err := c.cc.Invoke(ctx, "/AdminService/GetPlayerSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetPlayerTables(ctx context.Context, in *GetPlayerTablesRequest, opts ...grpc.CallOption) (*GetPlayerTablesResponse, error) {
+	out := new(GetPlayerTablesResponse)
+	err := c.cc.Invoke(ctx, "/AdminService/GetPlayerTables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetPlayerWallet(ctx context.Context, in *GetPlayerWalletRequest, opts ...grpc.CallOption) (*GetPlayerWalletResponse, error) {
+	out := new(GetPlayerWalletResponse)
+	err := c.cc.Invoke(ctx, "/AdminService/GetPlayerWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) BanPlayer(ctx context.Context, in *BanPlayerRequest, opts ...grpc.CallOption) (*BanPlayerResponse, error) {
+	out := new(BanPlayerResponse)
+	err := c.cc.Invoke(ctx, "/AdminService/BanPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) MutePlayer(ctx context.Context, in *MutePlayerRequest, opts ...grpc.CallOption) (*MutePlayerResponse, error) {
+	out := new(MutePlayerResponse)
+	err := c.cc.Invoke(ctx, "/AdminService/MutePlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AdjustBalance(ctx context.Context, in *AdjustBalanceRequest, opts ...grpc.CallOption) (*AdjustBalanceResponse, error) {
+	out := new(AdjustBalanceResponse)
+	err := c.cc.Invoke(ctx, "/AdminService/AdjustBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ForceCloseTable(ctx context.Context, in *ForceCloseTableRequest, opts ...grpc.CallOption) (*ForceCloseTableResponse, error) {
+	out := new(ForceCloseTableResponse)
+	err := c.cc.Invoke(ctx, "/AdminService/ForceCloseTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) BroadcastMessage(ctx context.Context, in *BroadcastMessageRequest, opts ...grpc.CallOption) (*BroadcastMessageResponse, error) {
+	out := new(BroadcastMessageResponse)
+	err := c.cc.Invoke(ctx, "/AdminService/BroadcastMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error) {
+	out := new(GetAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/AdminService/GetAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetReviewQueue(ctx context.Context, in *GetReviewQueueRequest, opts ...grpc.CallOption) (*GetReviewQueueResponse, error) {
+	out := new(GetReviewQueueResponse)
+	err := c.cc.Invoke(ctx, "/AdminService/GetReviewQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ResolveReviewCase(ctx context.Context, in *ResolveReviewCaseRequest, opts ...grpc.CallOption) (*ResolveReviewCaseResponse, error) {
+	out := new(ResolveReviewCaseResponse)
+	err := c.cc.Invoke(ctx, "/AdminService/ResolveReviewCase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+type AdminServiceServer interface {
+	SearchPlayers(context.Context, *SearchPlayersRequest) (*SearchPlayersResponse, error)
+	GetPlayerSessions(context.Context, *GetPlayerSessionsRequest) (*GetPlayerSessionsResponse, error)
+	GetPlayerTables(context.Context, *GetPlayerTablesRequest) (*GetPlayerTablesResponse, error)
+	GetPlayerWallet(context.Context, *GetPlayerWalletRequest) (*GetPlayerWalletResponse, error)
+	BanPlayer(context.Context, *BanPlayerRequest) (*BanPlayerResponse, error)
+	MutePlayer(context.Context, *MutePlayerRequest) (*MutePlayerResponse, error)
+	AdjustBalance(context.Context, *AdjustBalanceRequest) (*AdjustBalanceResponse, error)
+	ForceCloseTable(context.Context, *ForceCloseTableRequest) (*ForceCloseTableResponse, error)
+	BroadcastMessage(context.Context, *BroadcastMessageRequest) (*BroadcastMessageResponse, error)
+	GetAuditLog(context.Context, *GetAuditLogRequest) (*GetAuditLogResponse, error)
+	GetReviewQueue(context.Context, *GetReviewQueueRequest) (*GetReviewQueueResponse, error)
+	ResolveReviewCase(context.Context, *ResolveReviewCaseRequest) (*ResolveReviewCaseResponse, error)
+}
+
+func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
+	s.RegisterService(&_AdminService_serviceDesc, srv)
+}
+
+func _AdminService_SearchPlayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPlayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SearchPlayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/SearchPlayers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SearchPlayers(ctx, req.(*SearchPlayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetPlayerSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetPlayerSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/GetPlayerSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetPlayerSessions(ctx, req.(*GetPlayerSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetPlayerTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerTablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetPlayerTables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/GetPlayerTables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetPlayerTables(ctx, req.(*GetPlayerTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetPlayerWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetPlayerWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/GetPlayerWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetPlayerWallet(ctx, req.(*GetPlayerWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_BanPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).BanPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/BanPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).BanPlayer(ctx, req.(*BanPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_MutePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MutePlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).MutePlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/MutePlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).MutePlayer(ctx, req.(*MutePlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AdjustBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AdjustBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/AdjustBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AdjustBalance(ctx, req.(*AdjustBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ForceCloseTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceCloseTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ForceCloseTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/ForceCloseTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ForceCloseTable(ctx, req.(*ForceCloseTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_BroadcastMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).BroadcastMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/BroadcastMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).BroadcastMessage(ctx, req.(*BroadcastMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/GetAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetAuditLog(ctx, req.(*GetAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetReviewQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetReviewQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/GetReviewQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetReviewQueue(ctx, req.(*GetReviewQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResolveReviewCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReviewCaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResolveReviewCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/ResolveReviewCase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResolveReviewCase(ctx, req.(*ResolveReviewCaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AdminService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SearchPlayers",
+			Handler:    _AdminService_SearchPlayers_Handler,
+		},
+		{
+			MethodName: "GetPlayerSessions",
+			Handler:    _AdminService_GetPlayerSessions_Handler,
+		},
+		{
+			MethodName: "GetPlayerTables",
+			Handler:    _AdminService_GetPlayerTables_Handler,
+		},
+		{
+			MethodName: "GetPlayerWallet",
+			Handler:    _AdminService_GetPlayerWallet_Handler,
+		},
+		{
+			MethodName: "BanPlayer",
+			Handler:    _AdminService_BanPlayer_Handler,
+		},
+		{
+			MethodName: "MutePlayer",
+			Handler:    _AdminService_MutePlayer_Handler,
+		},
+		{
+			MethodName: "AdjustBalance",
+			Handler:    _AdminService_AdjustBalance_Handler,
+		},
+		{
+			MethodName: "ForceCloseTable",
+			Handler:    _AdminService_ForceCloseTable_Handler,
+		},
+		{
+			MethodName: "BroadcastMessage",
+			Handler:    _AdminService_BroadcastMessage_Handler,
+		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _AdminService_GetAuditLog_Handler,
+		},
+		{
+			MethodName: "GetReviewQueue",
+			Handler:    _AdminService_GetReviewQueue_Handler,
+		},
+		{
+			MethodName: "ResolveReviewCase",
+			Handler:    _AdminService_ResolveReviewCase_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/admin.proto",
+}
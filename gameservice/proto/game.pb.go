@@ -23,7 +23,10 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type OpenSessionRequest struct {
-	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// keep sessions of player's other devices open
+	MultiDevice          bool     `protobuf:"varint,2,opt,name=multi_device,json=multiDevice,proto3" json:"multi_device,omitempty"`
+	Device               string   `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -61,13 +64,30 @@ func (m *OpenSessionRequest) GetToken() string {
 	return ""
 }
 
+func (m *OpenSessionRequest) GetMultiDevice() bool {
+	if m != nil {
+		return m.MultiDevice
+	}
+	return false
+}
+
+func (m *OpenSessionRequest) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
+
 type OpenSessionResponse struct {
-	SessionId            string   `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Player               *Player  `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
-	TableId              string   `protobuf:"bytes,3,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SessionId            string          `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Player               *Player         `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+	TableId              string          `protobuf:"bytes,3,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	PendingRewards       *PendingRewards `protobuf:"bytes,4,opt,name=pending_rewards,json=pendingRewards,proto3" json:"pending_rewards,omitempty"`
+	Notifications        []*Notification `protobuf:"bytes,5,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	SeatController       bool            `protobuf:"varint,6,opt,name=seat_controller,json=seatController,proto3" json:"seat_controller,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *OpenSessionResponse) Reset()         { *m = OpenSessionResponse{} }
@@ -116,6 +136,27 @@ func (m *OpenSessionResponse) GetTableId() string {
 	return ""
 }
 
+func (m *OpenSessionResponse) GetPendingRewards() *PendingRewards {
+	if m != nil {
+		return m.PendingRewards
+	}
+	return nil
+}
+
+func (m *OpenSessionResponse) GetNotifications() []*Notification {
+	if m != nil {
+		return m.Notifications
+	}
+	return nil
+}
+
+func (m *OpenSessionResponse) GetSeatController() bool {
+	if m != nil {
+		return m.SeatController
+	}
+	return false
+}
+
 type CloseSessionRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -186,1325 +227,6440 @@ func (m *CloseSessionResponse) GetSessionId() string {
 	return ""
 }
 
-type ChangePasswordRequest struct {
-	OldPassword          string   `protobuf:"bytes,1,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
-	NewPassword          string   `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+type Session struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Device               string   `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Controller           bool     `protobuf:"varint,4,opt,name=controller,proto3" json:"controller,omitempty"`
+	Current              bool     `protobuf:"varint,5,opt,name=current,proto3" json:"current,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChangePasswordRequest) Reset()         { *m = ChangePasswordRequest{} }
-func (m *ChangePasswordRequest) String() string { return proto.CompactTextString(m) }
-func (*ChangePasswordRequest) ProtoMessage()    {}
-func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+func (m *Session) Reset()         { *m = Session{} }
+func (m *Session) String() string { return proto.CompactTextString(m) }
+func (*Session) ProtoMessage()    {}
+func (*Session) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5309ac3f9cbe5f84, []int{4}
 }
 
-func (m *ChangePasswordRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChangePasswordRequest.Unmarshal(m, b)
+func (m *Session) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Session.Unmarshal(m, b)
 }
-func (m *ChangePasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChangePasswordRequest.Marshal(b, m, deterministic)
+func (m *Session) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Session.Marshal(b, m, deterministic)
 }
-func (m *ChangePasswordRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangePasswordRequest.Merge(m, src)
+func (m *Session) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Session.Merge(m, src)
 }
-func (m *ChangePasswordRequest) XXX_Size() int {
-	return xxx_messageInfo_ChangePasswordRequest.Size(m)
+func (m *Session) XXX_Size() int {
+	return xxx_messageInfo_Session.Size(m)
 }
-func (m *ChangePasswordRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangePasswordRequest.DiscardUnknown(m)
+func (m *Session) XXX_DiscardUnknown() {
+	xxx_messageInfo_Session.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChangePasswordRequest proto.InternalMessageInfo
+var xxx_messageInfo_Session proto.InternalMessageInfo
 
-func (m *ChangePasswordRequest) GetOldPassword() string {
+func (m *Session) GetId() string {
 	if m != nil {
-		return m.OldPassword
+		return m.Id
 	}
 	return ""
 }
 
-func (m *ChangePasswordRequest) GetNewPassword() string {
+func (m *Session) GetDevice() string {
 	if m != nil {
-		return m.NewPassword
+		return m.Device
 	}
 	return ""
 }
 
-type ChangePasswordResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Session) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
 }
 
-func (m *ChangePasswordResponse) Reset()         { *m = ChangePasswordResponse{} }
-func (m *ChangePasswordResponse) String() string { return proto.CompactTextString(m) }
-func (*ChangePasswordResponse) ProtoMessage()    {}
-func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5309ac3f9cbe5f84, []int{5}
+func (m *Session) GetController() bool {
+	if m != nil {
+		return m.Controller
+	}
+	return false
 }
 
-func (m *ChangePasswordResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChangePasswordResponse.Unmarshal(m, b)
-}
-func (m *ChangePasswordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChangePasswordResponse.Marshal(b, m, deterministic)
-}
-func (m *ChangePasswordResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangePasswordResponse.Merge(m, src)
-}
-func (m *ChangePasswordResponse) XXX_Size() int {
-	return xxx_messageInfo_ChangePasswordResponse.Size(m)
-}
-func (m *ChangePasswordResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangePasswordResponse.DiscardUnknown(m)
+func (m *Session) GetCurrent() bool {
+	if m != nil {
+		return m.Current
+	}
+	return false
 }
 
-var xxx_messageInfo_ChangePasswordResponse proto.InternalMessageInfo
-
-type GetProductsRequest struct {
+type ListSessionsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetProductsRequest) Reset()         { *m = GetProductsRequest{} }
-func (m *GetProductsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetProductsRequest) ProtoMessage()    {}
-func (*GetProductsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5309ac3f9cbe5f84, []int{6}
+func (m *ListSessionsRequest) Reset()         { *m = ListSessionsRequest{} }
+func (m *ListSessionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSessionsRequest) ProtoMessage()    {}
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{5}
 }
 
-func (m *GetProductsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetProductsRequest.Unmarshal(m, b)
+func (m *ListSessionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSessionsRequest.Unmarshal(m, b)
 }
-func (m *GetProductsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetProductsRequest.Marshal(b, m, deterministic)
+func (m *ListSessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSessionsRequest.Marshal(b, m, deterministic)
 }
-func (m *GetProductsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetProductsRequest.Merge(m, src)
+func (m *ListSessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSessionsRequest.Merge(m, src)
 }
-func (m *GetProductsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetProductsRequest.Size(m)
+func (m *ListSessionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSessionsRequest.Size(m)
 }
-func (m *GetProductsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetProductsRequest.DiscardUnknown(m)
+func (m *ListSessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSessionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetProductsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListSessionsRequest proto.InternalMessageInfo
 
-type GetProductsResponse struct {
-	Products             []*Product `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+type ListSessionsResponse struct {
+	Sessions             []*Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *GetProductsResponse) Reset()         { *m = GetProductsResponse{} }
-func (m *GetProductsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetProductsResponse) ProtoMessage()    {}
-func (*GetProductsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5309ac3f9cbe5f84, []int{7}
+func (m *ListSessionsResponse) Reset()         { *m = ListSessionsResponse{} }
+func (m *ListSessionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSessionsResponse) ProtoMessage()    {}
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{6}
 }
 
-func (m *GetProductsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetProductsResponse.Unmarshal(m, b)
+func (m *ListSessionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSessionsResponse.Unmarshal(m, b)
 }
-func (m *GetProductsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetProductsResponse.Marshal(b, m, deterministic)
+func (m *ListSessionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSessionsResponse.Marshal(b, m, deterministic)
 }
-func (m *GetProductsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetProductsResponse.Merge(m, src)
+func (m *ListSessionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSessionsResponse.Merge(m, src)
 }
-func (m *GetProductsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetProductsResponse.Size(m)
+func (m *ListSessionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSessionsResponse.Size(m)
 }
-func (m *GetProductsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetProductsResponse.DiscardUnknown(m)
+func (m *ListSessionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSessionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetProductsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListSessionsResponse proto.InternalMessageInfo
 
-func (m *GetProductsResponse) GetProducts() []*Product {
+func (m *ListSessionsResponse) GetSessions() []*Session {
 	if m != nil {
-		return m.Products
+		return m.Sessions
 	}
 	return nil
 }
 
-type PurchaseProductRequest struct {
-	ProductId            string   `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+type TerminateSessionRequest struct {
+	SessionId            string   `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PurchaseProductRequest) Reset()         { *m = PurchaseProductRequest{} }
-func (m *PurchaseProductRequest) String() string { return proto.CompactTextString(m) }
-func (*PurchaseProductRequest) ProtoMessage()    {}
-func (*PurchaseProductRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5309ac3f9cbe5f84, []int{8}
+func (m *TerminateSessionRequest) Reset()         { *m = TerminateSessionRequest{} }
+func (m *TerminateSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*TerminateSessionRequest) ProtoMessage()    {}
+func (*TerminateSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{7}
 }
 
-func (m *PurchaseProductRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PurchaseProductRequest.Unmarshal(m, b)
+func (m *TerminateSessionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TerminateSessionRequest.Unmarshal(m, b)
 }
-func (m *PurchaseProductRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PurchaseProductRequest.Marshal(b, m, deterministic)
+func (m *TerminateSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TerminateSessionRequest.Marshal(b, m, deterministic)
 }
-func (m *PurchaseProductRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PurchaseProductRequest.Merge(m, src)
+func (m *TerminateSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerminateSessionRequest.Merge(m, src)
 }
-func (m *PurchaseProductRequest) XXX_Size() int {
-	return xxx_messageInfo_PurchaseProductRequest.Size(m)
+func (m *TerminateSessionRequest) XXX_Size() int {
+	return xxx_messageInfo_TerminateSessionRequest.Size(m)
 }
-func (m *PurchaseProductRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PurchaseProductRequest.DiscardUnknown(m)
+func (m *TerminateSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerminateSessionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PurchaseProductRequest proto.InternalMessageInfo
+var xxx_messageInfo_TerminateSessionRequest proto.InternalMessageInfo
 
-func (m *PurchaseProductRequest) GetProductId() string {
+func (m *TerminateSessionRequest) GetSessionId() string {
 	if m != nil {
-		return m.ProductId
+		return m.SessionId
 	}
 	return ""
 }
 
-type PurchaseProductResponse struct {
+type TerminateSessionResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PurchaseProductResponse) Reset()         { *m = PurchaseProductResponse{} }
-func (m *PurchaseProductResponse) String() string { return proto.CompactTextString(m) }
-func (*PurchaseProductResponse) ProtoMessage()    {}
-func (*PurchaseProductResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5309ac3f9cbe5f84, []int{9}
+func (m *TerminateSessionResponse) Reset()         { *m = TerminateSessionResponse{} }
+func (m *TerminateSessionResponse) String() string { return proto.CompactTextString(m) }
+func (*TerminateSessionResponse) ProtoMessage()    {}
+func (*TerminateSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{8}
 }
 
-func (m *PurchaseProductResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PurchaseProductResponse.Unmarshal(m, b)
+func (m *TerminateSessionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TerminateSessionResponse.Unmarshal(m, b)
 }
-func (m *PurchaseProductResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PurchaseProductResponse.Marshal(b, m, deterministic)
+func (m *TerminateSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TerminateSessionResponse.Marshal(b, m, deterministic)
 }
-func (m *PurchaseProductResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PurchaseProductResponse.Merge(m, src)
+func (m *TerminateSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerminateSessionResponse.Merge(m, src)
 }
-func (m *PurchaseProductResponse) XXX_Size() int {
-	return xxx_messageInfo_PurchaseProductResponse.Size(m)
+func (m *TerminateSessionResponse) XXX_Size() int {
+	return xxx_messageInfo_TerminateSessionResponse.Size(m)
 }
-func (m *PurchaseProductResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PurchaseProductResponse.DiscardUnknown(m)
+func (m *TerminateSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerminateSessionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PurchaseProductResponse proto.InternalMessageInfo
+var xxx_messageInfo_TerminateSessionResponse proto.InternalMessageInfo
 
-type Product struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title                string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price                uint32   `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
-	Currency             string   `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+type TakeSeatControlRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Product) Reset()         { *m = Product{} }
-func (m *Product) String() string { return proto.CompactTextString(m) }
-func (*Product) ProtoMessage()    {}
-func (*Product) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5309ac3f9cbe5f84, []int{10}
+func (m *TakeSeatControlRequest) Reset()         { *m = TakeSeatControlRequest{} }
+func (m *TakeSeatControlRequest) String() string { return proto.CompactTextString(m) }
+func (*TakeSeatControlRequest) ProtoMessage()    {}
+func (*TakeSeatControlRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{9}
 }
 
-func (m *Product) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Product.Unmarshal(m, b)
+func (m *TakeSeatControlRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TakeSeatControlRequest.Unmarshal(m, b)
 }
-func (m *Product) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Product.Marshal(b, m, deterministic)
+func (m *TakeSeatControlRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TakeSeatControlRequest.Marshal(b, m, deterministic)
 }
-func (m *Product) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Product.Merge(m, src)
+func (m *TakeSeatControlRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakeSeatControlRequest.Merge(m, src)
 }
-func (m *Product) XXX_Size() int {
-	return xxx_messageInfo_Product.Size(m)
+func (m *TakeSeatControlRequest) XXX_Size() int {
+	return xxx_messageInfo_TakeSeatControlRequest.Size(m)
 }
-func (m *Product) XXX_DiscardUnknown() {
-	xxx_messageInfo_Product.DiscardUnknown(m)
+func (m *TakeSeatControlRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakeSeatControlRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Product proto.InternalMessageInfo
+var xxx_messageInfo_TakeSeatControlRequest proto.InternalMessageInfo
 
-func (m *Product) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
+type TakeSeatControlResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Product) GetTitle() string {
-	if m != nil {
-		return m.Title
-	}
-	return ""
+func (m *TakeSeatControlResponse) Reset()         { *m = TakeSeatControlResponse{} }
+func (m *TakeSeatControlResponse) String() string { return proto.CompactTextString(m) }
+func (*TakeSeatControlResponse) ProtoMessage()    {}
+func (*TakeSeatControlResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{10}
 }
 
-func (m *Product) GetDescription() string {
-	if m != nil {
-		return m.Description
-	}
-	return ""
+func (m *TakeSeatControlResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TakeSeatControlResponse.Unmarshal(m, b)
 }
-
-func (m *Product) GetPrice() uint32 {
-	if m != nil {
-		return m.Price
-	}
-	return 0
+func (m *TakeSeatControlResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TakeSeatControlResponse.Marshal(b, m, deterministic)
 }
-
-func (m *Product) GetCurrency() string {
-	if m != nil {
-		return m.Currency
-	}
-	return ""
+func (m *TakeSeatControlResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakeSeatControlResponse.Merge(m, src)
+}
+func (m *TakeSeatControlResponse) XXX_Size() int {
+	return xxx_messageInfo_TakeSeatControlResponse.Size(m)
+}
+func (m *TakeSeatControlResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakeSeatControlResponse.DiscardUnknown(m)
 }
 
-type CreateTableRequest struct {
-	Currency             string   `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
-	Bet                  uint32   `protobuf:"varint,2,opt,name=bet,proto3" json:"bet,omitempty"`
+var xxx_messageInfo_TakeSeatControlResponse proto.InternalMessageInfo
+
+type ChangePasswordRequest struct {
+	OldPassword          string   `protobuf:"bytes,1,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword          string   `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateTableRequest) Reset()         { *m = CreateTableRequest{} }
-func (m *CreateTableRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateTableRequest) ProtoMessage()    {}
-func (*CreateTableRequest) Descriptor() ([]byte, []int) {
+func (m *ChangePasswordRequest) Reset()         { *m = ChangePasswordRequest{} }
+func (m *ChangePasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangePasswordRequest) ProtoMessage()    {}
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5309ac3f9cbe5f84, []int{11}
 }
 
-func (m *CreateTableRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateTableRequest.Unmarshal(m, b)
+func (m *ChangePasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePasswordRequest.Unmarshal(m, b)
 }
-func (m *CreateTableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateTableRequest.Marshal(b, m, deterministic)
+func (m *ChangePasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePasswordRequest.Marshal(b, m, deterministic)
 }
-func (m *CreateTableRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateTableRequest.Merge(m, src)
+func (m *ChangePasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePasswordRequest.Merge(m, src)
 }
-func (m *CreateTableRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateTableRequest.Size(m)
+func (m *ChangePasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangePasswordRequest.Size(m)
 }
-func (m *CreateTableRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateTableRequest.DiscardUnknown(m)
+func (m *ChangePasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePasswordRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateTableRequest proto.InternalMessageInfo
+var xxx_messageInfo_ChangePasswordRequest proto.InternalMessageInfo
 
-func (m *CreateTableRequest) GetCurrency() string {
+func (m *ChangePasswordRequest) GetOldPassword() string {
 	if m != nil {
-		return m.Currency
+		return m.OldPassword
 	}
 	return ""
 }
 
-func (m *CreateTableRequest) GetBet() uint32 {
+func (m *ChangePasswordRequest) GetNewPassword() string {
 	if m != nil {
-		return m.Bet
+		return m.NewPassword
 	}
-	return 0
+	return ""
 }
 
-type CreateTableResponse struct {
-	TableId              string   `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	UnitType             string   `protobuf:"bytes,2,opt,name=unit_type,json=unitType,proto3" json:"unit_type,omitempty"`
-	Bet                  uint32   `protobuf:"varint,3,opt,name=bet,proto3" json:"bet,omitempty"`
+type ChangePasswordResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateTableResponse) Reset()         { *m = CreateTableResponse{} }
-func (m *CreateTableResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateTableResponse) ProtoMessage()    {}
-func (*CreateTableResponse) Descriptor() ([]byte, []int) {
+func (m *ChangePasswordResponse) Reset()         { *m = ChangePasswordResponse{} }
+func (m *ChangePasswordResponse) String() string { return proto.CompactTextString(m) }
+func (*ChangePasswordResponse) ProtoMessage()    {}
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5309ac3f9cbe5f84, []int{12}
 }
 
-func (m *CreateTableResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateTableResponse.Unmarshal(m, b)
-}
-func (m *CreateTableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateTableResponse.Marshal(b, m, deterministic)
-}
-func (m *CreateTableResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateTableResponse.Merge(m, src)
+func (m *ChangePasswordResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePasswordResponse.Unmarshal(m, b)
 }
-func (m *CreateTableResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateTableResponse.Size(m)
+func (m *ChangePasswordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePasswordResponse.Marshal(b, m, deterministic)
 }
-func (m *CreateTableResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateTableResponse.DiscardUnknown(m)
+func (m *ChangePasswordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePasswordResponse.Merge(m, src)
 }
-
-var xxx_messageInfo_CreateTableResponse proto.InternalMessageInfo
-
-func (m *CreateTableResponse) GetTableId() string {
-	if m != nil {
-		return m.TableId
-	}
-	return ""
+func (m *ChangePasswordResponse) XXX_Size() int {
+	return xxx_messageInfo_ChangePasswordResponse.Size(m)
 }
-
-func (m *CreateTableResponse) GetUnitType() string {
-	if m != nil {
-		return m.UnitType
-	}
-	return ""
+func (m *ChangePasswordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePasswordResponse.DiscardUnknown(m)
 }
 
-func (m *CreateTableResponse) GetBet() uint32 {
-	if m != nil {
-		return m.Bet
-	}
-	return 0
-}
+var xxx_messageInfo_ChangePasswordResponse proto.InternalMessageInfo
 
-type GetOpenTablesRequest struct {
+type UpdateProfileRequest struct {
+	Profile              *Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetOpenTablesRequest) Reset()         { *m = GetOpenTablesRequest{} }
-func (m *GetOpenTablesRequest) String() string { return proto.CompactTextString(m) }
-func (*GetOpenTablesRequest) ProtoMessage()    {}
-func (*GetOpenTablesRequest) Descriptor() ([]byte, []int) {
+func (m *UpdateProfileRequest) Reset()         { *m = UpdateProfileRequest{} }
+func (m *UpdateProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateProfileRequest) ProtoMessage()    {}
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5309ac3f9cbe5f84, []int{13}
 }
 
-func (m *GetOpenTablesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetOpenTablesRequest.Unmarshal(m, b)
+func (m *UpdateProfileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateProfileRequest.Unmarshal(m, b)
 }
-func (m *GetOpenTablesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetOpenTablesRequest.Marshal(b, m, deterministic)
+func (m *UpdateProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateProfileRequest.Marshal(b, m, deterministic)
 }
-func (m *GetOpenTablesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetOpenTablesRequest.Merge(m, src)
+func (m *UpdateProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateProfileRequest.Merge(m, src)
 }
-func (m *GetOpenTablesRequest) XXX_Size() int {
-	return xxx_messageInfo_GetOpenTablesRequest.Size(m)
+func (m *UpdateProfileRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateProfileRequest.Size(m)
 }
-func (m *GetOpenTablesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetOpenTablesRequest.DiscardUnknown(m)
+func (m *UpdateProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateProfileRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetOpenTablesRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpdateProfileRequest proto.InternalMessageInfo
 
-type GetOpenTablesResponse struct {
-	Tables               []*Table `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+func (m *UpdateProfileRequest) GetProfile() *Profile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+type UpdateProfileResponse struct {
+	Profile              *Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetOpenTablesResponse) Reset()         { *m = GetOpenTablesResponse{} }
-func (m *GetOpenTablesResponse) String() string { return proto.CompactTextString(m) }
-func (*GetOpenTablesResponse) ProtoMessage()    {}
-func (*GetOpenTablesResponse) Descriptor() ([]byte, []int) {
+func (m *UpdateProfileResponse) Reset()         { *m = UpdateProfileResponse{} }
+func (m *UpdateProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateProfileResponse) ProtoMessage()    {}
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5309ac3f9cbe5f84, []int{14}
 }
 
-func (m *GetOpenTablesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetOpenTablesResponse.Unmarshal(m, b)
+func (m *UpdateProfileResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateProfileResponse.Unmarshal(m, b)
 }
-func (m *GetOpenTablesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetOpenTablesResponse.Marshal(b, m, deterministic)
+func (m *UpdateProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateProfileResponse.Marshal(b, m, deterministic)
 }
-func (m *GetOpenTablesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetOpenTablesResponse.Merge(m, src)
+func (m *UpdateProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateProfileResponse.Merge(m, src)
 }
-func (m *GetOpenTablesResponse) XXX_Size() int {
-	return xxx_messageInfo_GetOpenTablesResponse.Size(m)
+func (m *UpdateProfileResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateProfileResponse.Size(m)
 }
-func (m *GetOpenTablesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetOpenTablesResponse.DiscardUnknown(m)
+func (m *UpdateProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateProfileResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetOpenTablesResponse proto.InternalMessageInfo
+var xxx_messageInfo_UpdateProfileResponse proto.InternalMessageInfo
 
-func (m *GetOpenTablesResponse) GetTables() []*Table {
+func (m *UpdateProfileResponse) GetProfile() *Profile {
 	if m != nil {
-		return m.Tables
+		return m.Profile
 	}
 	return nil
 }
 
-type JoinTableRequest struct {
-	TableId              string   `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+type SetAvatarRequest struct {
+	// avatar from server catalogue
+	AvatarId string `protobuf:"bytes,1,opt,name=avatar_id,json=avatarId,proto3" json:"avatar_id,omitempty"`
+	// or uploaded png/jpeg image
+	Image                []byte   `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *JoinTableRequest) Reset()         { *m = JoinTableRequest{} }
-func (m *JoinTableRequest) String() string { return proto.CompactTextString(m) }
-func (*JoinTableRequest) ProtoMessage()    {}
-func (*JoinTableRequest) Descriptor() ([]byte, []int) {
+func (m *SetAvatarRequest) Reset()         { *m = SetAvatarRequest{} }
+func (m *SetAvatarRequest) String() string { return proto.CompactTextString(m) }
+func (*SetAvatarRequest) ProtoMessage()    {}
+func (*SetAvatarRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5309ac3f9cbe5f84, []int{15}
 }
 
-func (m *JoinTableRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_JoinTableRequest.Unmarshal(m, b)
+func (m *SetAvatarRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetAvatarRequest.Unmarshal(m, b)
 }
-func (m *JoinTableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_JoinTableRequest.Marshal(b, m, deterministic)
+func (m *SetAvatarRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetAvatarRequest.Marshal(b, m, deterministic)
 }
-func (m *JoinTableRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JoinTableRequest.Merge(m, src)
+func (m *SetAvatarRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAvatarRequest.Merge(m, src)
 }
-func (m *JoinTableRequest) XXX_Size() int {
-	return xxx_messageInfo_JoinTableRequest.Size(m)
+func (m *SetAvatarRequest) XXX_Size() int {
+	return xxx_messageInfo_SetAvatarRequest.Size(m)
 }
-func (m *JoinTableRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_JoinTableRequest.DiscardUnknown(m)
+func (m *SetAvatarRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAvatarRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JoinTableRequest proto.InternalMessageInfo
+var xxx_messageInfo_SetAvatarRequest proto.InternalMessageInfo
 
-func (m *JoinTableRequest) GetTableId() string {
+func (m *SetAvatarRequest) GetAvatarId() string {
 	if m != nil {
-		return m.TableId
+		return m.AvatarId
 	}
 	return ""
 }
 
-type JoinTableResponse struct {
-	Table                *Table   `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+func (m *SetAvatarRequest) GetImage() []byte {
+	if m != nil {
+		return m.Image
+	}
+	return nil
+}
+
+type SetAvatarResponse struct {
+	Avatar               string   `protobuf:"bytes,1,opt,name=avatar,proto3" json:"avatar,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *JoinTableResponse) Reset()         { *m = JoinTableResponse{} }
-func (m *JoinTableResponse) String() string { return proto.CompactTextString(m) }
-func (*JoinTableResponse) ProtoMessage()    {}
-func (*JoinTableResponse) Descriptor() ([]byte, []int) {
+func (m *SetAvatarResponse) Reset()         { *m = SetAvatarResponse{} }
+func (m *SetAvatarResponse) String() string { return proto.CompactTextString(m) }
+func (*SetAvatarResponse) ProtoMessage()    {}
+func (*SetAvatarResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5309ac3f9cbe5f84, []int{16}
 }
 
-func (m *JoinTableResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_JoinTableResponse.Unmarshal(m, b)
+func (m *SetAvatarResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetAvatarResponse.Unmarshal(m, b)
 }
-func (m *JoinTableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_JoinTableResponse.Marshal(b, m, deterministic)
+func (m *SetAvatarResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetAvatarResponse.Marshal(b, m, deterministic)
 }
-func (m *JoinTableResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JoinTableResponse.Merge(m, src)
+func (m *SetAvatarResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAvatarResponse.Merge(m, src)
 }
-func (m *JoinTableResponse) XXX_Size() int {
-	return xxx_messageInfo_JoinTableResponse.Size(m)
+func (m *SetAvatarResponse) XXX_Size() int {
+	return xxx_messageInfo_SetAvatarResponse.Size(m)
 }
-func (m *JoinTableResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_JoinTableResponse.DiscardUnknown(m)
+func (m *SetAvatarResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAvatarResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JoinTableResponse proto.InternalMessageInfo
+var xxx_messageInfo_SetAvatarResponse proto.InternalMessageInfo
 
-func (m *JoinTableResponse) GetTable() *Table {
+func (m *SetAvatarResponse) GetAvatar() string {
 	if m != nil {
-		return m.Table
+		return m.Avatar
 	}
-	return nil
+	return ""
 }
 
-type BecomeParticipantRequest struct {
-	TableId              string   `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	ParticipantId        string   `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+type GetPlayerProfileRequest struct {
+	PlayerId             string   `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BecomeParticipantRequest) Reset()         { *m = BecomeParticipantRequest{} }
-func (m *BecomeParticipantRequest) String() string { return proto.CompactTextString(m) }
-func (*BecomeParticipantRequest) ProtoMessage()    {}
-func (*BecomeParticipantRequest) Descriptor() ([]byte, []int) {
+func (m *GetPlayerProfileRequest) Reset()         { *m = GetPlayerProfileRequest{} }
+func (m *GetPlayerProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPlayerProfileRequest) ProtoMessage()    {}
+func (*GetPlayerProfileRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5309ac3f9cbe5f84, []int{17}
 }
 
-func (m *BecomeParticipantRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BecomeParticipantRequest.Unmarshal(m, b)
+func (m *GetPlayerProfileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPlayerProfileRequest.Unmarshal(m, b)
 }
-func (m *BecomeParticipantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BecomeParticipantRequest.Marshal(b, m, deterministic)
+func (m *GetPlayerProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPlayerProfileRequest.Marshal(b, m, deterministic)
 }
-func (m *BecomeParticipantRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BecomeParticipantRequest.Merge(m, src)
+func (m *GetPlayerProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPlayerProfileRequest.Merge(m, src)
 }
-func (m *BecomeParticipantRequest) XXX_Size() int {
-	return xxx_messageInfo_BecomeParticipantRequest.Size(m)
+func (m *GetPlayerProfileRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPlayerProfileRequest.Size(m)
 }
-func (m *BecomeParticipantRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_BecomeParticipantRequest.DiscardUnknown(m)
+func (m *GetPlayerProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPlayerProfileRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BecomeParticipantRequest proto.InternalMessageInfo
-
-func (m *BecomeParticipantRequest) GetTableId() string {
-	if m != nil {
-		return m.TableId
-	}
-	return ""
-}
+var xxx_messageInfo_GetPlayerProfileRequest proto.InternalMessageInfo
 
-func (m *BecomeParticipantRequest) GetParticipantId() string {
+func (m *GetPlayerProfileRequest) GetPlayerId() string {
 	if m != nil {
-		return m.ParticipantId
+		return m.PlayerId
 	}
 	return ""
 }
 
-type BecomeParticipantResponse struct {
+type GetPlayerProfileResponse struct {
+	Player               *Player  `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BecomeParticipantResponse) Reset()         { *m = BecomeParticipantResponse{} }
-func (m *BecomeParticipantResponse) String() string { return proto.CompactTextString(m) }
-func (*BecomeParticipantResponse) ProtoMessage()    {}
-func (*BecomeParticipantResponse) Descriptor() ([]byte, []int) {
+func (m *GetPlayerProfileResponse) Reset()         { *m = GetPlayerProfileResponse{} }
+func (m *GetPlayerProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPlayerProfileResponse) ProtoMessage()    {}
+func (*GetPlayerProfileResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5309ac3f9cbe5f84, []int{18}
 }
 
-func (m *BecomeParticipantResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BecomeParticipantResponse.Unmarshal(m, b)
+func (m *GetPlayerProfileResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPlayerProfileResponse.Unmarshal(m, b)
 }
-func (m *BecomeParticipantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BecomeParticipantResponse.Marshal(b, m, deterministic)
+func (m *GetPlayerProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPlayerProfileResponse.Marshal(b, m, deterministic)
 }
-func (m *BecomeParticipantResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BecomeParticipantResponse.Merge(m, src)
+func (m *GetPlayerProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPlayerProfileResponse.Merge(m, src)
 }
-func (m *BecomeParticipantResponse) XXX_Size() int {
-	return xxx_messageInfo_BecomeParticipantResponse.Size(m)
+func (m *GetPlayerProfileResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPlayerProfileResponse.Size(m)
 }
-func (m *BecomeParticipantResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BecomeParticipantResponse.DiscardUnknown(m)
+func (m *GetPlayerProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPlayerProfileResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BecomeParticipantResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetPlayerProfileResponse proto.InternalMessageInfo
 
-type ReadyRequest struct {
-	ParticipantId        string   `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+func (m *GetPlayerProfileResponse) GetPlayer() *Player {
+	if m != nil {
+		return m.Player
+	}
+	return nil
+}
+
+type GetFriendsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReadyRequest) Reset()         { *m = ReadyRequest{} }
-func (m *ReadyRequest) String() string { return proto.CompactTextString(m) }
-func (*ReadyRequest) ProtoMessage()    {}
-func (*ReadyRequest) Descriptor() ([]byte, []int) {
+func (m *GetFriendsRequest) Reset()         { *m = GetFriendsRequest{} }
+func (m *GetFriendsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFriendsRequest) ProtoMessage()    {}
+func (*GetFriendsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5309ac3f9cbe5f84, []int{19}
 }
 
-func (m *ReadyRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReadyRequest.Unmarshal(m, b)
+func (m *GetFriendsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFriendsRequest.Unmarshal(m, b)
 }
-func (m *ReadyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReadyRequest.Marshal(b, m, deterministic)
+func (m *GetFriendsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFriendsRequest.Marshal(b, m, deterministic)
 }
-func (m *ReadyRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadyRequest.Merge(m, src)
+func (m *GetFriendsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFriendsRequest.Merge(m, src)
 }
-func (m *ReadyRequest) XXX_Size() int {
-	return xxx_messageInfo_ReadyRequest.Size(m)
+func (m *GetFriendsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetFriendsRequest.Size(m)
 }
-func (m *ReadyRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadyRequest.DiscardUnknown(m)
+func (m *GetFriendsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFriendsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadyRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetFriendsRequest proto.InternalMessageInfo
 
-func (m *ReadyRequest) GetParticipantId() string {
+type GetFriendsResponse struct {
+	Friends              []*Friend `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetFriendsResponse) Reset()         { *m = GetFriendsResponse{} }
+func (m *GetFriendsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFriendsResponse) ProtoMessage()    {}
+func (*GetFriendsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{20}
+}
+
+func (m *GetFriendsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFriendsResponse.Unmarshal(m, b)
+}
+func (m *GetFriendsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFriendsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetFriendsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFriendsResponse.Merge(m, src)
+}
+func (m *GetFriendsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetFriendsResponse.Size(m)
+}
+func (m *GetFriendsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFriendsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFriendsResponse proto.InternalMessageInfo
+
+func (m *GetFriendsResponse) GetFriends() []*Friend {
 	if m != nil {
-		return m.ParticipantId
+		return m.Friends
 	}
-	return ""
+	return nil
 }
 
-type ReadyResponse struct {
+type AddFriendRequest struct {
+	PlayerId             string   `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReadyResponse) Reset()         { *m = ReadyResponse{} }
-func (m *ReadyResponse) String() string { return proto.CompactTextString(m) }
-func (*ReadyResponse) ProtoMessage()    {}
-func (*ReadyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5309ac3f9cbe5f84, []int{20}
+func (m *AddFriendRequest) Reset()         { *m = AddFriendRequest{} }
+func (m *AddFriendRequest) String() string { return proto.CompactTextString(m) }
+func (*AddFriendRequest) ProtoMessage()    {}
+func (*AddFriendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{21}
 }
 
-func (m *ReadyResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReadyResponse.Unmarshal(m, b)
+func (m *AddFriendRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddFriendRequest.Unmarshal(m, b)
 }
-func (m *ReadyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReadyResponse.Marshal(b, m, deterministic)
+func (m *AddFriendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddFriendRequest.Marshal(b, m, deterministic)
 }
-func (m *ReadyResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadyResponse.Merge(m, src)
+func (m *AddFriendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddFriendRequest.Merge(m, src)
 }
-func (m *ReadyResponse) XXX_Size() int {
-	return xxx_messageInfo_ReadyResponse.Size(m)
+func (m *AddFriendRequest) XXX_Size() int {
+	return xxx_messageInfo_AddFriendRequest.Size(m)
 }
-func (m *ReadyResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadyResponse.DiscardUnknown(m)
+func (m *AddFriendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddFriendRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadyResponse proto.InternalMessageInfo
+var xxx_messageInfo_AddFriendRequest proto.InternalMessageInfo
 
-type MakeMoveRequest struct {
-	TableId              string   `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	Card                 string   `protobuf:"bytes,2,opt,name=card,proto3" json:"card,omitempty"`
+func (m *AddFriendRequest) GetPlayerId() string {
+	if m != nil {
+		return m.PlayerId
+	}
+	return ""
+}
+
+type AddFriendResponse struct {
+	Friend               *Friend  `protobuf:"bytes,1,opt,name=friend,proto3" json:"friend,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MakeMoveRequest) Reset()         { *m = MakeMoveRequest{} }
-func (m *MakeMoveRequest) String() string { return proto.CompactTextString(m) }
-func (*MakeMoveRequest) ProtoMessage()    {}
-func (*MakeMoveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5309ac3f9cbe5f84, []int{21}
+func (m *AddFriendResponse) Reset()         { *m = AddFriendResponse{} }
+func (m *AddFriendResponse) String() string { return proto.CompactTextString(m) }
+func (*AddFriendResponse) ProtoMessage()    {}
+func (*AddFriendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{22}
 }
 
-func (m *MakeMoveRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MakeMoveRequest.Unmarshal(m, b)
+func (m *AddFriendResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddFriendResponse.Unmarshal(m, b)
 }
-func (m *MakeMoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MakeMoveRequest.Marshal(b, m, deterministic)
+func (m *AddFriendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddFriendResponse.Marshal(b, m, deterministic)
 }
-func (m *MakeMoveRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MakeMoveRequest.Merge(m, src)
+func (m *AddFriendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddFriendResponse.Merge(m, src)
 }
-func (m *MakeMoveRequest) XXX_Size() int {
-	return xxx_messageInfo_MakeMoveRequest.Size(m)
+func (m *AddFriendResponse) XXX_Size() int {
+	return xxx_messageInfo_AddFriendResponse.Size(m)
 }
-func (m *MakeMoveRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MakeMoveRequest.DiscardUnknown(m)
+func (m *AddFriendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddFriendResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MakeMoveRequest proto.InternalMessageInfo
+var xxx_messageInfo_AddFriendResponse proto.InternalMessageInfo
 
-func (m *MakeMoveRequest) GetTableId() string {
+func (m *AddFriendResponse) GetFriend() *Friend {
 	if m != nil {
-		return m.TableId
+		return m.Friend
 	}
-	return ""
+	return nil
 }
 
-func (m *MakeMoveRequest) GetCard() string {
+type AcceptFriendRequest struct {
+	PlayerId             string   `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AcceptFriendRequest) Reset()         { *m = AcceptFriendRequest{} }
+func (m *AcceptFriendRequest) String() string { return proto.CompactTextString(m) }
+func (*AcceptFriendRequest) ProtoMessage()    {}
+func (*AcceptFriendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{23}
+}
+
+func (m *AcceptFriendRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcceptFriendRequest.Unmarshal(m, b)
+}
+func (m *AcceptFriendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcceptFriendRequest.Marshal(b, m, deterministic)
+}
+func (m *AcceptFriendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptFriendRequest.Merge(m, src)
+}
+func (m *AcceptFriendRequest) XXX_Size() int {
+	return xxx_messageInfo_AcceptFriendRequest.Size(m)
+}
+func (m *AcceptFriendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptFriendRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptFriendRequest proto.InternalMessageInfo
+
+func (m *AcceptFriendRequest) GetPlayerId() string {
 	if m != nil {
-		return m.Card
+		return m.PlayerId
 	}
 	return ""
 }
 
-type MakeMoveResponse struct {
+type AcceptFriendResponse struct {
+	Friend               *Friend  `protobuf:"bytes,1,opt,name=friend,proto3" json:"friend,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MakeMoveResponse) Reset()         { *m = MakeMoveResponse{} }
-func (m *MakeMoveResponse) String() string { return proto.CompactTextString(m) }
-func (*MakeMoveResponse) ProtoMessage()    {}
-func (*MakeMoveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5309ac3f9cbe5f84, []int{22}
+func (m *AcceptFriendResponse) Reset()         { *m = AcceptFriendResponse{} }
+func (m *AcceptFriendResponse) String() string { return proto.CompactTextString(m) }
+func (*AcceptFriendResponse) ProtoMessage()    {}
+func (*AcceptFriendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{24}
 }
 
-func (m *MakeMoveResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MakeMoveResponse.Unmarshal(m, b)
+func (m *AcceptFriendResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcceptFriendResponse.Unmarshal(m, b)
 }
-func (m *MakeMoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MakeMoveResponse.Marshal(b, m, deterministic)
+func (m *AcceptFriendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcceptFriendResponse.Marshal(b, m, deterministic)
 }
-func (m *MakeMoveResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MakeMoveResponse.Merge(m, src)
+func (m *AcceptFriendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptFriendResponse.Merge(m, src)
 }
-func (m *MakeMoveResponse) XXX_Size() int {
-	return xxx_messageInfo_MakeMoveResponse.Size(m)
+func (m *AcceptFriendResponse) XXX_Size() int {
+	return xxx_messageInfo_AcceptFriendResponse.Size(m)
 }
-func (m *MakeMoveResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MakeMoveResponse.DiscardUnknown(m)
+func (m *AcceptFriendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptFriendResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MakeMoveResponse proto.InternalMessageInfo
+var xxx_messageInfo_AcceptFriendResponse proto.InternalMessageInfo
 
-type Participant struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Order                uint32   `protobuf:"varint,2,opt,name=order,proto3" json:"order,omitempty"`
-	State                string   `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
-	Cards                string   `protobuf:"bytes,4,opt,name=cards,proto3" json:"cards,omitempty"`
-	CardsCount           uint32   `protobuf:"varint,5,opt,name=cards_count,json=cardsCount,proto3" json:"cards_count,omitempty"`
-	Player               *Player  `protobuf:"bytes,6,opt,name=player,proto3" json:"player,omitempty"`
+func (m *AcceptFriendResponse) GetFriend() *Friend {
+	if m != nil {
+		return m.Friend
+	}
+	return nil
+}
+
+type RemoveFriendRequest struct {
+	PlayerId             string   `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Participant) Reset()         { *m = Participant{} }
-func (m *Participant) String() string { return proto.CompactTextString(m) }
-func (*Participant) ProtoMessage()    {}
-func (*Participant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5309ac3f9cbe5f84, []int{23}
+func (m *RemoveFriendRequest) Reset()         { *m = RemoveFriendRequest{} }
+func (m *RemoveFriendRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveFriendRequest) ProtoMessage()    {}
+func (*RemoveFriendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{25}
 }
 
-func (m *Participant) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Participant.Unmarshal(m, b)
+func (m *RemoveFriendRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveFriendRequest.Unmarshal(m, b)
 }
-func (m *Participant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Participant.Marshal(b, m, deterministic)
+func (m *RemoveFriendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveFriendRequest.Marshal(b, m, deterministic)
 }
-func (m *Participant) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Participant.Merge(m, src)
+func (m *RemoveFriendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveFriendRequest.Merge(m, src)
 }
-func (m *Participant) XXX_Size() int {
-	return xxx_messageInfo_Participant.Size(m)
+func (m *RemoveFriendRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveFriendRequest.Size(m)
 }
-func (m *Participant) XXX_DiscardUnknown() {
-	xxx_messageInfo_Participant.DiscardUnknown(m)
+func (m *RemoveFriendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveFriendRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Participant proto.InternalMessageInfo
+var xxx_messageInfo_RemoveFriendRequest proto.InternalMessageInfo
 
-func (m *Participant) GetId() string {
+func (m *RemoveFriendRequest) GetPlayerId() string {
 	if m != nil {
-		return m.Id
+		return m.PlayerId
 	}
 	return ""
 }
 
-func (m *Participant) GetOrder() uint32 {
-	if m != nil {
-		return m.Order
-	}
-	return 0
+type RemoveFriendResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Participant) GetState() string {
-	if m != nil {
-		return m.State
-	}
-	return ""
+func (m *RemoveFriendResponse) Reset()         { *m = RemoveFriendResponse{} }
+func (m *RemoveFriendResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveFriendResponse) ProtoMessage()    {}
+func (*RemoveFriendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{26}
 }
 
-func (m *Participant) GetCards() string {
-	if m != nil {
-		return m.Cards
-	}
-	return ""
+func (m *RemoveFriendResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveFriendResponse.Unmarshal(m, b)
+}
+func (m *RemoveFriendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveFriendResponse.Marshal(b, m, deterministic)
+}
+func (m *RemoveFriendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveFriendResponse.Merge(m, src)
+}
+func (m *RemoveFriendResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveFriendResponse.Size(m)
+}
+func (m *RemoveFriendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveFriendResponse.DiscardUnknown(m)
 }
 
-func (m *Participant) GetCardsCount() uint32 {
-	if m != nil {
-		return m.CardsCount
-	}
-	return 0
+var xxx_messageInfo_RemoveFriendResponse proto.InternalMessageInfo
+
+type BlockPlayerRequest struct {
+	PlayerId             string   `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Participant) GetPlayer() *Player {
+func (m *BlockPlayerRequest) Reset()         { *m = BlockPlayerRequest{} }
+func (m *BlockPlayerRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockPlayerRequest) ProtoMessage()    {}
+func (*BlockPlayerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{27}
+}
+
+func (m *BlockPlayerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockPlayerRequest.Unmarshal(m, b)
+}
+func (m *BlockPlayerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockPlayerRequest.Marshal(b, m, deterministic)
+}
+func (m *BlockPlayerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockPlayerRequest.Merge(m, src)
+}
+func (m *BlockPlayerRequest) XXX_Size() int {
+	return xxx_messageInfo_BlockPlayerRequest.Size(m)
+}
+func (m *BlockPlayerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockPlayerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockPlayerRequest proto.InternalMessageInfo
+
+func (m *BlockPlayerRequest) GetPlayerId() string {
 	if m != nil {
-		return m.Player
+		return m.PlayerId
 	}
-	return nil
+	return ""
 }
 
-type Table struct {
-	Id                   string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Trump                string         `protobuf:"bytes,2,opt,name=trump,proto3" json:"trump,omitempty"`
-	Turn                 uint32         `protobuf:"varint,3,opt,name=turn,proto3" json:"turn,omitempty"`
-	TableCards           string         `protobuf:"bytes,4,opt,name=table_cards,json=tableCards,proto3" json:"table_cards,omitempty"`
-	ClubPlayer           uint32         `protobuf:"varint,5,opt,name=club_player,json=clubPlayer,proto3" json:"club_player,omitempty"`
-	Dealer               uint32         `protobuf:"varint,6,opt,name=dealer,proto3" json:"dealer,omitempty"`
-	Team_1Score          uint32         `protobuf:"varint,7,opt,name=team_1_score,json=team1Score,proto3" json:"team_1_score,omitempty"`
-	Team_2Score          uint32         `protobuf:"varint,8,opt,name=team_2_score,json=team2Score,proto3" json:"team_2_score,omitempty"`
-	Team_1Total          uint32         `protobuf:"varint,9,opt,name=team_1_total,json=team1Total,proto3" json:"team_1_total,omitempty"`
-	Team_2Total          uint32         `protobuf:"varint,10,opt,name=team_2_total,json=team2Total,proto3" json:"team_2_total,omitempty"`
-	Participants         []*Participant `protobuf:"bytes,11,rep,name=participants,proto3" json:"participants,omitempty"`
-	Bet                  uint32         `protobuf:"varint,12,opt,name=bet,proto3" json:"bet,omitempty"`
-	UnitType             string         `protobuf:"bytes,13,opt,name=unit_type,json=unitType,proto3" json:"unit_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+type BlockPlayerResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Table) Reset()         { *m = Table{} }
-func (m *Table) String() string { return proto.CompactTextString(m) }
-func (*Table) ProtoMessage()    {}
-func (*Table) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5309ac3f9cbe5f84, []int{24}
+func (m *BlockPlayerResponse) Reset()         { *m = BlockPlayerResponse{} }
+func (m *BlockPlayerResponse) String() string { return proto.CompactTextString(m) }
+func (*BlockPlayerResponse) ProtoMessage()    {}
+func (*BlockPlayerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{28}
 }
 
-func (m *Table) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Table.Unmarshal(m, b)
+func (m *BlockPlayerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockPlayerResponse.Unmarshal(m, b)
 }
-func (m *Table) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Table.Marshal(b, m, deterministic)
+func (m *BlockPlayerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockPlayerResponse.Marshal(b, m, deterministic)
 }
-func (m *Table) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Table.Merge(m, src)
+func (m *BlockPlayerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockPlayerResponse.Merge(m, src)
 }
-func (m *Table) XXX_Size() int {
-	return xxx_messageInfo_Table.Size(m)
+func (m *BlockPlayerResponse) XXX_Size() int {
+	return xxx_messageInfo_BlockPlayerResponse.Size(m)
 }
-func (m *Table) XXX_DiscardUnknown() {
-	xxx_messageInfo_Table.DiscardUnknown(m)
+func (m *BlockPlayerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockPlayerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Table proto.InternalMessageInfo
+var xxx_messageInfo_BlockPlayerResponse proto.InternalMessageInfo
 
-func (m *Table) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
+type InviteToTableRequest struct {
+	TableId              string   `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	PlayerId             string   `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Table) GetTrump() string {
-	if m != nil {
-		return m.Trump
-	}
-	return ""
+func (m *InviteToTableRequest) Reset()         { *m = InviteToTableRequest{} }
+func (m *InviteToTableRequest) String() string { return proto.CompactTextString(m) }
+func (*InviteToTableRequest) ProtoMessage()    {}
+func (*InviteToTableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{29}
 }
 
-func (m *Table) GetTurn() uint32 {
-	if m != nil {
-		return m.Turn
-	}
-	return 0
+func (m *InviteToTableRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InviteToTableRequest.Unmarshal(m, b)
+}
+func (m *InviteToTableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InviteToTableRequest.Marshal(b, m, deterministic)
+}
+func (m *InviteToTableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InviteToTableRequest.Merge(m, src)
+}
+func (m *InviteToTableRequest) XXX_Size() int {
+	return xxx_messageInfo_InviteToTableRequest.Size(m)
+}
+func (m *InviteToTableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InviteToTableRequest.DiscardUnknown(m)
 }
 
-func (m *Table) GetTableCards() string {
+var xxx_messageInfo_InviteToTableRequest proto.InternalMessageInfo
+
+func (m *InviteToTableRequest) GetTableId() string {
 	if m != nil {
-		return m.TableCards
+		return m.TableId
 	}
 	return ""
 }
 
-func (m *Table) GetClubPlayer() uint32 {
+func (m *InviteToTableRequest) GetPlayerId() string {
 	if m != nil {
-		return m.ClubPlayer
+		return m.PlayerId
 	}
-	return 0
+	return ""
 }
 
-func (m *Table) GetDealer() uint32 {
-	if m != nil {
-		return m.Dealer
-	}
-	return 0
+type InviteToTableResponse struct {
+	InvitationId         string   `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Table) GetTeam_1Score() uint32 {
-	if m != nil {
-		return m.Team_1Score
-	}
-	return 0
+func (m *InviteToTableResponse) Reset()         { *m = InviteToTableResponse{} }
+func (m *InviteToTableResponse) String() string { return proto.CompactTextString(m) }
+func (*InviteToTableResponse) ProtoMessage()    {}
+func (*InviteToTableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{30}
 }
 
-func (m *Table) GetTeam_2Score() uint32 {
-	if m != nil {
-		return m.Team_2Score
-	}
-	return 0
+func (m *InviteToTableResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InviteToTableResponse.Unmarshal(m, b)
+}
+func (m *InviteToTableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InviteToTableResponse.Marshal(b, m, deterministic)
+}
+func (m *InviteToTableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InviteToTableResponse.Merge(m, src)
+}
+func (m *InviteToTableResponse) XXX_Size() int {
+	return xxx_messageInfo_InviteToTableResponse.Size(m)
+}
+func (m *InviteToTableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InviteToTableResponse.DiscardUnknown(m)
 }
 
-func (m *Table) GetTeam_1Total() uint32 {
+var xxx_messageInfo_InviteToTableResponse proto.InternalMessageInfo
+
+func (m *InviteToTableResponse) GetInvitationId() string {
 	if m != nil {
-		return m.Team_1Total
+		return m.InvitationId
 	}
-	return 0
+	return ""
 }
 
-func (m *Table) GetTeam_2Total() uint32 {
+func (m *InviteToTableResponse) GetExpiresAt() int64 {
 	if m != nil {
-		return m.Team_2Total
+		return m.ExpiresAt
 	}
 	return 0
 }
 
-func (m *Table) GetParticipants() []*Participant {
-	if m != nil {
-		return m.Participants
-	}
-	return nil
+type AcceptInvitationRequest struct {
+	InvitationId         string   `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Table) GetBet() uint32 {
-	if m != nil {
-		return m.Bet
-	}
-	return 0
+func (m *AcceptInvitationRequest) Reset()         { *m = AcceptInvitationRequest{} }
+func (m *AcceptInvitationRequest) String() string { return proto.CompactTextString(m) }
+func (*AcceptInvitationRequest) ProtoMessage()    {}
+func (*AcceptInvitationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{31}
 }
 
-func (m *Table) GetUnitType() string {
+func (m *AcceptInvitationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcceptInvitationRequest.Unmarshal(m, b)
+}
+func (m *AcceptInvitationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcceptInvitationRequest.Marshal(b, m, deterministic)
+}
+func (m *AcceptInvitationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptInvitationRequest.Merge(m, src)
+}
+func (m *AcceptInvitationRequest) XXX_Size() int {
+	return xxx_messageInfo_AcceptInvitationRequest.Size(m)
+}
+func (m *AcceptInvitationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptInvitationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptInvitationRequest proto.InternalMessageInfo
+
+func (m *AcceptInvitationRequest) GetInvitationId() string {
 	if m != nil {
-		return m.UnitType
+		return m.InvitationId
 	}
 	return ""
 }
 
-type Player struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nickname             string   `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Level                uint64   `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
-	Exp                  uint32   `protobuf:"varint,4,opt,name=exp,proto3" json:"exp,omitempty"`
-	Nuts                 uint64   `protobuf:"varint,5,opt,name=nuts,proto3" json:"nuts,omitempty"`
-	Gold                 uint64   `protobuf:"varint,6,opt,name=gold,proto3" json:"gold,omitempty"`
-	Avatar               string   `protobuf:"bytes,7,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	Profile              *Profile `protobuf:"bytes,8,opt,name=profile,proto3" json:"profile,omitempty"`
+type AcceptInvitationResponse struct {
+	Table                *Table   `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Player) Reset()         { *m = Player{} }
-func (m *Player) String() string { return proto.CompactTextString(m) }
-func (*Player) ProtoMessage()    {}
-func (*Player) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5309ac3f9cbe5f84, []int{25}
+func (m *AcceptInvitationResponse) Reset()         { *m = AcceptInvitationResponse{} }
+func (m *AcceptInvitationResponse) String() string { return proto.CompactTextString(m) }
+func (*AcceptInvitationResponse) ProtoMessage()    {}
+func (*AcceptInvitationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{32}
 }
 
-func (m *Player) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Player.Unmarshal(m, b)
+func (m *AcceptInvitationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcceptInvitationResponse.Unmarshal(m, b)
 }
-func (m *Player) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Player.Marshal(b, m, deterministic)
+func (m *AcceptInvitationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcceptInvitationResponse.Marshal(b, m, deterministic)
 }
-func (m *Player) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Player.Merge(m, src)
+func (m *AcceptInvitationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptInvitationResponse.Merge(m, src)
 }
-func (m *Player) XXX_Size() int {
-	return xxx_messageInfo_Player.Size(m)
+func (m *AcceptInvitationResponse) XXX_Size() int {
+	return xxx_messageInfo_AcceptInvitationResponse.Size(m)
 }
-func (m *Player) XXX_DiscardUnknown() {
-	xxx_messageInfo_Player.DiscardUnknown(m)
+func (m *AcceptInvitationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptInvitationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Player proto.InternalMessageInfo
+var xxx_messageInfo_AcceptInvitationResponse proto.InternalMessageInfo
 
-func (m *Player) GetId() string {
+func (m *AcceptInvitationResponse) GetTable() *Table {
 	if m != nil {
-		return m.Id
+		return m.Table
+	}
+	return nil
+}
+
+type Friend struct {
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Nickname string `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Avatar   string `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	// friend, incoming, outgoing or blocked
+	State                string   `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Online               bool     `protobuf:"varint,5,opt,name=online,proto3" json:"online,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Friend) Reset()         { *m = Friend{} }
+func (m *Friend) String() string { return proto.CompactTextString(m) }
+func (*Friend) ProtoMessage()    {}
+func (*Friend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{33}
+}
+
+func (m *Friend) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Friend.Unmarshal(m, b)
+}
+func (m *Friend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Friend.Marshal(b, m, deterministic)
+}
+func (m *Friend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Friend.Merge(m, src)
+}
+func (m *Friend) XXX_Size() int {
+	return xxx_messageInfo_Friend.Size(m)
+}
+func (m *Friend) XXX_DiscardUnknown() {
+	xxx_messageInfo_Friend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Friend proto.InternalMessageInfo
+
+func (m *Friend) GetPlayerId() string {
+	if m != nil {
+		return m.PlayerId
 	}
 	return ""
 }
 
-func (m *Player) GetNickname() string {
+func (m *Friend) GetNickname() string {
 	if m != nil {
 		return m.Nickname
 	}
 	return ""
 }
 
-func (m *Player) GetLevel() uint64 {
+func (m *Friend) GetAvatar() string {
 	if m != nil {
-		return m.Level
+		return m.Avatar
 	}
-	return 0
+	return ""
 }
 
-func (m *Player) GetExp() uint32 {
+func (m *Friend) GetState() string {
 	if m != nil {
-		return m.Exp
+		return m.State
 	}
-	return 0
+	return ""
 }
 
-func (m *Player) GetNuts() uint64 {
+func (m *Friend) GetOnline() bool {
 	if m != nil {
-		return m.Nuts
+		return m.Online
+	}
+	return false
+}
+
+type GetLeaderboardRequest struct {
+	// global, country or friends
+	Scope string `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	// daily, weekly or all_time
+	Window string `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	// rating, wins or winnings
+	Metric string `protobuf:"bytes,3,opt,name=metric,proto3" json:"metric,omitempty"`
+	// currency of winnings, nuts by default
+	Currency             string   `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Offset               uint32   `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                uint32   `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLeaderboardRequest) Reset()         { *m = GetLeaderboardRequest{} }
+func (m *GetLeaderboardRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLeaderboardRequest) ProtoMessage()    {}
+func (*GetLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{34}
+}
+
+func (m *GetLeaderboardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLeaderboardRequest.Unmarshal(m, b)
+}
+func (m *GetLeaderboardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLeaderboardRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLeaderboardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLeaderboardRequest.Merge(m, src)
+}
+func (m *GetLeaderboardRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLeaderboardRequest.Size(m)
+}
+func (m *GetLeaderboardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLeaderboardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLeaderboardRequest proto.InternalMessageInfo
+
+func (m *GetLeaderboardRequest) GetScope() string {
+	if m != nil {
+		return m.Scope
+	}
+	return ""
+}
+
+func (m *GetLeaderboardRequest) GetWindow() string {
+	if m != nil {
+		return m.Window
+	}
+	return ""
+}
+
+func (m *GetLeaderboardRequest) GetMetric() string {
+	if m != nil {
+		return m.Metric
+	}
+	return ""
+}
+
+func (m *GetLeaderboardRequest) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *GetLeaderboardRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
 	}
 	return 0
 }
 
-func (m *Player) GetGold() uint64 {
+func (m *GetLeaderboardRequest) GetLimit() uint32 {
 	if m != nil {
-		return m.Gold
+		return m.Limit
 	}
 	return 0
 }
 
-func (m *Player) GetAvatar() string {
+type GetLeaderboardResponse struct {
+	Entries []*LeaderboardEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// requesting player's position, empty if player has no score
+	Own                  *LeaderboardEntry `protobuf:"bytes,2,opt,name=own,proto3" json:"own,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetLeaderboardResponse) Reset()         { *m = GetLeaderboardResponse{} }
+func (m *GetLeaderboardResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLeaderboardResponse) ProtoMessage()    {}
+func (*GetLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{35}
+}
+
+func (m *GetLeaderboardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLeaderboardResponse.Unmarshal(m, b)
+}
+func (m *GetLeaderboardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLeaderboardResponse.Marshal(b, m, deterministic)
+}
+func (m *GetLeaderboardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLeaderboardResponse.Merge(m, src)
+}
+func (m *GetLeaderboardResponse) XXX_Size() int {
+	return xxx_messageInfo_GetLeaderboardResponse.Size(m)
+}
+func (m *GetLeaderboardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLeaderboardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLeaderboardResponse proto.InternalMessageInfo
+
+func (m *GetLeaderboardResponse) GetEntries() []*LeaderboardEntry {
 	if m != nil {
-		return m.Avatar
+		return m.Entries
 	}
-	return ""
+	return nil
 }
 
-func (m *Player) GetProfile() *Profile {
+func (m *GetLeaderboardResponse) GetOwn() *LeaderboardEntry {
 	if m != nil {
-		return m.Profile
+		return m.Own
 	}
 	return nil
 }
 
-type Profile struct {
-	FirstName            string   `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName             string   `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	Age                  uint32   `protobuf:"varint,3,opt,name=age,proto3" json:"age,omitempty"`
-	Gender               string   `protobuf:"bytes,4,opt,name=gender,proto3" json:"gender,omitempty"`
+type LeaderboardEntry struct {
+	Rank                 uint64   `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	PlayerId             string   `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Nickname             string   `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Avatar               string   `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
 	Country              string   `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"`
-	Language             string   `protobuf:"bytes,6,opt,name=language,proto3" json:"language,omitempty"`
+	Score                int64    `protobuf:"varint,6,opt,name=score,proto3" json:"score,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Profile) Reset()         { *m = Profile{} }
-func (m *Profile) String() string { return proto.CompactTextString(m) }
-func (*Profile) ProtoMessage()    {}
-func (*Profile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5309ac3f9cbe5f84, []int{26}
+func (m *LeaderboardEntry) Reset()         { *m = LeaderboardEntry{} }
+func (m *LeaderboardEntry) String() string { return proto.CompactTextString(m) }
+func (*LeaderboardEntry) ProtoMessage()    {}
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{36}
 }
 
-func (m *Profile) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Profile.Unmarshal(m, b)
+func (m *LeaderboardEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaderboardEntry.Unmarshal(m, b)
 }
-func (m *Profile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Profile.Marshal(b, m, deterministic)
+func (m *LeaderboardEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaderboardEntry.Marshal(b, m, deterministic)
 }
-func (m *Profile) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Profile.Merge(m, src)
+func (m *LeaderboardEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaderboardEntry.Merge(m, src)
 }
-func (m *Profile) XXX_Size() int {
-	return xxx_messageInfo_Profile.Size(m)
+func (m *LeaderboardEntry) XXX_Size() int {
+	return xxx_messageInfo_LeaderboardEntry.Size(m)
 }
-func (m *Profile) XXX_DiscardUnknown() {
-	xxx_messageInfo_Profile.DiscardUnknown(m)
+func (m *LeaderboardEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaderboardEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Profile proto.InternalMessageInfo
+var xxx_messageInfo_LeaderboardEntry proto.InternalMessageInfo
 
-func (m *Profile) GetFirstName() string {
+func (m *LeaderboardEntry) GetRank() uint64 {
 	if m != nil {
-		return m.FirstName
+		return m.Rank
 	}
-	return ""
+	return 0
 }
 
-func (m *Profile) GetLastName() string {
+func (m *LeaderboardEntry) GetPlayerId() string {
 	if m != nil {
-		return m.LastName
+		return m.PlayerId
 	}
 	return ""
 }
 
-func (m *Profile) GetAge() uint32 {
+func (m *LeaderboardEntry) GetNickname() string {
 	if m != nil {
-		return m.Age
+		return m.Nickname
 	}
-	return 0
+	return ""
 }
 
-func (m *Profile) GetGender() string {
+func (m *LeaderboardEntry) GetAvatar() string {
 	if m != nil {
-		return m.Gender
+		return m.Avatar
 	}
 	return ""
 }
 
-func (m *Profile) GetCountry() string {
+func (m *LeaderboardEntry) GetCountry() string {
 	if m != nil {
 		return m.Country
 	}
 	return ""
 }
 
-func (m *Profile) GetLanguage() string {
+func (m *LeaderboardEntry) GetScore() int64 {
 	if m != nil {
-		return m.Language
+		return m.Score
 	}
-	return ""
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*OpenSessionRequest)(nil), "OpenSessionRequest")
-	proto.RegisterType((*OpenSessionResponse)(nil), "OpenSessionResponse")
-	proto.RegisterType((*CloseSessionRequest)(nil), "CloseSessionRequest")
-	proto.RegisterType((*CloseSessionResponse)(nil), "CloseSessionResponse")
-	proto.RegisterType((*ChangePasswordRequest)(nil), "ChangePasswordRequest")
-	proto.RegisterType((*ChangePasswordResponse)(nil), "ChangePasswordResponse")
-	proto.RegisterType((*GetProductsRequest)(nil), "GetProductsRequest")
-	proto.RegisterType((*GetProductsResponse)(nil), "GetProductsResponse")
-	proto.RegisterType((*PurchaseProductRequest)(nil), "PurchaseProductRequest")
-	proto.RegisterType((*PurchaseProductResponse)(nil), "PurchaseProductResponse")
-	proto.RegisterType((*Product)(nil), "Product")
-	proto.RegisterType((*CreateTableRequest)(nil), "CreateTableRequest")
-	proto.RegisterType((*CreateTableResponse)(nil), "CreateTableResponse")
-	proto.RegisterType((*GetOpenTablesRequest)(nil), "GetOpenTablesRequest")
-	proto.RegisterType((*GetOpenTablesResponse)(nil), "GetOpenTablesResponse")
-	proto.RegisterType((*JoinTableRequest)(nil), "JoinTableRequest")
-	proto.RegisterType((*JoinTableResponse)(nil), "JoinTableResponse")
-	proto.RegisterType((*BecomeParticipantRequest)(nil), "BecomeParticipantRequest")
-	proto.RegisterType((*BecomeParticipantResponse)(nil), "BecomeParticipantResponse")
-	proto.RegisterType((*ReadyRequest)(nil), "ReadyRequest")
-	proto.RegisterType((*ReadyResponse)(nil), "ReadyResponse")
-	proto.RegisterType((*MakeMoveRequest)(nil), "MakeMoveRequest")
-	proto.RegisterType((*MakeMoveResponse)(nil), "MakeMoveResponse")
-	proto.RegisterType((*Participant)(nil), "Participant")
-	proto.RegisterType((*Table)(nil), "Table")
-	proto.RegisterType((*Player)(nil), "Player")
-	proto.RegisterType((*Profile)(nil), "Profile")
+type GetMatchHistoryRequest struct {
+	Offset               uint32   `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                uint32   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("proto/game.proto", fileDescriptor_5309ac3f9cbe5f84) }
+func (m *GetMatchHistoryRequest) Reset()         { *m = GetMatchHistoryRequest{} }
+func (m *GetMatchHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMatchHistoryRequest) ProtoMessage()    {}
+func (*GetMatchHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{37}
+}
 
-var fileDescriptor_5309ac3f9cbe5f84 = []byte{
-	// 1118 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x5d, 0x6f, 0xe3, 0x44,
-	0x17, 0x96, 0x9b, 0xe6, 0xeb, 0x24, 0x69, 0xd3, 0x49, 0x9a, 0xba, 0xee, 0xbb, 0xef, 0x06, 0x0b,
-	0x50, 0x85, 0xc4, 0x2c, 0x0d, 0x5a, 0x2d, 0xd2, 0x4a, 0x08, 0xb6, 0x48, 0x55, 0x57, 0x5a, 0xa8,
-	0xdc, 0x5e, 0x82, 0xac, 0xa9, 0x7d, 0x36, 0x6b, 0xd5, 0xb1, 0x8d, 0x3d, 0x6e, 0xe9, 0x35, 0xfc,
-	0x0a, 0xee, 0xb8, 0xe0, 0x7f, 0xf0, 0xd3, 0xd0, 0x7c, 0xd8, 0xb1, 0x13, 0x57, 0xec, 0xdd, 0x9c,
-	0x67, 0xce, 0xd7, 0x9c, 0x39, 0x33, 0xcf, 0x81, 0x71, 0x92, 0xc6, 0x3c, 0x7e, 0xb1, 0x64, 0x2b,
-	0xa4, 0x72, 0x69, 0x7f, 0x01, 0xe4, 0xa7, 0x04, 0xa3, 0x6b, 0xcc, 0xb2, 0x20, 0x8e, 0x1c, 0xfc,
-	0x35, 0xc7, 0x8c, 0x93, 0x29, 0xb4, 0x79, 0x7c, 0x87, 0x91, 0x69, 0xcc, 0x8d, 0xd3, 0xbe, 0xa3,
-	0x04, 0x3b, 0x81, 0x49, 0x4d, 0x37, 0x4b, 0xe2, 0x28, 0x43, 0xf2, 0x0c, 0x20, 0x53, 0x90, 0x1b,
-	0xf8, 0xda, 0xa2, 0xaf, 0x91, 0x4b, 0x9f, 0x3c, 0x87, 0x4e, 0x12, 0xb2, 0x47, 0x4c, 0xcd, 0x9d,
-	0xb9, 0x71, 0x3a, 0x58, 0x74, 0xe9, 0x95, 0x14, 0x1d, 0x0d, 0x93, 0x63, 0xe8, 0x71, 0x76, 0x1b,
-	0xa2, 0xb0, 0x6e, 0x49, 0xeb, 0xae, 0x94, 0x2f, 0x7d, 0xfb, 0x10, 0x26, 0xe7, 0x61, 0x9c, 0x61,
-	0x3d, 0x3d, 0xfb, 0x25, 0x4c, 0xeb, 0xf0, 0x47, 0x65, 0x62, 0xff, 0x02, 0x87, 0xe7, 0x1f, 0x58,
-	0xb4, 0xc4, 0x2b, 0x96, 0x65, 0x0f, 0x71, 0xea, 0x17, 0xc7, 0xfd, 0x04, 0x86, 0x71, 0xe8, 0xbb,
-	0x89, 0x86, 0xb5, 0xe5, 0x20, 0x0e, 0xfd, 0x42, 0x53, 0xa8, 0x44, 0xf8, 0xb0, 0x56, 0xd9, 0x51,
-	0x2a, 0x11, 0x3e, 0x14, 0x2a, 0xb6, 0x09, 0xb3, 0x4d, 0xf7, 0x2a, 0x2f, 0x7b, 0x0a, 0xe4, 0x02,
-	0xf9, 0x55, 0x1a, 0xfb, 0xb9, 0xc7, 0xb3, 0xe2, 0x14, 0xaf, 0x61, 0x52, 0x43, 0xf5, 0x21, 0x3e,
-	0x85, 0x5e, 0xa2, 0x31, 0xd3, 0x98, 0xb7, 0x4e, 0x07, 0x8b, 0x1e, 0xd5, 0x4a, 0x4e, 0xb9, 0x63,
-	0xbf, 0x82, 0xd9, 0x55, 0x9e, 0x7a, 0x1f, 0x58, 0x86, 0xc5, 0xa6, 0x3e, 0xcc, 0x33, 0x00, 0xad,
-	0x55, 0x29, 0x82, 0x46, 0x2e, 0x7d, 0xfb, 0x18, 0x8e, 0xb6, 0x0c, 0x75, 0x9a, 0xbf, 0x1b, 0xd0,
-	0xd5, 0x18, 0xd9, 0x83, 0x9d, 0xd2, 0x7a, 0x27, 0xf0, 0x65, 0x47, 0x04, 0x3c, 0x44, 0x7d, 0x70,
-	0x25, 0x90, 0x39, 0x0c, 0x7c, 0xcc, 0xbc, 0x34, 0x48, 0x78, 0x10, 0x47, 0xfa, 0xf6, 0xaa, 0x90,
-	0xb0, 0x4b, 0xd2, 0xc0, 0x43, 0x73, 0x77, 0x6e, 0x9c, 0x8e, 0x1c, 0x25, 0x10, 0x0b, 0x7a, 0x5e,
-	0x9e, 0xa6, 0x18, 0x79, 0x8f, 0x66, 0x5b, 0x1a, 0x95, 0xb2, 0xfd, 0x06, 0xc8, 0x79, 0x8a, 0x8c,
-	0xe3, 0x8d, 0x68, 0x82, 0xe2, 0x54, 0x55, 0x0b, 0xa3, 0x6e, 0x41, 0xc6, 0xd0, 0xba, 0x45, 0x2e,
-	0x33, 0x1b, 0x39, 0x62, 0x69, 0xbb, 0x30, 0xa9, 0xf9, 0xd0, 0xa5, 0xad, 0x76, 0x9a, 0x51, 0xeb,
-	0x34, 0x72, 0x02, 0xfd, 0x3c, 0x0a, 0xb8, 0xcb, 0x1f, 0x93, 0xe2, 0x8c, 0x3d, 0x01, 0xdc, 0x3c,
-	0x26, 0x58, 0x04, 0x68, 0xad, 0x03, 0xcc, 0x60, 0x7a, 0x81, 0x5c, 0xbc, 0x06, 0x19, 0xa1, 0xbc,
-	0xd3, 0x57, 0x70, 0xb8, 0x81, 0xeb, 0xd0, 0xff, 0x87, 0x8e, 0x0c, 0x55, 0xdc, 0x69, 0x87, 0xaa,
-	0xd4, 0x34, 0x6a, 0x7f, 0x09, 0xe3, 0xb7, 0x71, 0x10, 0xd5, 0xce, 0xfc, 0x74, 0xba, 0xf6, 0x19,
-	0x1c, 0x54, 0xd4, 0x75, 0x8c, 0xff, 0x41, 0x5b, 0xee, 0x4b, 0xe5, 0x75, 0x08, 0x05, 0xda, 0x3f,
-	0x83, 0xf9, 0x06, 0xbd, 0x78, 0x85, 0x57, 0x2c, 0xe5, 0x81, 0x17, 0x24, 0x2c, 0xe2, 0xff, 0x1d,
-	0x89, 0x7c, 0x06, 0x7b, 0xc9, 0xda, 0x40, 0x28, 0xa8, 0xea, 0x8c, 0x2a, 0xe8, 0xa5, 0x6f, 0x9f,
-	0xc0, 0x71, 0x83, 0x77, 0xdd, 0x58, 0x2f, 0x61, 0xe8, 0x20, 0xf3, 0x1f, 0x8b, 0x70, 0xdb, 0x3e,
-	0x8d, 0x26, 0x9f, 0xfb, 0x30, 0xd2, 0x66, 0xda, 0xcf, 0x77, 0xb0, 0xff, 0x8e, 0xdd, 0xe1, 0xbb,
-	0xf8, 0xfe, 0x23, 0x6a, 0x44, 0x08, 0xec, 0x7a, 0xac, 0x7c, 0xaa, 0x72, 0x6d, 0x13, 0x18, 0xaf,
-	0x3d, 0x68, 0xaf, 0x7f, 0x19, 0x30, 0xa8, 0x64, 0xdd, 0xd4, 0xfa, 0x71, 0xea, 0xeb, 0xff, 0x6b,
-	0xe4, 0x28, 0x41, 0xa0, 0x19, 0x67, 0x1c, 0x75, 0xd3, 0x2b, 0x41, 0xa0, 0x22, 0x4e, 0x26, 0xdb,
-	0xbd, 0xef, 0x28, 0x81, 0x3c, 0x87, 0x81, 0x5c, 0xb8, 0x5e, 0x9c, 0x47, 0x5c, 0x76, 0xfc, 0xc8,
-	0x01, 0x09, 0x9d, 0x0b, 0xa4, 0xf2, 0x47, 0x76, 0x1a, 0xff, 0x48, 0xfb, 0x8f, 0x16, 0xb4, 0xe5,
-	0x6d, 0x36, 0x3e, 0xcc, 0x34, 0x5f, 0x25, 0xe5, 0xc3, 0x14, 0x82, 0x38, 0x3b, 0xcf, 0xd3, 0x48,
-	0xb7, 0xac, 0x5c, 0x8b, 0x2c, 0x54, 0xa9, 0xaa, 0x19, 0x82, 0x84, 0xce, 0xcb, 0x34, 0xc3, 0xfc,
-	0xd6, 0xd5, 0xa9, 0x14, 0x69, 0x86, 0xf9, 0xad, 0xca, 0x86, 0xcc, 0xa0, 0xe3, 0x23, 0x0b, 0x75,
-	0x9a, 0x23, 0x47, 0x4b, 0x64, 0x0e, 0x43, 0x8e, 0x6c, 0xe5, 0x9e, 0xb9, 0x99, 0x17, 0xa7, 0x68,
-	0x76, 0x95, 0xa5, 0xc0, 0xce, 0xae, 0x05, 0x52, 0x6a, 0x2c, 0xb4, 0x46, 0x6f, 0xad, 0xb1, 0xa8,
-	0x6b, 0x9c, 0xb9, 0x3c, 0xe6, 0x2c, 0x34, 0xfb, 0x15, 0x1f, 0x37, 0x02, 0xa9, 0xf8, 0x50, 0x1a,
-	0x50, 0xf1, 0xa1, 0x34, 0xbe, 0x82, 0x61, 0xa5, 0x83, 0x32, 0x73, 0x20, 0x9f, 0xda, 0x90, 0x56,
-	0x7b, 0xb2, 0xa6, 0x51, 0xbc, 0xec, 0x61, 0xf9, 0xb2, 0xeb, 0x1f, 0xc1, 0xa8, 0xfe, 0x11, 0xd8,
-	0xff, 0x18, 0xd0, 0xd1, 0xb5, 0xd8, 0xbc, 0x07, 0x0b, 0x7a, 0x51, 0xe0, 0xdd, 0x45, 0x6c, 0x55,
-	0xfe, 0x1f, 0x85, 0x2c, 0xee, 0x28, 0xc4, 0x7b, 0x0c, 0xe5, 0x75, 0xec, 0x3a, 0x4a, 0x10, 0xb1,
-	0xf1, 0xb7, 0x44, 0x7f, 0x8c, 0x62, 0x29, 0x6e, 0x2d, 0xca, 0x79, 0x26, 0x2b, 0xbf, 0xeb, 0xc8,
-	0xb5, 0xc0, 0x96, 0x71, 0xe8, 0xcb, 0x8a, 0xef, 0x3a, 0x72, 0x2d, 0xee, 0x81, 0xdd, 0x33, 0xce,
-	0x52, 0x59, 0xe9, 0xbe, 0xa3, 0x25, 0x62, 0x43, 0x37, 0x49, 0xe3, 0xf7, 0x41, 0xa8, 0x0a, 0xac,
-	0x99, 0x43, 0xc8, 0x4e, 0xb1, 0x61, 0xff, 0xad, 0x3e, 0x79, 0xb1, 0x16, 0x54, 0xf1, 0x3e, 0x48,
-	0x33, 0xee, 0xca, 0xac, 0x35, 0x55, 0x48, 0xe4, 0x47, 0x91, 0xf6, 0x09, 0xf4, 0x43, 0x56, 0xec,
-	0xea, 0x33, 0x09, 0x40, 0x6e, 0x8e, 0xa1, 0xc5, 0x96, 0x58, 0xfc, 0x89, 0x6c, 0x89, 0x22, 0xab,
-	0x25, 0x46, 0xe2, 0xa1, 0xa8, 0xd6, 0xd2, 0x12, 0x31, 0xa1, 0x2b, 0xfb, 0x3e, 0x2d, 0xfe, 0xfa,
-	0x42, 0x14, 0x35, 0x0b, 0x59, 0xb4, 0xcc, 0x85, 0xa3, 0x4e, 0xe1, 0x5f, 0xc9, 0x8b, 0x3f, 0xdb,
-	0x30, 0xb8, 0x60, 0x2b, 0xbc, 0xc6, 0xf4, 0x5e, 0x50, 0xc6, 0x37, 0x30, 0xa8, 0x0c, 0x1f, 0x64,
-	0x42, 0xb7, 0xc7, 0x16, 0x6b, 0x4a, 0x9b, 0xe6, 0x93, 0xd7, 0x30, 0xac, 0x4e, 0x0b, 0x64, 0x4a,
-	0x1b, 0x66, 0x0a, 0xeb, 0x90, 0x36, 0x8e, 0x14, 0xdf, 0xc3, 0x5e, 0x9d, 0xd4, 0xc9, 0x8c, 0x36,
-	0x0e, 0x11, 0xd6, 0x11, 0x6d, 0x66, 0x7f, 0x91, 0x79, 0x85, 0xe7, 0xc9, 0x84, 0x6e, 0xcf, 0x02,
-	0xd6, 0x94, 0x36, 0x8d, 0x02, 0x3f, 0xc0, 0xfe, 0x06, 0x57, 0x93, 0x23, 0xda, 0x4c, 0xfb, 0x96,
-	0x49, 0x9f, 0xa0, 0x75, 0x11, 0xbf, 0x42, 0x86, 0x64, 0x42, 0xb7, 0xe9, 0xd5, 0x9a, 0xd2, 0x26,
-	0xbe, 0xfc, 0x16, 0x46, 0x35, 0x36, 0x23, 0x87, 0xb4, 0x89, 0xf5, 0xac, 0x19, 0x6d, 0x26, 0xbd,
-	0x05, 0xf4, 0x4b, 0x96, 0x22, 0x07, 0x74, 0x93, 0xe0, 0x2c, 0x42, 0xb7, 0x49, 0xec, 0x2d, 0x1c,
-	0x6c, 0x11, 0x09, 0x39, 0xa6, 0x4f, 0x51, 0x97, 0x65, 0xd1, 0x27, 0x79, 0x87, 0x7c, 0x0e, 0x6d,
-	0x49, 0x20, 0x64, 0x44, 0xab, 0xfc, 0x63, 0xed, 0xd1, 0x1a, 0xaf, 0x90, 0x17, 0xd0, 0x2b, 0x58,
-	0x81, 0x8c, 0xe9, 0x06, 0xc5, 0x58, 0x07, 0x74, 0x93, 0x32, 0x6e, 0x3b, 0x72, 0x78, 0xfe, 0xfa,
-	0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x83, 0x95, 0xc5, 0x50, 0x0b, 0x00, 0x00,
+func (m *GetMatchHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMatchHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetMatchHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMatchHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetMatchHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMatchHistoryRequest.Merge(m, src)
+}
+func (m *GetMatchHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMatchHistoryRequest.Size(m)
+}
+func (m *GetMatchHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMatchHistoryRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_GetMatchHistoryRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *GetMatchHistoryRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
 
-// GameServiceClient is the client API for GameService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GameServiceClient interface {
-	OpenSession(ctx context.Context, in *OpenSessionRequest, opts ...grpc.CallOption) (*OpenSessionResponse, error)
-	CloseSession(ctx context.Context, in *CloseSessionRequest, opts ...grpc.CallOption) (*CloseSessionResponse, error)
-	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
-	// Shop
-	GetProducts(ctx context.Context, in *GetProductsRequest, opts ...grpc.CallOption) (*GetProductsResponse, error)
-	PurchaseProduct(ctx context.Context, in *PurchaseProductRequest, opts ...grpc.CallOption) (*PurchaseProductResponse, error)
-	// Table requests
-	CreateTable(ctx context.Context, in *CreateTableRequest, opts ...grpc.CallOption) (*CreateTableResponse, error)
-	GetOpenTables(ctx context.Context, in *GetOpenTablesRequest, opts ...grpc.CallOption) (*GetOpenTablesResponse, error)
-	JoinTable(ctx context.Context, in *JoinTableRequest, opts ...grpc.CallOption) (*JoinTableResponse, error)
-	BecomeParticipant(ctx context.Context, in *BecomeParticipantRequest, opts ...grpc.CallOption) (*BecomeParticipantResponse, error)
-	Ready(ctx context.Context, in *ReadyRequest, opts ...grpc.CallOption) (*ReadyResponse, error)
-	MakeMove(ctx context.Context, in *MakeMoveRequest, opts ...grpc.CallOption) (*MakeMoveResponse, error)
+func (m *GetMatchHistoryRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
 
-type gameServiceClient struct {
-	cc *grpc.ClientConn
+type GetMatchHistoryResponse struct {
+	Matches              []*Match `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	Total                uint32   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func NewGameServiceClient(cc *grpc.ClientConn) GameServiceClient {
-	return &gameServiceClient{cc}
+func (m *GetMatchHistoryResponse) Reset()         { *m = GetMatchHistoryResponse{} }
+func (m *GetMatchHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMatchHistoryResponse) ProtoMessage()    {}
+func (*GetMatchHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{38}
 }
 
-func (c *gameServiceClient) OpenSession(ctx context.Context, in *OpenSessionRequest, opts ...grpc.CallOption) (*OpenSessionResponse, error) {
-	out := new(OpenSessionResponse)
-	err := c.cc.Invoke(ctx, "/GameService/OpenSession", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetMatchHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMatchHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetMatchHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMatchHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetMatchHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMatchHistoryResponse.Merge(m, src)
+}
+func (m *GetMatchHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMatchHistoryResponse.Size(m)
+}
+func (m *GetMatchHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMatchHistoryResponse.DiscardUnknown(m)
 }
 
-func (c *gameServiceClient) CloseSession(ctx context.Context, in *CloseSessionRequest, opts ...grpc.CallOption) (*CloseSessionResponse, error) {
-	out := new(CloseSessionResponse)
-	err := c.cc.Invoke(ctx, "/GameService/CloseSession", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetMatchHistoryResponse proto.InternalMessageInfo
+
+func (m *GetMatchHistoryResponse) GetMatches() []*Match {
+	if m != nil {
+		return m.Matches
 	}
-	return out, nil
+	return nil
 }
 
-func (c *gameServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
-	out := new(ChangePasswordResponse)
-	err := c.cc.Invoke(ctx, "/GameService/ChangePassword", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetMatchHistoryResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
 	}
-	return out, nil
+	return 0
+}
+
+type Match struct {
+	TableId   string `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	StartTime int64  `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   int64  `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Currency  string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Bet       uint32 `protobuf:"varint,5,opt,name=bet,proto3" json:"bet,omitempty"`
+	// won or lost
+	Result               string    `protobuf:"bytes,6,opt,name=result,proto3" json:"result,omitempty"`
+	TeamTotal            uint32    `protobuf:"varint,7,opt,name=team_total,json=teamTotal,proto3" json:"team_total,omitempty"`
+	OpponentsTotal       uint32    `protobuf:"varint,8,opt,name=opponents_total,json=opponentsTotal,proto3" json:"opponents_total,omitempty"`
+	RatingChange         int32     `protobuf:"varint,9,opt,name=rating_change,json=ratingChange,proto3" json:"rating_change,omitempty"`
+	Partner              *Player   `protobuf:"bytes,10,opt,name=partner,proto3" json:"partner,omitempty"`
+	Opponents            []*Player `protobuf:"bytes,11,rep,name=opponents,proto3" json:"opponents,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Match) Reset()         { *m = Match{} }
+func (m *Match) String() string { return proto.CompactTextString(m) }
+func (*Match) ProtoMessage()    {}
+func (*Match) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{39}
+}
+
+func (m *Match) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Match.Unmarshal(m, b)
+}
+func (m *Match) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Match.Marshal(b, m, deterministic)
+}
+func (m *Match) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Match.Merge(m, src)
+}
+func (m *Match) XXX_Size() int {
+	return xxx_messageInfo_Match.Size(m)
+}
+func (m *Match) XXX_DiscardUnknown() {
+	xxx_messageInfo_Match.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Match proto.InternalMessageInfo
+
+func (m *Match) GetTableId() string {
+	if m != nil {
+		return m.TableId
+	}
+	return ""
+}
+
+func (m *Match) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *Match) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *Match) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *Match) GetBet() uint32 {
+	if m != nil {
+		return m.Bet
+	}
+	return 0
+}
+
+func (m *Match) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *Match) GetTeamTotal() uint32 {
+	if m != nil {
+		return m.TeamTotal
+	}
+	return 0
+}
+
+func (m *Match) GetOpponentsTotal() uint32 {
+	if m != nil {
+		return m.OpponentsTotal
+	}
+	return 0
+}
+
+func (m *Match) GetRatingChange() int32 {
+	if m != nil {
+		return m.RatingChange
+	}
+	return 0
+}
+
+func (m *Match) GetPartner() *Player {
+	if m != nil {
+		return m.Partner
+	}
+	return nil
+}
+
+func (m *Match) GetOpponents() []*Player {
+	if m != nil {
+		return m.Opponents
+	}
+	return nil
+}
+
+type GetPlayerStatsRequest struct {
+	// own stats if empty
+	PlayerId             string   `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPlayerStatsRequest) Reset()         { *m = GetPlayerStatsRequest{} }
+func (m *GetPlayerStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPlayerStatsRequest) ProtoMessage()    {}
+func (*GetPlayerStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{40}
+}
+
+func (m *GetPlayerStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPlayerStatsRequest.Unmarshal(m, b)
+}
+func (m *GetPlayerStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPlayerStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPlayerStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPlayerStatsRequest.Merge(m, src)
+}
+func (m *GetPlayerStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPlayerStatsRequest.Size(m)
+}
+func (m *GetPlayerStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPlayerStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPlayerStatsRequest proto.InternalMessageInfo
+
+func (m *GetPlayerStatsRequest) GetPlayerId() string {
+	if m != nil {
+		return m.PlayerId
+	}
+	return ""
+}
+
+type GetPlayerStatsResponse struct {
+	Stats                *PlayerStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetPlayerStatsResponse) Reset()         { *m = GetPlayerStatsResponse{} }
+func (m *GetPlayerStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPlayerStatsResponse) ProtoMessage()    {}
+func (*GetPlayerStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{41}
+}
+
+func (m *GetPlayerStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPlayerStatsResponse.Unmarshal(m, b)
+}
+func (m *GetPlayerStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPlayerStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPlayerStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPlayerStatsResponse.Merge(m, src)
+}
+func (m *GetPlayerStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPlayerStatsResponse.Size(m)
+}
+func (m *GetPlayerStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPlayerStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPlayerStatsResponse proto.InternalMessageInfo
+
+func (m *GetPlayerStatsResponse) GetStats() *PlayerStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type PlayerStats struct {
+	GamesPlayed   uint32  `protobuf:"varint,1,opt,name=games_played,json=gamesPlayed,proto3" json:"games_played,omitempty"`
+	GamesWon      uint32  `protobuf:"varint,2,opt,name=games_won,json=gamesWon,proto3" json:"games_won,omitempty"`
+	RoundsPlayed  uint32  `protobuf:"varint,3,opt,name=rounds_played,json=roundsPlayed,proto3" json:"rounds_played,omitempty"`
+	RoundsWon     uint32  `protobuf:"varint,4,opt,name=rounds_won,json=roundsWon,proto3" json:"rounds_won,omitempty"`
+	RoundWinRate  float64 `protobuf:"fixed64,5,opt,name=round_win_rate,json=roundWinRate,proto3" json:"round_win_rate,omitempty"`
+	AvgCardPoints float64 `protobuf:"fixed64,6,opt,name=avg_card_points,json=avgCardPoints,proto3" json:"avg_card_points,omitempty"`
+	// share of rounds started with club jack in hand
+	ClubJackRate float64 `protobuf:"fixed64,7,opt,name=club_jack_rate,json=clubJackRate,proto3" json:"club_jack_rate,omitempty"`
+	// rounds played per trump suit: club, spade, heart, diamond
+	Trumps               map[string]uint32 `protobuf:"bytes,8,rep,name=trumps,proto3" json:"trumps,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PlayerStats) Reset()         { *m = PlayerStats{} }
+func (m *PlayerStats) String() string { return proto.CompactTextString(m) }
+func (*PlayerStats) ProtoMessage()    {}
+func (*PlayerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{42}
+}
+
+func (m *PlayerStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlayerStats.Unmarshal(m, b)
+}
+func (m *PlayerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlayerStats.Marshal(b, m, deterministic)
+}
+func (m *PlayerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlayerStats.Merge(m, src)
+}
+func (m *PlayerStats) XXX_Size() int {
+	return xxx_messageInfo_PlayerStats.Size(m)
+}
+func (m *PlayerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlayerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlayerStats proto.InternalMessageInfo
+
+func (m *PlayerStats) GetGamesPlayed() uint32 {
+	if m != nil {
+		return m.GamesPlayed
+	}
+	return 0
+}
+
+func (m *PlayerStats) GetGamesWon() uint32 {
+	if m != nil {
+		return m.GamesWon
+	}
+	return 0
+}
+
+func (m *PlayerStats) GetRoundsPlayed() uint32 {
+	if m != nil {
+		return m.RoundsPlayed
+	}
+	return 0
+}
+
+func (m *PlayerStats) GetRoundsWon() uint32 {
+	if m != nil {
+		return m.RoundsWon
+	}
+	return 0
+}
+
+func (m *PlayerStats) GetRoundWinRate() float64 {
+	if m != nil {
+		return m.RoundWinRate
+	}
+	return 0
+}
+
+func (m *PlayerStats) GetAvgCardPoints() float64 {
+	if m != nil {
+		return m.AvgCardPoints
+	}
+	return 0
+}
+
+func (m *PlayerStats) GetClubJackRate() float64 {
+	if m != nil {
+		return m.ClubJackRate
+	}
+	return 0
+}
+
+func (m *PlayerStats) GetTrumps() map[string]uint32 {
+	if m != nil {
+		return m.Trumps
+	}
+	return nil
+}
+
+type GetAchievementsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAchievementsRequest) Reset()         { *m = GetAchievementsRequest{} }
+func (m *GetAchievementsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAchievementsRequest) ProtoMessage()    {}
+func (*GetAchievementsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{43}
+}
+
+func (m *GetAchievementsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAchievementsRequest.Unmarshal(m, b)
+}
+func (m *GetAchievementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAchievementsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAchievementsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAchievementsRequest.Merge(m, src)
+}
+func (m *GetAchievementsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAchievementsRequest.Size(m)
+}
+func (m *GetAchievementsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAchievementsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAchievementsRequest proto.InternalMessageInfo
+
+type GetAchievementsResponse struct {
+	Achievements         []*Achievement `protobuf:"bytes,1,rep,name=achievements,proto3" json:"achievements,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetAchievementsResponse) Reset()         { *m = GetAchievementsResponse{} }
+func (m *GetAchievementsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAchievementsResponse) ProtoMessage()    {}
+func (*GetAchievementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{44}
+}
+
+func (m *GetAchievementsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAchievementsResponse.Unmarshal(m, b)
+}
+func (m *GetAchievementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAchievementsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetAchievementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAchievementsResponse.Merge(m, src)
+}
+func (m *GetAchievementsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAchievementsResponse.Size(m)
+}
+func (m *GetAchievementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAchievementsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAchievementsResponse proto.InternalMessageInfo
+
+func (m *GetAchievementsResponse) GetAchievements() []*Achievement {
+	if m != nil {
+		return m.Achievements
+	}
+	return nil
+}
+
+type Achievement struct {
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Goal        uint32 `protobuf:"varint,4,opt,name=goal,proto3" json:"goal,omitempty"`
+	Progress    uint32 `protobuf:"varint,5,opt,name=progress,proto3" json:"progress,omitempty"`
+	// zero if achievement is locked
+	UnlockedAt           int64    `protobuf:"varint,6,opt,name=unlocked_at,json=unlockedAt,proto3" json:"unlocked_at,omitempty"`
+	RewardNuts           uint64   `protobuf:"varint,7,opt,name=reward_nuts,json=rewardNuts,proto3" json:"reward_nuts,omitempty"`
+	RewardGold           uint64   `protobuf:"varint,8,opt,name=reward_gold,json=rewardGold,proto3" json:"reward_gold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Achievement) Reset()         { *m = Achievement{} }
+func (m *Achievement) String() string { return proto.CompactTextString(m) }
+func (*Achievement) ProtoMessage()    {}
+func (*Achievement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{45}
+}
+
+func (m *Achievement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Achievement.Unmarshal(m, b)
+}
+func (m *Achievement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Achievement.Marshal(b, m, deterministic)
+}
+func (m *Achievement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Achievement.Merge(m, src)
+}
+func (m *Achievement) XXX_Size() int {
+	return xxx_messageInfo_Achievement.Size(m)
+}
+func (m *Achievement) XXX_DiscardUnknown() {
+	xxx_messageInfo_Achievement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Achievement proto.InternalMessageInfo
+
+func (m *Achievement) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Achievement) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Achievement) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Achievement) GetGoal() uint32 {
+	if m != nil {
+		return m.Goal
+	}
+	return 0
+}
+
+func (m *Achievement) GetProgress() uint32 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+func (m *Achievement) GetUnlockedAt() int64 {
+	if m != nil {
+		return m.UnlockedAt
+	}
+	return 0
+}
+
+func (m *Achievement) GetRewardNuts() uint64 {
+	if m != nil {
+		return m.RewardNuts
+	}
+	return 0
+}
+
+func (m *Achievement) GetRewardGold() uint64 {
+	if m != nil {
+		return m.RewardGold
+	}
+	return 0
+}
+
+type ClaimDailyRewardRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClaimDailyRewardRequest) Reset()         { *m = ClaimDailyRewardRequest{} }
+func (m *ClaimDailyRewardRequest) String() string { return proto.CompactTextString(m) }
+func (*ClaimDailyRewardRequest) ProtoMessage()    {}
+func (*ClaimDailyRewardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{46}
+}
+
+func (m *ClaimDailyRewardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClaimDailyRewardRequest.Unmarshal(m, b)
+}
+func (m *ClaimDailyRewardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClaimDailyRewardRequest.Marshal(b, m, deterministic)
+}
+func (m *ClaimDailyRewardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimDailyRewardRequest.Merge(m, src)
+}
+func (m *ClaimDailyRewardRequest) XXX_Size() int {
+	return xxx_messageInfo_ClaimDailyRewardRequest.Size(m)
+}
+func (m *ClaimDailyRewardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimDailyRewardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimDailyRewardRequest proto.InternalMessageInfo
+
+type ClaimDailyRewardResponse struct {
+	// consecutive days of claimed rewards, including today
+	Streak               uint32   `protobuf:"varint,1,opt,name=streak,proto3" json:"streak,omitempty"`
+	RewardNuts           uint64   `protobuf:"varint,2,opt,name=reward_nuts,json=rewardNuts,proto3" json:"reward_nuts,omitempty"`
+	Nuts                 uint64   `protobuf:"varint,3,opt,name=nuts,proto3" json:"nuts,omitempty"`
+	Gold                 uint64   `protobuf:"varint,4,opt,name=gold,proto3" json:"gold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClaimDailyRewardResponse) Reset()         { *m = ClaimDailyRewardResponse{} }
+func (m *ClaimDailyRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*ClaimDailyRewardResponse) ProtoMessage()    {}
+func (*ClaimDailyRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{47}
+}
+
+func (m *ClaimDailyRewardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClaimDailyRewardResponse.Unmarshal(m, b)
+}
+func (m *ClaimDailyRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClaimDailyRewardResponse.Marshal(b, m, deterministic)
+}
+func (m *ClaimDailyRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimDailyRewardResponse.Merge(m, src)
+}
+func (m *ClaimDailyRewardResponse) XXX_Size() int {
+	return xxx_messageInfo_ClaimDailyRewardResponse.Size(m)
+}
+func (m *ClaimDailyRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimDailyRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimDailyRewardResponse proto.InternalMessageInfo
+
+func (m *ClaimDailyRewardResponse) GetStreak() uint32 {
+	if m != nil {
+		return m.Streak
+	}
+	return 0
+}
+
+func (m *ClaimDailyRewardResponse) GetRewardNuts() uint64 {
+	if m != nil {
+		return m.RewardNuts
+	}
+	return 0
+}
+
+func (m *ClaimDailyRewardResponse) GetNuts() uint64 {
+	if m != nil {
+		return m.Nuts
+	}
+	return 0
+}
+
+func (m *ClaimDailyRewardResponse) GetGold() uint64 {
+	if m != nil {
+		return m.Gold
+	}
+	return 0
+}
+
+type GetQuestsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetQuestsRequest) Reset()         { *m = GetQuestsRequest{} }
+func (m *GetQuestsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetQuestsRequest) ProtoMessage()    {}
+func (*GetQuestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{48}
+}
+
+func (m *GetQuestsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQuestsRequest.Unmarshal(m, b)
+}
+func (m *GetQuestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQuestsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetQuestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQuestsRequest.Merge(m, src)
+}
+func (m *GetQuestsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetQuestsRequest.Size(m)
+}
+func (m *GetQuestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQuestsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQuestsRequest proto.InternalMessageInfo
+
+type GetQuestsResponse struct {
+	Quests               []*Quest `protobuf:"bytes,1,rep,name=quests,proto3" json:"quests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetQuestsResponse) Reset()         { *m = GetQuestsResponse{} }
+func (m *GetQuestsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetQuestsResponse) ProtoMessage()    {}
+func (*GetQuestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{49}
+}
+
+func (m *GetQuestsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQuestsResponse.Unmarshal(m, b)
+}
+func (m *GetQuestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQuestsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetQuestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQuestsResponse.Merge(m, src)
+}
+func (m *GetQuestsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetQuestsResponse.Size(m)
+}
+func (m *GetQuestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQuestsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQuestsResponse proto.InternalMessageInfo
+
+func (m *GetQuestsResponse) GetQuests() []*Quest {
+	if m != nil {
+		return m.Quests
+	}
+	return nil
+}
+
+type Quest struct {
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Goal        uint32 `protobuf:"varint,4,opt,name=goal,proto3" json:"goal,omitempty"`
+	Progress    uint32 `protobuf:"varint,5,opt,name=progress,proto3" json:"progress,omitempty"`
+	// zero if quest is not completed
+	CompletedAt          int64    `protobuf:"varint,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RewardNuts           uint64   `protobuf:"varint,8,opt,name=reward_nuts,json=rewardNuts,proto3" json:"reward_nuts,omitempty"`
+	RewardGold           uint64   `protobuf:"varint,9,opt,name=reward_gold,json=rewardGold,proto3" json:"reward_gold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Quest) Reset()         { *m = Quest{} }
+func (m *Quest) String() string { return proto.CompactTextString(m) }
+func (*Quest) ProtoMessage()    {}
+func (*Quest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{50}
+}
+
+func (m *Quest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Quest.Unmarshal(m, b)
+}
+func (m *Quest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Quest.Marshal(b, m, deterministic)
+}
+func (m *Quest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Quest.Merge(m, src)
+}
+func (m *Quest) XXX_Size() int {
+	return xxx_messageInfo_Quest.Size(m)
+}
+func (m *Quest) XXX_DiscardUnknown() {
+	xxx_messageInfo_Quest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Quest proto.InternalMessageInfo
+
+func (m *Quest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Quest) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Quest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Quest) GetGoal() uint32 {
+	if m != nil {
+		return m.Goal
+	}
+	return 0
+}
+
+func (m *Quest) GetProgress() uint32 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+func (m *Quest) GetCompletedAt() int64 {
+	if m != nil {
+		return m.CompletedAt
+	}
+	return 0
+}
+
+func (m *Quest) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *Quest) GetRewardNuts() uint64 {
+	if m != nil {
+		return m.RewardNuts
+	}
+	return 0
+}
+
+func (m *Quest) GetRewardGold() uint64 {
+	if m != nil {
+		return m.RewardGold
+	}
+	return 0
+}
+
+type PendingRewards struct {
+	// daily reward is available to claim
+	DailyReward bool `protobuf:"varint,1,opt,name=daily_reward,json=dailyReward,proto3" json:"daily_reward,omitempty"`
+	// streak after claiming daily reward
+	Streak          uint32 `protobuf:"varint,2,opt,name=streak,proto3" json:"streak,omitempty"`
+	DailyRewardNuts uint64 `protobuf:"varint,3,opt,name=daily_reward_nuts,json=dailyRewardNuts,proto3" json:"daily_reward_nuts,omitempty"`
+	// active quests not completed yet
+	Quests               []*Quest `protobuf:"bytes,4,rep,name=quests,proto3" json:"quests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingRewards) Reset()         { *m = PendingRewards{} }
+func (m *PendingRewards) String() string { return proto.CompactTextString(m) }
+func (*PendingRewards) ProtoMessage()    {}
+func (*PendingRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{51}
+}
+
+func (m *PendingRewards) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingRewards.Unmarshal(m, b)
+}
+func (m *PendingRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingRewards.Marshal(b, m, deterministic)
+}
+func (m *PendingRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRewards.Merge(m, src)
+}
+func (m *PendingRewards) XXX_Size() int {
+	return xxx_messageInfo_PendingRewards.Size(m)
+}
+func (m *PendingRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRewards proto.InternalMessageInfo
+
+func (m *PendingRewards) GetDailyReward() bool {
+	if m != nil {
+		return m.DailyReward
+	}
+	return false
+}
+
+func (m *PendingRewards) GetStreak() uint32 {
+	if m != nil {
+		return m.Streak
+	}
+	return 0
+}
+
+func (m *PendingRewards) GetDailyRewardNuts() uint64 {
+	if m != nil {
+		return m.DailyRewardNuts
+	}
+	return 0
+}
+
+func (m *PendingRewards) GetQuests() []*Quest {
+	if m != nil {
+		return m.Quests
+	}
+	return nil
+}
+
+type GetTournamentsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTournamentsRequest) Reset()         { *m = GetTournamentsRequest{} }
+func (m *GetTournamentsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTournamentsRequest) ProtoMessage()    {}
+func (*GetTournamentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{52}
+}
+
+func (m *GetTournamentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTournamentsRequest.Unmarshal(m, b)
+}
+func (m *GetTournamentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTournamentsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTournamentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTournamentsRequest.Merge(m, src)
+}
+func (m *GetTournamentsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTournamentsRequest.Size(m)
+}
+func (m *GetTournamentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTournamentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTournamentsRequest proto.InternalMessageInfo
+
+type GetTournamentsResponse struct {
+	Tournaments          []*Tournament `protobuf:"bytes,1,rep,name=tournaments,proto3" json:"tournaments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetTournamentsResponse) Reset()         { *m = GetTournamentsResponse{} }
+func (m *GetTournamentsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTournamentsResponse) ProtoMessage()    {}
+func (*GetTournamentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{53}
+}
+
+func (m *GetTournamentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTournamentsResponse.Unmarshal(m, b)
+}
+func (m *GetTournamentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTournamentsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTournamentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTournamentsResponse.Merge(m, src)
+}
+func (m *GetTournamentsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTournamentsResponse.Size(m)
+}
+func (m *GetTournamentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTournamentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTournamentsResponse proto.InternalMessageInfo
+
+func (m *GetTournamentsResponse) GetTournaments() []*Tournament {
+	if m != nil {
+		return m.Tournaments
+	}
+	return nil
+}
+
+type GetTournamentRequest struct {
+	TournamentId         string   `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTournamentRequest) Reset()         { *m = GetTournamentRequest{} }
+func (m *GetTournamentRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTournamentRequest) ProtoMessage()    {}
+func (*GetTournamentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{54}
+}
+
+func (m *GetTournamentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTournamentRequest.Unmarshal(m, b)
+}
+func (m *GetTournamentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTournamentRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTournamentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTournamentRequest.Merge(m, src)
+}
+func (m *GetTournamentRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTournamentRequest.Size(m)
+}
+func (m *GetTournamentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTournamentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTournamentRequest proto.InternalMessageInfo
+
+func (m *GetTournamentRequest) GetTournamentId() string {
+	if m != nil {
+		return m.TournamentId
+	}
+	return ""
+}
+
+type GetTournamentResponse struct {
+	Tournament           *Tournament        `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	Entries              []*TournamentEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetTournamentResponse) Reset()         { *m = GetTournamentResponse{} }
+func (m *GetTournamentResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTournamentResponse) ProtoMessage()    {}
+func (*GetTournamentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{55}
+}
+
+func (m *GetTournamentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTournamentResponse.Unmarshal(m, b)
+}
+func (m *GetTournamentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTournamentResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTournamentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTournamentResponse.Merge(m, src)
+}
+func (m *GetTournamentResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTournamentResponse.Size(m)
+}
+func (m *GetTournamentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTournamentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTournamentResponse proto.InternalMessageInfo
+
+func (m *GetTournamentResponse) GetTournament() *Tournament {
+	if m != nil {
+		return m.Tournament
+	}
+	return nil
+}
+
+func (m *GetTournamentResponse) GetEntries() []*TournamentEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type RegisterTournamentRequest struct {
+	TournamentId string `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	// friend to play with in team tournaments. Every player pays own
+	// entry fee, partner accepts by registering back with the player.
+	// Entries partner has not accepted are dropped when tournament starts.
+	PartnerId            string   `protobuf:"bytes,2,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterTournamentRequest) Reset()         { *m = RegisterTournamentRequest{} }
+func (m *RegisterTournamentRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterTournamentRequest) ProtoMessage()    {}
+func (*RegisterTournamentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{56}
+}
+
+func (m *RegisterTournamentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterTournamentRequest.Unmarshal(m, b)
+}
+func (m *RegisterTournamentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterTournamentRequest.Marshal(b, m, deterministic)
+}
+func (m *RegisterTournamentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterTournamentRequest.Merge(m, src)
+}
+func (m *RegisterTournamentRequest) XXX_Size() int {
+	return xxx_messageInfo_RegisterTournamentRequest.Size(m)
+}
+func (m *RegisterTournamentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterTournamentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterTournamentRequest proto.InternalMessageInfo
+
+func (m *RegisterTournamentRequest) GetTournamentId() string {
+	if m != nil {
+		return m.TournamentId
+	}
+	return ""
+}
+
+func (m *RegisterTournamentRequest) GetPartnerId() string {
+	if m != nil {
+		return m.PartnerId
+	}
+	return ""
+}
+
+type RegisterTournamentResponse struct {
+	Nuts                 uint64   `protobuf:"varint,1,opt,name=nuts,proto3" json:"nuts,omitempty"`
+	Gold                 uint64   `protobuf:"varint,2,opt,name=gold,proto3" json:"gold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterTournamentResponse) Reset()         { *m = RegisterTournamentResponse{} }
+func (m *RegisterTournamentResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterTournamentResponse) ProtoMessage()    {}
+func (*RegisterTournamentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{57}
+}
+
+func (m *RegisterTournamentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterTournamentResponse.Unmarshal(m, b)
+}
+func (m *RegisterTournamentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterTournamentResponse.Marshal(b, m, deterministic)
+}
+func (m *RegisterTournamentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterTournamentResponse.Merge(m, src)
+}
+func (m *RegisterTournamentResponse) XXX_Size() int {
+	return xxx_messageInfo_RegisterTournamentResponse.Size(m)
+}
+func (m *RegisterTournamentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterTournamentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterTournamentResponse proto.InternalMessageInfo
+
+func (m *RegisterTournamentResponse) GetNuts() uint64 {
+	if m != nil {
+		return m.Nuts
+	}
+	return 0
+}
+
+func (m *RegisterTournamentResponse) GetGold() uint64 {
+	if m != nil {
+		return m.Gold
+	}
+	return 0
+}
+
+type UnregisterTournamentRequest struct {
+	TournamentId         string   `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnregisterTournamentRequest) Reset()         { *m = UnregisterTournamentRequest{} }
+func (m *UnregisterTournamentRequest) String() string { return proto.CompactTextString(m) }
+func (*UnregisterTournamentRequest) ProtoMessage()    {}
+func (*UnregisterTournamentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{58}
+}
+
+func (m *UnregisterTournamentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnregisterTournamentRequest.Unmarshal(m, b)
+}
+func (m *UnregisterTournamentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnregisterTournamentRequest.Marshal(b, m, deterministic)
+}
+func (m *UnregisterTournamentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnregisterTournamentRequest.Merge(m, src)
+}
+func (m *UnregisterTournamentRequest) XXX_Size() int {
+	return xxx_messageInfo_UnregisterTournamentRequest.Size(m)
+}
+func (m *UnregisterTournamentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnregisterTournamentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnregisterTournamentRequest proto.InternalMessageInfo
+
+func (m *UnregisterTournamentRequest) GetTournamentId() string {
+	if m != nil {
+		return m.TournamentId
+	}
+	return ""
+}
+
+type UnregisterTournamentResponse struct {
+	Nuts                 uint64   `protobuf:"varint,1,opt,name=nuts,proto3" json:"nuts,omitempty"`
+	Gold                 uint64   `protobuf:"varint,2,opt,name=gold,proto3" json:"gold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnregisterTournamentResponse) Reset()         { *m = UnregisterTournamentResponse{} }
+func (m *UnregisterTournamentResponse) String() string { return proto.CompactTextString(m) }
+func (*UnregisterTournamentResponse) ProtoMessage()    {}
+func (*UnregisterTournamentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{59}
+}
+
+func (m *UnregisterTournamentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnregisterTournamentResponse.Unmarshal(m, b)
+}
+func (m *UnregisterTournamentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnregisterTournamentResponse.Marshal(b, m, deterministic)
+}
+func (m *UnregisterTournamentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnregisterTournamentResponse.Merge(m, src)
+}
+func (m *UnregisterTournamentResponse) XXX_Size() int {
+	return xxx_messageInfo_UnregisterTournamentResponse.Size(m)
+}
+func (m *UnregisterTournamentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnregisterTournamentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnregisterTournamentResponse proto.InternalMessageInfo
+
+func (m *UnregisterTournamentResponse) GetNuts() uint64 {
+	if m != nil {
+		return m.Nuts
+	}
+	return 0
+}
+
+func (m *UnregisterTournamentResponse) GetGold() uint64 {
+	if m != nil {
+		return m.Gold
+	}
+	return 0
+}
+
+type Tournament struct {
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// elimination or swiss
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	// registration, running, finished or cancelled
+	State      string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	TeamSize   uint32 `protobuf:"varint,5,opt,name=team_size,json=teamSize,proto3" json:"team_size,omitempty"`
+	Currency   string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	EntryFee   uint32 `protobuf:"varint,7,opt,name=entry_fee,json=entryFee,proto3" json:"entry_fee,omitempty"`
+	PrizePool  uint64 `protobuf:"varint,8,opt,name=prize_pool,json=prizePool,proto3" json:"prize_pool,omitempty"`
+	Entries    uint32 `protobuf:"varint,9,opt,name=entries,proto3" json:"entries,omitempty"`
+	MaxEntries uint32 `protobuf:"varint,10,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
+	StartsAt   int64  `protobuf:"varint,11,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	Round      uint32 `protobuf:"varint,12,opt,name=round,proto3" json:"round,omitempty"`
+	// rounds of swiss tournament
+	Rounds               uint32   `protobuf:"varint,13,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Tournament) Reset()         { *m = Tournament{} }
+func (m *Tournament) String() string { return proto.CompactTextString(m) }
+func (*Tournament) ProtoMessage()    {}
+func (*Tournament) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{60}
+}
+
+func (m *Tournament) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Tournament.Unmarshal(m, b)
+}
+func (m *Tournament) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Tournament.Marshal(b, m, deterministic)
+}
+func (m *Tournament) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tournament.Merge(m, src)
+}
+func (m *Tournament) XXX_Size() int {
+	return xxx_messageInfo_Tournament.Size(m)
+}
+func (m *Tournament) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tournament.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tournament proto.InternalMessageInfo
+
+func (m *Tournament) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Tournament) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Tournament) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *Tournament) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *Tournament) GetTeamSize() uint32 {
+	if m != nil {
+		return m.TeamSize
+	}
+	return 0
+}
+
+func (m *Tournament) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *Tournament) GetEntryFee() uint32 {
+	if m != nil {
+		return m.EntryFee
+	}
+	return 0
+}
+
+func (m *Tournament) GetPrizePool() uint64 {
+	if m != nil {
+		return m.PrizePool
+	}
+	return 0
+}
+
+func (m *Tournament) GetEntries() uint32 {
+	if m != nil {
+		return m.Entries
+	}
+	return 0
+}
+
+func (m *Tournament) GetMaxEntries() uint32 {
+	if m != nil {
+		return m.MaxEntries
+	}
+	return 0
+}
+
+func (m *Tournament) GetStartsAt() int64 {
+	if m != nil {
+		return m.StartsAt
+	}
+	return 0
+}
+
+func (m *Tournament) GetRound() uint32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *Tournament) GetRounds() uint32 {
+	if m != nil {
+		return m.Rounds
+	}
+	return 0
+}
+
+type TournamentEntry struct {
+	Id         string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Player     *Player `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+	Partner    *Player `protobuf:"bytes,3,opt,name=partner,proto3" json:"partner,omitempty"`
+	Points     uint32  `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	Eliminated bool    `protobuf:"varint,5,opt,name=eliminated,proto3" json:"eliminated,omitempty"`
+	Place      uint32  `protobuf:"varint,6,opt,name=place,proto3" json:"place,omitempty"`
+	Prize      uint64  `protobuf:"varint,7,opt,name=prize,proto3" json:"prize,omitempty"`
+	// false while team entry waits for partner to register back
+	Accepted             bool     `protobuf:"varint,8,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TournamentEntry) Reset()         { *m = TournamentEntry{} }
+func (m *TournamentEntry) String() string { return proto.CompactTextString(m) }
+func (*TournamentEntry) ProtoMessage()    {}
+func (*TournamentEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{61}
+}
+
+func (m *TournamentEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TournamentEntry.Unmarshal(m, b)
+}
+func (m *TournamentEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TournamentEntry.Marshal(b, m, deterministic)
+}
+func (m *TournamentEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TournamentEntry.Merge(m, src)
+}
+func (m *TournamentEntry) XXX_Size() int {
+	return xxx_messageInfo_TournamentEntry.Size(m)
+}
+func (m *TournamentEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_TournamentEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TournamentEntry proto.InternalMessageInfo
+
+func (m *TournamentEntry) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *TournamentEntry) GetPlayer() *Player {
+	if m != nil {
+		return m.Player
+	}
+	return nil
+}
+
+func (m *TournamentEntry) GetPartner() *Player {
+	if m != nil {
+		return m.Partner
+	}
+	return nil
+}
+
+func (m *TournamentEntry) GetPoints() uint32 {
+	if m != nil {
+		return m.Points
+	}
+	return 0
+}
+
+func (m *TournamentEntry) GetEliminated() bool {
+	if m != nil {
+		return m.Eliminated
+	}
+	return false
+}
+
+func (m *TournamentEntry) GetPlace() uint32 {
+	if m != nil {
+		return m.Place
+	}
+	return 0
+}
+
+func (m *TournamentEntry) GetPrize() uint64 {
+	if m != nil {
+		return m.Prize
+	}
+	return 0
+}
+
+func (m *TournamentEntry) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+type Notification struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event                string   `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Payload              string   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	Read                 bool     `protobuf:"varint,4,opt,name=read,proto3" json:"read,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Notification) Reset()         { *m = Notification{} }
+func (m *Notification) String() string { return proto.CompactTextString(m) }
+func (*Notification) ProtoMessage()    {}
+func (*Notification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{62}
+}
+
+func (m *Notification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Notification.Unmarshal(m, b)
+}
+func (m *Notification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Notification.Marshal(b, m, deterministic)
+}
+func (m *Notification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Notification.Merge(m, src)
+}
+func (m *Notification) XXX_Size() int {
+	return xxx_messageInfo_Notification.Size(m)
+}
+func (m *Notification) XXX_DiscardUnknown() {
+	xxx_messageInfo_Notification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Notification proto.InternalMessageInfo
+
+func (m *Notification) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Notification) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *Notification) GetPayload() string {
+	if m != nil {
+		return m.Payload
+	}
+	return ""
+}
+
+func (m *Notification) GetRead() bool {
+	if m != nil {
+		return m.Read
+	}
+	return false
+}
+
+func (m *Notification) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type GetNotificationsRequest struct {
+	UnreadOnly           bool     `protobuf:"varint,1,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	Offset               uint32   `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                uint32   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetNotificationsRequest) Reset()         { *m = GetNotificationsRequest{} }
+func (m *GetNotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetNotificationsRequest) ProtoMessage()    {}
+func (*GetNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{63}
+}
+
+func (m *GetNotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNotificationsRequest.Unmarshal(m, b)
+}
+func (m *GetNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNotificationsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetNotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNotificationsRequest.Merge(m, src)
+}
+func (m *GetNotificationsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetNotificationsRequest.Size(m)
+}
+func (m *GetNotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNotificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNotificationsRequest proto.InternalMessageInfo
+
+func (m *GetNotificationsRequest) GetUnreadOnly() bool {
+	if m != nil {
+		return m.UnreadOnly
+	}
+	return false
+}
+
+func (m *GetNotificationsRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *GetNotificationsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetNotificationsResponse struct {
+	Notifications        []*Notification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	Total                uint32          `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Unread               uint32          `protobuf:"varint,3,opt,name=unread,proto3" json:"unread,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetNotificationsResponse) Reset()         { *m = GetNotificationsResponse{} }
+func (m *GetNotificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetNotificationsResponse) ProtoMessage()    {}
+func (*GetNotificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{64}
+}
+
+func (m *GetNotificationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNotificationsResponse.Unmarshal(m, b)
+}
+func (m *GetNotificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNotificationsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetNotificationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNotificationsResponse.Merge(m, src)
+}
+func (m *GetNotificationsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetNotificationsResponse.Size(m)
+}
+func (m *GetNotificationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNotificationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNotificationsResponse proto.InternalMessageInfo
+
+func (m *GetNotificationsResponse) GetNotifications() []*Notification {
+	if m != nil {
+		return m.Notifications
+	}
+	return nil
+}
+
+func (m *GetNotificationsResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *GetNotificationsResponse) GetUnread() uint32 {
+	if m != nil {
+		return m.Unread
+	}
+	return 0
+}
+
+type MarkReadRequest struct {
+	NotificationIds      []string `protobuf:"bytes,1,rep,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MarkReadRequest) Reset()         { *m = MarkReadRequest{} }
+func (m *MarkReadRequest) String() string { return proto.CompactTextString(m) }
+func (*MarkReadRequest) ProtoMessage()    {}
+func (*MarkReadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{65}
+}
+
+func (m *MarkReadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarkReadRequest.Unmarshal(m, b)
+}
+func (m *MarkReadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarkReadRequest.Marshal(b, m, deterministic)
+}
+func (m *MarkReadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkReadRequest.Merge(m, src)
+}
+func (m *MarkReadRequest) XXX_Size() int {
+	return xxx_messageInfo_MarkReadRequest.Size(m)
+}
+func (m *MarkReadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkReadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkReadRequest proto.InternalMessageInfo
+
+func (m *MarkReadRequest) GetNotificationIds() []string {
+	if m != nil {
+		return m.NotificationIds
+	}
+	return nil
+}
+
+type MarkReadResponse struct {
+	Unread               uint32   `protobuf:"varint,1,opt,name=unread,proto3" json:"unread,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MarkReadResponse) Reset()         { *m = MarkReadResponse{} }
+func (m *MarkReadResponse) String() string { return proto.CompactTextString(m) }
+func (*MarkReadResponse) ProtoMessage()    {}
+func (*MarkReadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{66}
+}
+
+func (m *MarkReadResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarkReadResponse.Unmarshal(m, b)
+}
+func (m *MarkReadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarkReadResponse.Marshal(b, m, deterministic)
+}
+func (m *MarkReadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkReadResponse.Merge(m, src)
+}
+func (m *MarkReadResponse) XXX_Size() int {
+	return xxx_messageInfo_MarkReadResponse.Size(m)
+}
+func (m *MarkReadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkReadResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkReadResponse proto.InternalMessageInfo
+
+func (m *MarkReadResponse) GetUnread() uint32 {
+	if m != nil {
+		return m.Unread
+	}
+	return 0
+}
+
+type GetProductsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProductsRequest) Reset()         { *m = GetProductsRequest{} }
+func (m *GetProductsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetProductsRequest) ProtoMessage()    {}
+func (*GetProductsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{67}
+}
+
+func (m *GetProductsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProductsRequest.Unmarshal(m, b)
+}
+func (m *GetProductsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProductsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetProductsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProductsRequest.Merge(m, src)
+}
+func (m *GetProductsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetProductsRequest.Size(m)
+}
+func (m *GetProductsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProductsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProductsRequest proto.InternalMessageInfo
+
+type GetProductsResponse struct {
+	Products             []*Product `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GetProductsResponse) Reset()         { *m = GetProductsResponse{} }
+func (m *GetProductsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetProductsResponse) ProtoMessage()    {}
+func (*GetProductsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{68}
+}
+
+func (m *GetProductsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProductsResponse.Unmarshal(m, b)
+}
+func (m *GetProductsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProductsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetProductsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProductsResponse.Merge(m, src)
+}
+func (m *GetProductsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetProductsResponse.Size(m)
+}
+func (m *GetProductsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProductsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProductsResponse proto.InternalMessageInfo
+
+func (m *GetProductsResponse) GetProducts() []*Product {
+	if m != nil {
+		return m.Products
+	}
+	return nil
+}
+
+type PurchaseProductRequest struct {
+	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// generated by client once per purchase and resent on retries
+	PurchaseId           string   `protobuf:"bytes,2,opt,name=purchase_id,json=purchaseId,proto3" json:"purchase_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurchaseProductRequest) Reset()         { *m = PurchaseProductRequest{} }
+func (m *PurchaseProductRequest) String() string { return proto.CompactTextString(m) }
+func (*PurchaseProductRequest) ProtoMessage()    {}
+func (*PurchaseProductRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{69}
+}
+
+func (m *PurchaseProductRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurchaseProductRequest.Unmarshal(m, b)
+}
+func (m *PurchaseProductRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurchaseProductRequest.Marshal(b, m, deterministic)
+}
+func (m *PurchaseProductRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurchaseProductRequest.Merge(m, src)
+}
+func (m *PurchaseProductRequest) XXX_Size() int {
+	return xxx_messageInfo_PurchaseProductRequest.Size(m)
+}
+func (m *PurchaseProductRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurchaseProductRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurchaseProductRequest proto.InternalMessageInfo
+
+func (m *PurchaseProductRequest) GetProductId() string {
+	if m != nil {
+		return m.ProductId
+	}
+	return ""
+}
+
+func (m *PurchaseProductRequest) GetPurchaseId() string {
+	if m != nil {
+		return m.PurchaseId
+	}
+	return ""
+}
+
+type PurchaseProductResponse struct {
+	PurchaseId           string   `protobuf:"bytes,1,opt,name=purchase_id,json=purchaseId,proto3" json:"purchase_id,omitempty"`
+	Nuts                 uint64   `protobuf:"varint,2,opt,name=nuts,proto3" json:"nuts,omitempty"`
+	Gold                 uint64   `protobuf:"varint,3,opt,name=gold,proto3" json:"gold,omitempty"`
+	VipUntil             int64    `protobuf:"varint,4,opt,name=vip_until,json=vipUntil,proto3" json:"vip_until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurchaseProductResponse) Reset()         { *m = PurchaseProductResponse{} }
+func (m *PurchaseProductResponse) String() string { return proto.CompactTextString(m) }
+func (*PurchaseProductResponse) ProtoMessage()    {}
+func (*PurchaseProductResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{70}
+}
+
+func (m *PurchaseProductResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurchaseProductResponse.Unmarshal(m, b)
+}
+func (m *PurchaseProductResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurchaseProductResponse.Marshal(b, m, deterministic)
+}
+func (m *PurchaseProductResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurchaseProductResponse.Merge(m, src)
+}
+func (m *PurchaseProductResponse) XXX_Size() int {
+	return xxx_messageInfo_PurchaseProductResponse.Size(m)
+}
+func (m *PurchaseProductResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurchaseProductResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurchaseProductResponse proto.InternalMessageInfo
+
+func (m *PurchaseProductResponse) GetPurchaseId() string {
+	if m != nil {
+		return m.PurchaseId
+	}
+	return ""
+}
+
+func (m *PurchaseProductResponse) GetNuts() uint64 {
+	if m != nil {
+		return m.Nuts
+	}
+	return 0
+}
+
+func (m *PurchaseProductResponse) GetGold() uint64 {
+	if m != nil {
+		return m.Gold
+	}
+	return 0
+}
+
+func (m *PurchaseProductResponse) GetVipUntil() int64 {
+	if m != nil {
+		return m.VipUntil
+	}
+	return 0
+}
+
+type CreateCheckoutRequest struct {
+	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// generated by client once per purchase and resent on retries
+	PurchaseId           string   `protobuf:"bytes,2,opt,name=purchase_id,json=purchaseId,proto3" json:"purchase_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateCheckoutRequest) Reset()         { *m = CreateCheckoutRequest{} }
+func (m *CreateCheckoutRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCheckoutRequest) ProtoMessage()    {}
+func (*CreateCheckoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{71}
+}
+
+func (m *CreateCheckoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCheckoutRequest.Unmarshal(m, b)
+}
+func (m *CreateCheckoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCheckoutRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateCheckoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCheckoutRequest.Merge(m, src)
+}
+func (m *CreateCheckoutRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCheckoutRequest.Size(m)
+}
+func (m *CreateCheckoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCheckoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCheckoutRequest proto.InternalMessageInfo
+
+func (m *CreateCheckoutRequest) GetProductId() string {
+	if m != nil {
+		return m.ProductId
+	}
+	return ""
+}
+
+func (m *CreateCheckoutRequest) GetPurchaseId() string {
+	if m != nil {
+		return m.PurchaseId
+	}
+	return ""
+}
+
+type CreateCheckoutResponse struct {
+	PurchaseId           string   `protobuf:"bytes,1,opt,name=purchase_id,json=purchaseId,proto3" json:"purchase_id,omitempty"`
+	CheckoutUrl          string   `protobuf:"bytes,2,opt,name=checkout_url,json=checkoutUrl,proto3" json:"checkout_url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateCheckoutResponse) Reset()         { *m = CreateCheckoutResponse{} }
+func (m *CreateCheckoutResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateCheckoutResponse) ProtoMessage()    {}
+func (*CreateCheckoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{72}
+}
+
+func (m *CreateCheckoutResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCheckoutResponse.Unmarshal(m, b)
+}
+func (m *CreateCheckoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCheckoutResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateCheckoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCheckoutResponse.Merge(m, src)
+}
+func (m *CreateCheckoutResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateCheckoutResponse.Size(m)
+}
+func (m *CreateCheckoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCheckoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCheckoutResponse proto.InternalMessageInfo
+
+func (m *CreateCheckoutResponse) GetPurchaseId() string {
+	if m != nil {
+		return m.PurchaseId
+	}
+	return ""
+}
+
+func (m *CreateCheckoutResponse) GetCheckoutUrl() string {
+	if m != nil {
+		return m.CheckoutUrl
+	}
+	return ""
+}
+
+type VerifyReceiptRequest struct {
+	PurchaseId           string   `protobuf:"bytes,1,opt,name=purchase_id,json=purchaseId,proto3" json:"purchase_id,omitempty"`
+	Receipt              string   `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyReceiptRequest) Reset()         { *m = VerifyReceiptRequest{} }
+func (m *VerifyReceiptRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyReceiptRequest) ProtoMessage()    {}
+func (*VerifyReceiptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{73}
+}
+
+func (m *VerifyReceiptRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyReceiptRequest.Unmarshal(m, b)
+}
+func (m *VerifyReceiptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyReceiptRequest.Marshal(b, m, deterministic)
+}
+func (m *VerifyReceiptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyReceiptRequest.Merge(m, src)
+}
+func (m *VerifyReceiptRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyReceiptRequest.Size(m)
+}
+func (m *VerifyReceiptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyReceiptRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyReceiptRequest proto.InternalMessageInfo
+
+func (m *VerifyReceiptRequest) GetPurchaseId() string {
+	if m != nil {
+		return m.PurchaseId
+	}
+	return ""
+}
+
+func (m *VerifyReceiptRequest) GetReceipt() string {
+	if m != nil {
+		return m.Receipt
+	}
+	return ""
+}
+
+type VerifyReceiptResponse struct {
+	PurchaseId           string   `protobuf:"bytes,1,opt,name=purchase_id,json=purchaseId,proto3" json:"purchase_id,omitempty"`
+	Nuts                 uint64   `protobuf:"varint,2,opt,name=nuts,proto3" json:"nuts,omitempty"`
+	Gold                 uint64   `protobuf:"varint,3,opt,name=gold,proto3" json:"gold,omitempty"`
+	VipUntil             int64    `protobuf:"varint,4,opt,name=vip_until,json=vipUntil,proto3" json:"vip_until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyReceiptResponse) Reset()         { *m = VerifyReceiptResponse{} }
+func (m *VerifyReceiptResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyReceiptResponse) ProtoMessage()    {}
+func (*VerifyReceiptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{74}
+}
+
+func (m *VerifyReceiptResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyReceiptResponse.Unmarshal(m, b)
+}
+func (m *VerifyReceiptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyReceiptResponse.Marshal(b, m, deterministic)
+}
+func (m *VerifyReceiptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyReceiptResponse.Merge(m, src)
+}
+func (m *VerifyReceiptResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyReceiptResponse.Size(m)
+}
+func (m *VerifyReceiptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyReceiptResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyReceiptResponse proto.InternalMessageInfo
+
+func (m *VerifyReceiptResponse) GetPurchaseId() string {
+	if m != nil {
+		return m.PurchaseId
+	}
+	return ""
+}
+
+func (m *VerifyReceiptResponse) GetNuts() uint64 {
+	if m != nil {
+		return m.Nuts
+	}
+	return 0
+}
+
+func (m *VerifyReceiptResponse) GetGold() uint64 {
+	if m != nil {
+		return m.Gold
+	}
+	return 0
+}
+
+func (m *VerifyReceiptResponse) GetVipUntil() int64 {
+	if m != nil {
+		return m.VipUntil
+	}
+	return 0
+}
+
+type GetInventoryRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetInventoryRequest) Reset()         { *m = GetInventoryRequest{} }
+func (m *GetInventoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetInventoryRequest) ProtoMessage()    {}
+func (*GetInventoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{75}
+}
+
+func (m *GetInventoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetInventoryRequest.Unmarshal(m, b)
+}
+func (m *GetInventoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetInventoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetInventoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetInventoryRequest.Merge(m, src)
+}
+func (m *GetInventoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetInventoryRequest.Size(m)
+}
+func (m *GetInventoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetInventoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetInventoryRequest proto.InternalMessageInfo
+
+type GetInventoryResponse struct {
+	Items                []*InventoryItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetInventoryResponse) Reset()         { *m = GetInventoryResponse{} }
+func (m *GetInventoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetInventoryResponse) ProtoMessage()    {}
+func (*GetInventoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{76}
+}
+
+func (m *GetInventoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetInventoryResponse.Unmarshal(m, b)
+}
+func (m *GetInventoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetInventoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetInventoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetInventoryResponse.Merge(m, src)
+}
+func (m *GetInventoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetInventoryResponse.Size(m)
+}
+func (m *GetInventoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetInventoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetInventoryResponse proto.InternalMessageInfo
+
+func (m *GetInventoryResponse) GetItems() []*InventoryItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type InventoryItem struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title                string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity             uint32   `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InventoryItem) Reset()         { *m = InventoryItem{} }
+func (m *InventoryItem) String() string { return proto.CompactTextString(m) }
+func (*InventoryItem) ProtoMessage()    {}
+func (*InventoryItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{77}
+}
+
+func (m *InventoryItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InventoryItem.Unmarshal(m, b)
+}
+func (m *InventoryItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InventoryItem.Marshal(b, m, deterministic)
+}
+func (m *InventoryItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InventoryItem.Merge(m, src)
+}
+func (m *InventoryItem) XXX_Size() int {
+	return xxx_messageInfo_InventoryItem.Size(m)
+}
+func (m *InventoryItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_InventoryItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InventoryItem proto.InternalMessageInfo
+
+func (m *InventoryItem) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *InventoryItem) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *InventoryItem) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *InventoryItem) GetQuantity() uint32 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *InventoryItem) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+type Product struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title                string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price                uint32   `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	Currency             string   `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Product) Reset()         { *m = Product{} }
+func (m *Product) String() string { return proto.CompactTextString(m) }
+func (*Product) ProtoMessage()    {}
+func (*Product) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{78}
+}
+
+func (m *Product) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Product.Unmarshal(m, b)
+}
+func (m *Product) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Product.Marshal(b, m, deterministic)
+}
+func (m *Product) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Product.Merge(m, src)
+}
+func (m *Product) XXX_Size() int {
+	return xxx_messageInfo_Product.Size(m)
+}
+func (m *Product) XXX_DiscardUnknown() {
+	xxx_messageInfo_Product.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Product proto.InternalMessageInfo
+
+func (m *Product) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Product) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Product) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Product) GetPrice() uint32 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *Product) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+type CreateTableRequest struct {
+	Currency             string   `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Bet                  uint32   `protobuf:"varint,2,opt,name=bet,proto3" json:"bet,omitempty"`
+	Private              bool     `protobuf:"varint,3,opt,name=private,proto3" json:"private,omitempty"`
+	Variant              string   `protobuf:"bytes,4,opt,name=variant,proto3" json:"variant,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateTableRequest) Reset()         { *m = CreateTableRequest{} }
+func (m *CreateTableRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateTableRequest) ProtoMessage()    {}
+func (*CreateTableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{79}
+}
+
+func (m *CreateTableRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTableRequest.Unmarshal(m, b)
+}
+func (m *CreateTableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTableRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateTableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTableRequest.Merge(m, src)
+}
+func (m *CreateTableRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateTableRequest.Size(m)
+}
+func (m *CreateTableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTableRequest proto.InternalMessageInfo
+
+func (m *CreateTableRequest) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *CreateTableRequest) GetBet() uint32 {
+	if m != nil {
+		return m.Bet
+	}
+	return 0
+}
+
+func (m *CreateTableRequest) GetPrivate() bool {
+	if m != nil {
+		return m.Private
+	}
+	return false
+}
+
+func (m *CreateTableRequest) GetVariant() string {
+	if m != nil {
+		return m.Variant
+	}
+	return ""
+}
+
+type CreateTableResponse struct {
+	TableId              string   `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	UnitType             string   `protobuf:"bytes,2,opt,name=unit_type,json=unitType,proto3" json:"unit_type,omitempty"`
+	Bet                  uint32   `protobuf:"varint,3,opt,name=bet,proto3" json:"bet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateTableResponse) Reset()         { *m = CreateTableResponse{} }
+func (m *CreateTableResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateTableResponse) ProtoMessage()    {}
+func (*CreateTableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{80}
+}
+
+func (m *CreateTableResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTableResponse.Unmarshal(m, b)
+}
+func (m *CreateTableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTableResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateTableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTableResponse.Merge(m, src)
+}
+func (m *CreateTableResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateTableResponse.Size(m)
+}
+func (m *CreateTableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTableResponse proto.InternalMessageInfo
+
+func (m *CreateTableResponse) GetTableId() string {
+	if m != nil {
+		return m.TableId
+	}
+	return ""
+}
+
+func (m *CreateTableResponse) GetUnitType() string {
+	if m != nil {
+		return m.UnitType
+	}
+	return ""
+}
+
+func (m *CreateTableResponse) GetBet() uint32 {
+	if m != nil {
+		return m.Bet
+	}
+	return 0
+}
+
+type GetOpenTablesRequest struct {
+	Currency             string   `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Variant              string   `protobuf:"bytes,2,opt,name=variant,proto3" json:"variant,omitempty"`
+	MinBet               uint32   `protobuf:"varint,3,opt,name=min_bet,json=minBet,proto3" json:"min_bet,omitempty"`
+	MaxBet               uint32   `protobuf:"varint,4,opt,name=max_bet,json=maxBet,proto3" json:"max_bet,omitempty"`
+	FreeSeats            uint32   `protobuf:"varint,5,opt,name=free_seats,json=freeSeats,proto3" json:"free_seats,omitempty"`
+	Offset               uint32   `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                uint32   `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetOpenTablesRequest) Reset()         { *m = GetOpenTablesRequest{} }
+func (m *GetOpenTablesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOpenTablesRequest) ProtoMessage()    {}
+func (*GetOpenTablesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{81}
+}
+
+func (m *GetOpenTablesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOpenTablesRequest.Unmarshal(m, b)
+}
+func (m *GetOpenTablesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOpenTablesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetOpenTablesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOpenTablesRequest.Merge(m, src)
+}
+func (m *GetOpenTablesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOpenTablesRequest.Size(m)
+}
+func (m *GetOpenTablesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOpenTablesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOpenTablesRequest proto.InternalMessageInfo
+
+func (m *GetOpenTablesRequest) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *GetOpenTablesRequest) GetVariant() string {
+	if m != nil {
+		return m.Variant
+	}
+	return ""
+}
+
+func (m *GetOpenTablesRequest) GetMinBet() uint32 {
+	if m != nil {
+		return m.MinBet
+	}
+	return 0
+}
+
+func (m *GetOpenTablesRequest) GetMaxBet() uint32 {
+	if m != nil {
+		return m.MaxBet
+	}
+	return 0
+}
+
+func (m *GetOpenTablesRequest) GetFreeSeats() uint32 {
+	if m != nil {
+		return m.FreeSeats
+	}
+	return 0
+}
+
+func (m *GetOpenTablesRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *GetOpenTablesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetOpenTablesResponse struct {
+	Tables               []*Table `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	Total                uint32   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetOpenTablesResponse) Reset()         { *m = GetOpenTablesResponse{} }
+func (m *GetOpenTablesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOpenTablesResponse) ProtoMessage()    {}
+func (*GetOpenTablesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{82}
+}
+
+func (m *GetOpenTablesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOpenTablesResponse.Unmarshal(m, b)
+}
+func (m *GetOpenTablesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOpenTablesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetOpenTablesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOpenTablesResponse.Merge(m, src)
+}
+func (m *GetOpenTablesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetOpenTablesResponse.Size(m)
+}
+func (m *GetOpenTablesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOpenTablesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOpenTablesResponse proto.InternalMessageInfo
+
+func (m *GetOpenTablesResponse) GetTables() []*Table {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+func (m *GetOpenTablesResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type SubscribeLobbyRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeLobbyRequest) Reset()         { *m = SubscribeLobbyRequest{} }
+func (m *SubscribeLobbyRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeLobbyRequest) ProtoMessage()    {}
+func (*SubscribeLobbyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{83}
+}
+
+func (m *SubscribeLobbyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeLobbyRequest.Unmarshal(m, b)
+}
+func (m *SubscribeLobbyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeLobbyRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribeLobbyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeLobbyRequest.Merge(m, src)
+}
+func (m *SubscribeLobbyRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeLobbyRequest.Size(m)
+}
+func (m *SubscribeLobbyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeLobbyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeLobbyRequest proto.InternalMessageInfo
+
+type SubscribeLobbyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeLobbyResponse) Reset()         { *m = SubscribeLobbyResponse{} }
+func (m *SubscribeLobbyResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeLobbyResponse) ProtoMessage()    {}
+func (*SubscribeLobbyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{84}
+}
+
+func (m *SubscribeLobbyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeLobbyResponse.Unmarshal(m, b)
+}
+func (m *SubscribeLobbyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeLobbyResponse.Marshal(b, m, deterministic)
+}
+func (m *SubscribeLobbyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeLobbyResponse.Merge(m, src)
+}
+func (m *SubscribeLobbyResponse) XXX_Size() int {
+	return xxx_messageInfo_SubscribeLobbyResponse.Size(m)
+}
+func (m *SubscribeLobbyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeLobbyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeLobbyResponse proto.InternalMessageInfo
+
+type UnsubscribeLobbyRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnsubscribeLobbyRequest) Reset()         { *m = UnsubscribeLobbyRequest{} }
+func (m *UnsubscribeLobbyRequest) String() string { return proto.CompactTextString(m) }
+func (*UnsubscribeLobbyRequest) ProtoMessage()    {}
+func (*UnsubscribeLobbyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{85}
+}
+
+func (m *UnsubscribeLobbyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnsubscribeLobbyRequest.Unmarshal(m, b)
+}
+func (m *UnsubscribeLobbyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnsubscribeLobbyRequest.Marshal(b, m, deterministic)
+}
+func (m *UnsubscribeLobbyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsubscribeLobbyRequest.Merge(m, src)
+}
+func (m *UnsubscribeLobbyRequest) XXX_Size() int {
+	return xxx_messageInfo_UnsubscribeLobbyRequest.Size(m)
+}
+func (m *UnsubscribeLobbyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsubscribeLobbyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsubscribeLobbyRequest proto.InternalMessageInfo
+
+type UnsubscribeLobbyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnsubscribeLobbyResponse) Reset()         { *m = UnsubscribeLobbyResponse{} }
+func (m *UnsubscribeLobbyResponse) String() string { return proto.CompactTextString(m) }
+func (*UnsubscribeLobbyResponse) ProtoMessage()    {}
+func (*UnsubscribeLobbyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{86}
+}
+
+func (m *UnsubscribeLobbyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnsubscribeLobbyResponse.Unmarshal(m, b)
+}
+func (m *UnsubscribeLobbyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnsubscribeLobbyResponse.Marshal(b, m, deterministic)
+}
+func (m *UnsubscribeLobbyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsubscribeLobbyResponse.Merge(m, src)
+}
+func (m *UnsubscribeLobbyResponse) XXX_Size() int {
+	return xxx_messageInfo_UnsubscribeLobbyResponse.Size(m)
+}
+func (m *UnsubscribeLobbyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsubscribeLobbyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsubscribeLobbyResponse proto.InternalMessageInfo
+
+type JoinTableRequest struct {
+	TableId              string   `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JoinTableRequest) Reset()         { *m = JoinTableRequest{} }
+func (m *JoinTableRequest) String() string { return proto.CompactTextString(m) }
+func (*JoinTableRequest) ProtoMessage()    {}
+func (*JoinTableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{87}
+}
+
+func (m *JoinTableRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JoinTableRequest.Unmarshal(m, b)
+}
+func (m *JoinTableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JoinTableRequest.Marshal(b, m, deterministic)
+}
+func (m *JoinTableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinTableRequest.Merge(m, src)
+}
+func (m *JoinTableRequest) XXX_Size() int {
+	return xxx_messageInfo_JoinTableRequest.Size(m)
+}
+func (m *JoinTableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinTableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinTableRequest proto.InternalMessageInfo
+
+func (m *JoinTableRequest) GetTableId() string {
+	if m != nil {
+		return m.TableId
+	}
+	return ""
+}
+
+type JoinTableResponse struct {
+	Table                *Table   `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JoinTableResponse) Reset()         { *m = JoinTableResponse{} }
+func (m *JoinTableResponse) String() string { return proto.CompactTextString(m) }
+func (*JoinTableResponse) ProtoMessage()    {}
+func (*JoinTableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{88}
+}
+
+func (m *JoinTableResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JoinTableResponse.Unmarshal(m, b)
+}
+func (m *JoinTableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JoinTableResponse.Marshal(b, m, deterministic)
+}
+func (m *JoinTableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinTableResponse.Merge(m, src)
+}
+func (m *JoinTableResponse) XXX_Size() int {
+	return xxx_messageInfo_JoinTableResponse.Size(m)
+}
+func (m *JoinTableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinTableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinTableResponse proto.InternalMessageInfo
+
+func (m *JoinTableResponse) GetTable() *Table {
+	if m != nil {
+		return m.Table
+	}
+	return nil
+}
+
+type BecomeParticipantRequest struct {
+	TableId              string   `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	ParticipantId        string   `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BecomeParticipantRequest) Reset()         { *m = BecomeParticipantRequest{} }
+func (m *BecomeParticipantRequest) String() string { return proto.CompactTextString(m) }
+func (*BecomeParticipantRequest) ProtoMessage()    {}
+func (*BecomeParticipantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{89}
+}
+
+func (m *BecomeParticipantRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BecomeParticipantRequest.Unmarshal(m, b)
+}
+func (m *BecomeParticipantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BecomeParticipantRequest.Marshal(b, m, deterministic)
+}
+func (m *BecomeParticipantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BecomeParticipantRequest.Merge(m, src)
+}
+func (m *BecomeParticipantRequest) XXX_Size() int {
+	return xxx_messageInfo_BecomeParticipantRequest.Size(m)
+}
+func (m *BecomeParticipantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BecomeParticipantRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BecomeParticipantRequest proto.InternalMessageInfo
+
+func (m *BecomeParticipantRequest) GetTableId() string {
+	if m != nil {
+		return m.TableId
+	}
+	return ""
+}
+
+func (m *BecomeParticipantRequest) GetParticipantId() string {
+	if m != nil {
+		return m.ParticipantId
+	}
+	return ""
+}
+
+type BecomeParticipantResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BecomeParticipantResponse) Reset()         { *m = BecomeParticipantResponse{} }
+func (m *BecomeParticipantResponse) String() string { return proto.CompactTextString(m) }
+func (*BecomeParticipantResponse) ProtoMessage()    {}
+func (*BecomeParticipantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{90}
+}
+
+func (m *BecomeParticipantResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BecomeParticipantResponse.Unmarshal(m, b)
+}
+func (m *BecomeParticipantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BecomeParticipantResponse.Marshal(b, m, deterministic)
+}
+func (m *BecomeParticipantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BecomeParticipantResponse.Merge(m, src)
+}
+func (m *BecomeParticipantResponse) XXX_Size() int {
+	return xxx_messageInfo_BecomeParticipantResponse.Size(m)
+}
+func (m *BecomeParticipantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BecomeParticipantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BecomeParticipantResponse proto.InternalMessageInfo
+
+type ReadyRequest struct {
+	ParticipantId        string   `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadyRequest) Reset()         { *m = ReadyRequest{} }
+func (m *ReadyRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadyRequest) ProtoMessage()    {}
+func (*ReadyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{91}
+}
+
+func (m *ReadyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadyRequest.Unmarshal(m, b)
+}
+func (m *ReadyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadyRequest.Marshal(b, m, deterministic)
+}
+func (m *ReadyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadyRequest.Merge(m, src)
+}
+func (m *ReadyRequest) XXX_Size() int {
+	return xxx_messageInfo_ReadyRequest.Size(m)
+}
+func (m *ReadyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadyRequest proto.InternalMessageInfo
+
+func (m *ReadyRequest) GetParticipantId() string {
+	if m != nil {
+		return m.ParticipantId
+	}
+	return ""
+}
+
+type ReadyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadyResponse) Reset()         { *m = ReadyResponse{} }
+func (m *ReadyResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadyResponse) ProtoMessage()    {}
+func (*ReadyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{92}
+}
+
+func (m *ReadyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadyResponse.Unmarshal(m, b)
+}
+func (m *ReadyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadyResponse.Marshal(b, m, deterministic)
+}
+func (m *ReadyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadyResponse.Merge(m, src)
+}
+func (m *ReadyResponse) XXX_Size() int {
+	return xxx_messageInfo_ReadyResponse.Size(m)
+}
+func (m *ReadyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadyResponse proto.InternalMessageInfo
+
+type MakeMoveRequest struct {
+	TableId              string   `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Card                 string   `protobuf:"bytes,2,opt,name=card,proto3" json:"card,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MakeMoveRequest) Reset()         { *m = MakeMoveRequest{} }
+func (m *MakeMoveRequest) String() string { return proto.CompactTextString(m) }
+func (*MakeMoveRequest) ProtoMessage()    {}
+func (*MakeMoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{93}
+}
+
+func (m *MakeMoveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MakeMoveRequest.Unmarshal(m, b)
+}
+func (m *MakeMoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MakeMoveRequest.Marshal(b, m, deterministic)
+}
+func (m *MakeMoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MakeMoveRequest.Merge(m, src)
+}
+func (m *MakeMoveRequest) XXX_Size() int {
+	return xxx_messageInfo_MakeMoveRequest.Size(m)
+}
+func (m *MakeMoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MakeMoveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MakeMoveRequest proto.InternalMessageInfo
+
+func (m *MakeMoveRequest) GetTableId() string {
+	if m != nil {
+		return m.TableId
+	}
+	return ""
+}
+
+func (m *MakeMoveRequest) GetCard() string {
+	if m != nil {
+		return m.Card
+	}
+	return ""
+}
+
+type MakeMoveResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MakeMoveResponse) Reset()         { *m = MakeMoveResponse{} }
+func (m *MakeMoveResponse) String() string { return proto.CompactTextString(m) }
+func (*MakeMoveResponse) ProtoMessage()    {}
+func (*MakeMoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{94}
+}
+
+func (m *MakeMoveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MakeMoveResponse.Unmarshal(m, b)
+}
+func (m *MakeMoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MakeMoveResponse.Marshal(b, m, deterministic)
+}
+func (m *MakeMoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MakeMoveResponse.Merge(m, src)
+}
+func (m *MakeMoveResponse) XXX_Size() int {
+	return xxx_messageInfo_MakeMoveResponse.Size(m)
+}
+func (m *MakeMoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MakeMoveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MakeMoveResponse proto.InternalMessageInfo
+
+// leaving started game is a forfeit
+type LeaveTableRequest struct {
+	TableId              string   `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaveTableRequest) Reset()         { *m = LeaveTableRequest{} }
+func (m *LeaveTableRequest) String() string { return proto.CompactTextString(m) }
+func (*LeaveTableRequest) ProtoMessage()    {}
+func (*LeaveTableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{95}
+}
+
+func (m *LeaveTableRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaveTableRequest.Unmarshal(m, b)
+}
+func (m *LeaveTableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaveTableRequest.Marshal(b, m, deterministic)
+}
+func (m *LeaveTableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaveTableRequest.Merge(m, src)
+}
+func (m *LeaveTableRequest) XXX_Size() int {
+	return xxx_messageInfo_LeaveTableRequest.Size(m)
+}
+func (m *LeaveTableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaveTableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaveTableRequest proto.InternalMessageInfo
+
+func (m *LeaveTableRequest) GetTableId() string {
+	if m != nil {
+		return m.TableId
+	}
+	return ""
+}
+
+type LeaveTableResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaveTableResponse) Reset()         { *m = LeaveTableResponse{} }
+func (m *LeaveTableResponse) String() string { return proto.CompactTextString(m) }
+func (*LeaveTableResponse) ProtoMessage()    {}
+func (*LeaveTableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{96}
+}
+
+func (m *LeaveTableResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaveTableResponse.Unmarshal(m, b)
+}
+func (m *LeaveTableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaveTableResponse.Marshal(b, m, deterministic)
+}
+func (m *LeaveTableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaveTableResponse.Merge(m, src)
+}
+func (m *LeaveTableResponse) XXX_Size() int {
+	return xxx_messageInfo_LeaveTableResponse.Size(m)
+}
+func (m *LeaveTableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaveTableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaveTableResponse proto.InternalMessageInfo
+
+// frees the seat, player keeps watching the table
+type StandUpRequest struct {
+	TableId              string   `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StandUpRequest) Reset()         { *m = StandUpRequest{} }
+func (m *StandUpRequest) String() string { return proto.CompactTextString(m) }
+func (*StandUpRequest) ProtoMessage()    {}
+func (*StandUpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{97}
+}
+
+func (m *StandUpRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StandUpRequest.Unmarshal(m, b)
+}
+func (m *StandUpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StandUpRequest.Marshal(b, m, deterministic)
+}
+func (m *StandUpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StandUpRequest.Merge(m, src)
+}
+func (m *StandUpRequest) XXX_Size() int {
+	return xxx_messageInfo_StandUpRequest.Size(m)
+}
+func (m *StandUpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StandUpRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StandUpRequest proto.InternalMessageInfo
+
+func (m *StandUpRequest) GetTableId() string {
+	if m != nil {
+		return m.TableId
+	}
+	return ""
+}
+
+type StandUpResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StandUpResponse) Reset()         { *m = StandUpResponse{} }
+func (m *StandUpResponse) String() string { return proto.CompactTextString(m) }
+func (*StandUpResponse) ProtoMessage()    {}
+func (*StandUpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{98}
+}
+
+func (m *StandUpResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StandUpResponse.Unmarshal(m, b)
+}
+func (m *StandUpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StandUpResponse.Marshal(b, m, deterministic)
+}
+func (m *StandUpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StandUpResponse.Merge(m, src)
+}
+func (m *StandUpResponse) XXX_Size() int {
+	return xxx_messageInfo_StandUpResponse.Size(m)
+}
+func (m *StandUpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StandUpResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StandUpResponse proto.InternalMessageInfo
+
+// available to table creator before game start
+type KickParticipantRequest struct {
+	TableId              string   `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	ParticipantId        string   `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KickParticipantRequest) Reset()         { *m = KickParticipantRequest{} }
+func (m *KickParticipantRequest) String() string { return proto.CompactTextString(m) }
+func (*KickParticipantRequest) ProtoMessage()    {}
+func (*KickParticipantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{99}
+}
+
+func (m *KickParticipantRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KickParticipantRequest.Unmarshal(m, b)
+}
+func (m *KickParticipantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KickParticipantRequest.Marshal(b, m, deterministic)
+}
+func (m *KickParticipantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KickParticipantRequest.Merge(m, src)
+}
+func (m *KickParticipantRequest) XXX_Size() int {
+	return xxx_messageInfo_KickParticipantRequest.Size(m)
+}
+func (m *KickParticipantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_KickParticipantRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KickParticipantRequest proto.InternalMessageInfo
+
+func (m *KickParticipantRequest) GetTableId() string {
+	if m != nil {
+		return m.TableId
+	}
+	return ""
+}
+
+func (m *KickParticipantRequest) GetParticipantId() string {
+	if m != nil {
+		return m.ParticipantId
+	}
+	return ""
+}
+
+type KickParticipantResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KickParticipantResponse) Reset()         { *m = KickParticipantResponse{} }
+func (m *KickParticipantResponse) String() string { return proto.CompactTextString(m) }
+func (*KickParticipantResponse) ProtoMessage()    {}
+func (*KickParticipantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{100}
+}
+
+func (m *KickParticipantResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KickParticipantResponse.Unmarshal(m, b)
+}
+func (m *KickParticipantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KickParticipantResponse.Marshal(b, m, deterministic)
+}
+func (m *KickParticipantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KickParticipantResponse.Merge(m, src)
+}
+func (m *KickParticipantResponse) XXX_Size() int {
+	return xxx_messageInfo_KickParticipantResponse.Size(m)
+}
+func (m *KickParticipantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_KickParticipantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KickParticipantResponse proto.InternalMessageInfo
+
+type LockSeatRequest struct {
+	TableId       string `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	ParticipantId string `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	// false unlocks the seat
+	Locked               bool     `protobuf:"varint,3,opt,name=locked,proto3" json:"locked,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LockSeatRequest) Reset()         { *m = LockSeatRequest{} }
+func (m *LockSeatRequest) String() string { return proto.CompactTextString(m) }
+func (*LockSeatRequest) ProtoMessage()    {}
+func (*LockSeatRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{101}
+}
+
+func (m *LockSeatRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockSeatRequest.Unmarshal(m, b)
+}
+func (m *LockSeatRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockSeatRequest.Marshal(b, m, deterministic)
+}
+func (m *LockSeatRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockSeatRequest.Merge(m, src)
+}
+func (m *LockSeatRequest) XXX_Size() int {
+	return xxx_messageInfo_LockSeatRequest.Size(m)
+}
+func (m *LockSeatRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockSeatRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockSeatRequest proto.InternalMessageInfo
+
+func (m *LockSeatRequest) GetTableId() string {
+	if m != nil {
+		return m.TableId
+	}
+	return ""
+}
+
+func (m *LockSeatRequest) GetParticipantId() string {
+	if m != nil {
+		return m.ParticipantId
+	}
+	return ""
+}
+
+func (m *LockSeatRequest) GetLocked() bool {
+	if m != nil {
+		return m.Locked
+	}
+	return false
+}
+
+type LockSeatResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LockSeatResponse) Reset()         { *m = LockSeatResponse{} }
+func (m *LockSeatResponse) String() string { return proto.CompactTextString(m) }
+func (*LockSeatResponse) ProtoMessage()    {}
+func (*LockSeatResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{102}
+}
+
+func (m *LockSeatResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockSeatResponse.Unmarshal(m, b)
+}
+func (m *LockSeatResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockSeatResponse.Marshal(b, m, deterministic)
+}
+func (m *LockSeatResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockSeatResponse.Merge(m, src)
+}
+func (m *LockSeatResponse) XXX_Size() int {
+	return xxx_messageInfo_LockSeatResponse.Size(m)
+}
+func (m *LockSeatResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockSeatResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockSeatResponse proto.InternalMessageInfo
+
+type CloseTableRequest struct {
+	TableId              string   `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CloseTableRequest) Reset()         { *m = CloseTableRequest{} }
+func (m *CloseTableRequest) String() string { return proto.CompactTextString(m) }
+func (*CloseTableRequest) ProtoMessage()    {}
+func (*CloseTableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{103}
+}
+
+func (m *CloseTableRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseTableRequest.Unmarshal(m, b)
+}
+func (m *CloseTableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseTableRequest.Marshal(b, m, deterministic)
+}
+func (m *CloseTableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseTableRequest.Merge(m, src)
+}
+func (m *CloseTableRequest) XXX_Size() int {
+	return xxx_messageInfo_CloseTableRequest.Size(m)
+}
+func (m *CloseTableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseTableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloseTableRequest proto.InternalMessageInfo
+
+func (m *CloseTableRequest) GetTableId() string {
+	if m != nil {
+		return m.TableId
+	}
+	return ""
+}
+
+type CloseTableResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CloseTableResponse) Reset()         { *m = CloseTableResponse{} }
+func (m *CloseTableResponse) String() string { return proto.CompactTextString(m) }
+func (*CloseTableResponse) ProtoMessage()    {}
+func (*CloseTableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{104}
+}
+
+func (m *CloseTableResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseTableResponse.Unmarshal(m, b)
+}
+func (m *CloseTableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseTableResponse.Marshal(b, m, deterministic)
+}
+func (m *CloseTableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseTableResponse.Merge(m, src)
+}
+func (m *CloseTableResponse) XXX_Size() int {
+	return xxx_messageInfo_CloseTableResponse.Size(m)
+}
+func (m *CloseTableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseTableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloseTableResponse proto.InternalMessageInfo
+
+// seating may be changed before game start, ready flags are reset
+type MoveToSeatRequest struct {
+	TableId string `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	// free seat to move to
+	ParticipantId        string   `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoveToSeatRequest) Reset()         { *m = MoveToSeatRequest{} }
+func (m *MoveToSeatRequest) String() string { return proto.CompactTextString(m) }
+func (*MoveToSeatRequest) ProtoMessage()    {}
+func (*MoveToSeatRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{105}
+}
+
+func (m *MoveToSeatRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveToSeatRequest.Unmarshal(m, b)
+}
+func (m *MoveToSeatRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveToSeatRequest.Marshal(b, m, deterministic)
+}
+func (m *MoveToSeatRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveToSeatRequest.Merge(m, src)
+}
+func (m *MoveToSeatRequest) XXX_Size() int {
+	return xxx_messageInfo_MoveToSeatRequest.Size(m)
+}
+func (m *MoveToSeatRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveToSeatRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveToSeatRequest proto.InternalMessageInfo
+
+func (m *MoveToSeatRequest) GetTableId() string {
+	if m != nil {
+		return m.TableId
+	}
+	return ""
+}
+
+func (m *MoveToSeatRequest) GetParticipantId() string {
+	if m != nil {
+		return m.ParticipantId
+	}
+	return ""
+}
+
+type MoveToSeatResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoveToSeatResponse) Reset()         { *m = MoveToSeatResponse{} }
+func (m *MoveToSeatResponse) String() string { return proto.CompactTextString(m) }
+func (*MoveToSeatResponse) ProtoMessage()    {}
+func (*MoveToSeatResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{106}
+}
+
+func (m *MoveToSeatResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveToSeatResponse.Unmarshal(m, b)
+}
+func (m *MoveToSeatResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveToSeatResponse.Marshal(b, m, deterministic)
+}
+func (m *MoveToSeatResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveToSeatResponse.Merge(m, src)
+}
+func (m *MoveToSeatResponse) XXX_Size() int {
+	return xxx_messageInfo_MoveToSeatResponse.Size(m)
+}
+func (m *MoveToSeatResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveToSeatResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveToSeatResponse proto.InternalMessageInfo
+
+type RequestSeatSwapRequest struct {
+	TableId string `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	// occupied seat to swap with
+	ParticipantId        string   `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestSeatSwapRequest) Reset()         { *m = RequestSeatSwapRequest{} }
+func (m *RequestSeatSwapRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestSeatSwapRequest) ProtoMessage()    {}
+func (*RequestSeatSwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{107}
+}
+
+func (m *RequestSeatSwapRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestSeatSwapRequest.Unmarshal(m, b)
+}
+func (m *RequestSeatSwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestSeatSwapRequest.Marshal(b, m, deterministic)
+}
+func (m *RequestSeatSwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestSeatSwapRequest.Merge(m, src)
+}
+func (m *RequestSeatSwapRequest) XXX_Size() int {
+	return xxx_messageInfo_RequestSeatSwapRequest.Size(m)
+}
+func (m *RequestSeatSwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestSeatSwapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestSeatSwapRequest proto.InternalMessageInfo
+
+func (m *RequestSeatSwapRequest) GetTableId() string {
+	if m != nil {
+		return m.TableId
+	}
+	return ""
+}
+
+func (m *RequestSeatSwapRequest) GetParticipantId() string {
+	if m != nil {
+		return m.ParticipantId
+	}
+	return ""
+}
+
+type RequestSeatSwapResponse struct {
+	SwapId               string   `protobuf:"bytes,1,opt,name=swap_id,json=swapId,proto3" json:"swap_id,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestSeatSwapResponse) Reset()         { *m = RequestSeatSwapResponse{} }
+func (m *RequestSeatSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*RequestSeatSwapResponse) ProtoMessage()    {}
+func (*RequestSeatSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{108}
+}
+
+func (m *RequestSeatSwapResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestSeatSwapResponse.Unmarshal(m, b)
+}
+func (m *RequestSeatSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestSeatSwapResponse.Marshal(b, m, deterministic)
+}
+func (m *RequestSeatSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestSeatSwapResponse.Merge(m, src)
+}
+func (m *RequestSeatSwapResponse) XXX_Size() int {
+	return xxx_messageInfo_RequestSeatSwapResponse.Size(m)
+}
+func (m *RequestSeatSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestSeatSwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestSeatSwapResponse proto.InternalMessageInfo
+
+func (m *RequestSeatSwapResponse) GetSwapId() string {
+	if m != nil {
+		return m.SwapId
+	}
+	return ""
+}
+
+func (m *RequestSeatSwapResponse) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+type AcceptSeatSwapRequest struct {
+	SwapId               string   `protobuf:"bytes,1,opt,name=swap_id,json=swapId,proto3" json:"swap_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AcceptSeatSwapRequest) Reset()         { *m = AcceptSeatSwapRequest{} }
+func (m *AcceptSeatSwapRequest) String() string { return proto.CompactTextString(m) }
+func (*AcceptSeatSwapRequest) ProtoMessage()    {}
+func (*AcceptSeatSwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{109}
+}
+
+func (m *AcceptSeatSwapRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcceptSeatSwapRequest.Unmarshal(m, b)
+}
+func (m *AcceptSeatSwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcceptSeatSwapRequest.Marshal(b, m, deterministic)
+}
+func (m *AcceptSeatSwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptSeatSwapRequest.Merge(m, src)
+}
+func (m *AcceptSeatSwapRequest) XXX_Size() int {
+	return xxx_messageInfo_AcceptSeatSwapRequest.Size(m)
+}
+func (m *AcceptSeatSwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptSeatSwapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptSeatSwapRequest proto.InternalMessageInfo
+
+func (m *AcceptSeatSwapRequest) GetSwapId() string {
+	if m != nil {
+		return m.SwapId
+	}
+	return ""
+}
+
+type AcceptSeatSwapResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AcceptSeatSwapResponse) Reset()         { *m = AcceptSeatSwapResponse{} }
+func (m *AcceptSeatSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*AcceptSeatSwapResponse) ProtoMessage()    {}
+func (*AcceptSeatSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{110}
+}
+
+func (m *AcceptSeatSwapResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcceptSeatSwapResponse.Unmarshal(m, b)
+}
+func (m *AcceptSeatSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcceptSeatSwapResponse.Marshal(b, m, deterministic)
+}
+func (m *AcceptSeatSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptSeatSwapResponse.Merge(m, src)
+}
+func (m *AcceptSeatSwapResponse) XXX_Size() int {
+	return xxx_messageInfo_AcceptSeatSwapResponse.Size(m)
+}
+func (m *AcceptSeatSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptSeatSwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptSeatSwapResponse proto.InternalMessageInfo
+
+// vote for rematch of finished table, all four players have to accept
+type RematchRequest struct {
+	TableId              string   `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Accept               bool     `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RematchRequest) Reset()         { *m = RematchRequest{} }
+func (m *RematchRequest) String() string { return proto.CompactTextString(m) }
+func (*RematchRequest) ProtoMessage()    {}
+func (*RematchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{111}
+}
+
+func (m *RematchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RematchRequest.Unmarshal(m, b)
+}
+func (m *RematchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RematchRequest.Marshal(b, m, deterministic)
+}
+func (m *RematchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RematchRequest.Merge(m, src)
+}
+func (m *RematchRequest) XXX_Size() int {
+	return xxx_messageInfo_RematchRequest.Size(m)
+}
+func (m *RematchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RematchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RematchRequest proto.InternalMessageInfo
+
+func (m *RematchRequest) GetTableId() string {
+	if m != nil {
+		return m.TableId
+	}
+	return ""
+}
+
+func (m *RematchRequest) GetAccept() bool {
+	if m != nil {
+		return m.Accept
+	}
+	return false
+}
+
+type RematchResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RematchResponse) Reset()         { *m = RematchResponse{} }
+func (m *RematchResponse) String() string { return proto.CompactTextString(m) }
+func (*RematchResponse) ProtoMessage()    {}
+func (*RematchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{112}
+}
+
+func (m *RematchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RematchResponse.Unmarshal(m, b)
+}
+func (m *RematchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RematchResponse.Marshal(b, m, deterministic)
+}
+func (m *RematchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RematchResponse.Merge(m, src)
+}
+func (m *RematchResponse) XXX_Size() int {
+	return xxx_messageInfo_RematchResponse.Size(m)
+}
+func (m *RematchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RematchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RematchResponse proto.InternalMessageInfo
+
+// log of closed table for replays, events follow after_seq in order
+type GetTableEventsRequest struct {
+	TableId              string   `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	AfterSeq             uint32   `protobuf:"varint,2,opt,name=after_seq,json=afterSeq,proto3" json:"after_seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTableEventsRequest) Reset()         { *m = GetTableEventsRequest{} }
+func (m *GetTableEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTableEventsRequest) ProtoMessage()    {}
+func (*GetTableEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{113}
+}
+
+func (m *GetTableEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTableEventsRequest.Unmarshal(m, b)
+}
+func (m *GetTableEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTableEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTableEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTableEventsRequest.Merge(m, src)
+}
+func (m *GetTableEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTableEventsRequest.Size(m)
+}
+func (m *GetTableEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTableEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTableEventsRequest proto.InternalMessageInfo
+
+func (m *GetTableEventsRequest) GetTableId() string {
+	if m != nil {
+		return m.TableId
+	}
+	return ""
+}
+
+func (m *GetTableEventsRequest) GetAfterSeq() uint32 {
+	if m != nil {
+		return m.AfterSeq
+	}
+	return 0
+}
+
+type GetTableEventsResponse struct {
+	Events               []*TableEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetTableEventsResponse) Reset()         { *m = GetTableEventsResponse{} }
+func (m *GetTableEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTableEventsResponse) ProtoMessage()    {}
+func (*GetTableEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{114}
+}
+
+func (m *GetTableEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTableEventsResponse.Unmarshal(m, b)
+}
+func (m *GetTableEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTableEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTableEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTableEventsResponse.Merge(m, src)
+}
+func (m *GetTableEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTableEventsResponse.Size(m)
+}
+func (m *GetTableEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTableEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTableEventsResponse proto.InternalMessageInfo
+
+func (m *GetTableEventsResponse) GetEvents() []*TableEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type TableEvent struct {
+	Seq                  uint32   `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Order                uint32   `protobuf:"varint,3,opt,name=order,proto3" json:"order,omitempty"`
+	PlayerId             string   `protobuf:"bytes,4,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Card                 string   `protobuf:"bytes,5,opt,name=card,proto3" json:"card,omitempty"`
+	Team                 uint32   `protobuf:"varint,6,opt,name=team,proto3" json:"team,omitempty"`
+	Amount               int64    `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Signature            string   `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TableEvent) Reset()         { *m = TableEvent{} }
+func (m *TableEvent) String() string { return proto.CompactTextString(m) }
+func (*TableEvent) ProtoMessage()    {}
+func (*TableEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{115}
+}
+
+func (m *TableEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TableEvent.Unmarshal(m, b)
+}
+func (m *TableEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TableEvent.Marshal(b, m, deterministic)
+}
+func (m *TableEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TableEvent.Merge(m, src)
+}
+func (m *TableEvent) XXX_Size() int {
+	return xxx_messageInfo_TableEvent.Size(m)
+}
+func (m *TableEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TableEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TableEvent proto.InternalMessageInfo
+
+func (m *TableEvent) GetSeq() uint32 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *TableEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *TableEvent) GetOrder() uint32 {
+	if m != nil {
+		return m.Order
+	}
+	return 0
+}
+
+func (m *TableEvent) GetPlayerId() string {
+	if m != nil {
+		return m.PlayerId
+	}
+	return ""
+}
+
+func (m *TableEvent) GetCard() string {
+	if m != nil {
+		return m.Card
+	}
+	return ""
+}
+
+func (m *TableEvent) GetTeam() uint32 {
+	if m != nil {
+		return m.Team
+	}
+	return 0
+}
+
+func (m *TableEvent) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *TableEvent) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *TableEvent) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type Participant struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Order                uint32   `protobuf:"varint,2,opt,name=order,proto3" json:"order,omitempty"`
+	State                string   `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Cards                string   `protobuf:"bytes,4,opt,name=cards,proto3" json:"cards,omitempty"`
+	CardsCount           uint32   `protobuf:"varint,5,opt,name=cards_count,json=cardsCount,proto3" json:"cards_count,omitempty"`
+	Player               *Player  `protobuf:"bytes,6,opt,name=player,proto3" json:"player,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Participant) Reset()         { *m = Participant{} }
+func (m *Participant) String() string { return proto.CompactTextString(m) }
+func (*Participant) ProtoMessage()    {}
+func (*Participant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{116}
+}
+
+func (m *Participant) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Participant.Unmarshal(m, b)
+}
+func (m *Participant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Participant.Marshal(b, m, deterministic)
+}
+func (m *Participant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Participant.Merge(m, src)
+}
+func (m *Participant) XXX_Size() int {
+	return xxx_messageInfo_Participant.Size(m)
+}
+func (m *Participant) XXX_DiscardUnknown() {
+	xxx_messageInfo_Participant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Participant proto.InternalMessageInfo
+
+func (m *Participant) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Participant) GetOrder() uint32 {
+	if m != nil {
+		return m.Order
+	}
+	return 0
+}
+
+func (m *Participant) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *Participant) GetCards() string {
+	if m != nil {
+		return m.Cards
+	}
+	return ""
+}
+
+func (m *Participant) GetCardsCount() uint32 {
+	if m != nil {
+		return m.CardsCount
+	}
+	return 0
+}
+
+func (m *Participant) GetPlayer() *Player {
+	if m != nil {
+		return m.Player
+	}
+	return nil
+}
+
+type Table struct {
+	Id                   string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Trump                string         `protobuf:"bytes,2,opt,name=trump,proto3" json:"trump,omitempty"`
+	Turn                 uint32         `protobuf:"varint,3,opt,name=turn,proto3" json:"turn,omitempty"`
+	TableCards           string         `protobuf:"bytes,4,opt,name=table_cards,json=tableCards,proto3" json:"table_cards,omitempty"`
+	ClubPlayer           uint32         `protobuf:"varint,5,opt,name=club_player,json=clubPlayer,proto3" json:"club_player,omitempty"`
+	Dealer               uint32         `protobuf:"varint,6,opt,name=dealer,proto3" json:"dealer,omitempty"`
+	Team_1Score          uint32         `protobuf:"varint,7,opt,name=team_1_score,json=team1Score,proto3" json:"team_1_score,omitempty"`
+	Team_2Score          uint32         `protobuf:"varint,8,opt,name=team_2_score,json=team2Score,proto3" json:"team_2_score,omitempty"`
+	Team_1Total          uint32         `protobuf:"varint,9,opt,name=team_1_total,json=team1Total,proto3" json:"team_1_total,omitempty"`
+	Team_2Total          uint32         `protobuf:"varint,10,opt,name=team_2_total,json=team2Total,proto3" json:"team_2_total,omitempty"`
+	Participants         []*Participant `protobuf:"bytes,11,rep,name=participants,proto3" json:"participants,omitempty"`
+	Bet                  uint32         `protobuf:"varint,12,opt,name=bet,proto3" json:"bet,omitempty"`
+	UnitType             string         `protobuf:"bytes,13,opt,name=unit_type,json=unitType,proto3" json:"unit_type,omitempty"`
+	Version              uint64         `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`
+	Variant              string         `protobuf:"bytes,15,opt,name=variant,proto3" json:"variant,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Table) Reset()         { *m = Table{} }
+func (m *Table) String() string { return proto.CompactTextString(m) }
+func (*Table) ProtoMessage()    {}
+func (*Table) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{117}
+}
+
+func (m *Table) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Table.Unmarshal(m, b)
+}
+func (m *Table) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Table.Marshal(b, m, deterministic)
+}
+func (m *Table) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Table.Merge(m, src)
+}
+func (m *Table) XXX_Size() int {
+	return xxx_messageInfo_Table.Size(m)
+}
+func (m *Table) XXX_DiscardUnknown() {
+	xxx_messageInfo_Table.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Table proto.InternalMessageInfo
+
+func (m *Table) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Table) GetTrump() string {
+	if m != nil {
+		return m.Trump
+	}
+	return ""
+}
+
+func (m *Table) GetTurn() uint32 {
+	if m != nil {
+		return m.Turn
+	}
+	return 0
+}
+
+func (m *Table) GetTableCards() string {
+	if m != nil {
+		return m.TableCards
+	}
+	return ""
+}
+
+func (m *Table) GetClubPlayer() uint32 {
+	if m != nil {
+		return m.ClubPlayer
+	}
+	return 0
+}
+
+func (m *Table) GetDealer() uint32 {
+	if m != nil {
+		return m.Dealer
+	}
+	return 0
+}
+
+func (m *Table) GetTeam_1Score() uint32 {
+	if m != nil {
+		return m.Team_1Score
+	}
+	return 0
+}
+
+func (m *Table) GetTeam_2Score() uint32 {
+	if m != nil {
+		return m.Team_2Score
+	}
+	return 0
+}
+
+func (m *Table) GetTeam_1Total() uint32 {
+	if m != nil {
+		return m.Team_1Total
+	}
+	return 0
+}
+
+func (m *Table) GetTeam_2Total() uint32 {
+	if m != nil {
+		return m.Team_2Total
+	}
+	return 0
+}
+
+func (m *Table) GetParticipants() []*Participant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+func (m *Table) GetBet() uint32 {
+	if m != nil {
+		return m.Bet
+	}
+	return 0
+}
+
+func (m *Table) GetUnitType() string {
+	if m != nil {
+		return m.UnitType
+	}
+	return ""
+}
+
+func (m *Table) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Table) GetVariant() string {
+	if m != nil {
+		return m.Variant
+	}
+	return ""
+}
+
+type Player struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nickname             string   `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Level                uint32   `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	Exp                  uint64   `protobuf:"varint,4,opt,name=exp,proto3" json:"exp,omitempty"`
+	Nuts                 uint64   `protobuf:"varint,5,opt,name=nuts,proto3" json:"nuts,omitempty"`
+	Gold                 uint64   `protobuf:"varint,6,opt,name=gold,proto3" json:"gold,omitempty"`
+	Avatar               string   `protobuf:"bytes,7,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Profile              *Profile `protobuf:"bytes,8,opt,name=profile,proto3" json:"profile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Player) Reset()         { *m = Player{} }
+func (m *Player) String() string { return proto.CompactTextString(m) }
+func (*Player) ProtoMessage()    {}
+func (*Player) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{118}
+}
+
+func (m *Player) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Player.Unmarshal(m, b)
+}
+func (m *Player) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Player.Marshal(b, m, deterministic)
+}
+func (m *Player) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Player.Merge(m, src)
+}
+func (m *Player) XXX_Size() int {
+	return xxx_messageInfo_Player.Size(m)
+}
+func (m *Player) XXX_DiscardUnknown() {
+	xxx_messageInfo_Player.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Player proto.InternalMessageInfo
+
+func (m *Player) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Player) GetNickname() string {
+	if m != nil {
+		return m.Nickname
+	}
+	return ""
+}
+
+func (m *Player) GetLevel() uint32 {
+	if m != nil {
+		return m.Level
+	}
+	return 0
+}
+
+func (m *Player) GetExp() uint64 {
+	if m != nil {
+		return m.Exp
+	}
+	return 0
+}
+
+func (m *Player) GetNuts() uint64 {
+	if m != nil {
+		return m.Nuts
+	}
+	return 0
+}
+
+func (m *Player) GetGold() uint64 {
+	if m != nil {
+		return m.Gold
+	}
+	return 0
+}
+
+func (m *Player) GetAvatar() string {
+	if m != nil {
+		return m.Avatar
+	}
+	return ""
+}
+
+func (m *Player) GetProfile() *Profile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+type Profile struct {
+	FirstName            string   `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName             string   `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Age                  uint32   `protobuf:"varint,3,opt,name=age,proto3" json:"age,omitempty"`
+	Gender               string   `protobuf:"bytes,4,opt,name=gender,proto3" json:"gender,omitempty"`
+	Country              string   `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"`
+	Language             string   `protobuf:"bytes,6,opt,name=language,proto3" json:"language,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Profile) Reset()         { *m = Profile{} }
+func (m *Profile) String() string { return proto.CompactTextString(m) }
+func (*Profile) ProtoMessage()    {}
+func (*Profile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5309ac3f9cbe5f84, []int{119}
+}
+
+func (m *Profile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Profile.Unmarshal(m, b)
+}
+func (m *Profile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Profile.Marshal(b, m, deterministic)
+}
+func (m *Profile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Profile.Merge(m, src)
+}
+func (m *Profile) XXX_Size() int {
+	return xxx_messageInfo_Profile.Size(m)
+}
+func (m *Profile) XXX_DiscardUnknown() {
+	xxx_messageInfo_Profile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Profile proto.InternalMessageInfo
+
+func (m *Profile) GetFirstName() string {
+	if m != nil {
+		return m.FirstName
+	}
+	return ""
+}
+
+func (m *Profile) GetLastName() string {
+	if m != nil {
+		return m.LastName
+	}
+	return ""
+}
+
+func (m *Profile) GetAge() uint32 {
+	if m != nil {
+		return m.Age
+	}
+	return 0
+}
+
+func (m *Profile) GetGender() string {
+	if m != nil {
+		return m.Gender
+	}
+	return ""
+}
+
+func (m *Profile) GetCountry() string {
+	if m != nil {
+		return m.Country
+	}
+	return ""
+}
+
+func (m *Profile) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*OpenSessionRequest)(nil), "OpenSessionRequest")
+	proto.RegisterType((*OpenSessionResponse)(nil), "OpenSessionResponse")
+	proto.RegisterType((*CloseSessionRequest)(nil), "CloseSessionRequest")
+	proto.RegisterType((*CloseSessionResponse)(nil), "CloseSessionResponse")
+	proto.RegisterType((*Session)(nil), "Session")
+	proto.RegisterType((*ListSessionsRequest)(nil), "ListSessionsRequest")
+	proto.RegisterType((*ListSessionsResponse)(nil), "ListSessionsResponse")
+	proto.RegisterType((*TerminateSessionRequest)(nil), "TerminateSessionRequest")
+	proto.RegisterType((*TerminateSessionResponse)(nil), "TerminateSessionResponse")
+	proto.RegisterType((*TakeSeatControlRequest)(nil), "TakeSeatControlRequest")
+	proto.RegisterType((*TakeSeatControlResponse)(nil), "TakeSeatControlResponse")
+	proto.RegisterType((*ChangePasswordRequest)(nil), "ChangePasswordRequest")
+	proto.RegisterType((*ChangePasswordResponse)(nil), "ChangePasswordResponse")
+	proto.RegisterType((*UpdateProfileRequest)(nil), "UpdateProfileRequest")
+	proto.RegisterType((*UpdateProfileResponse)(nil), "UpdateProfileResponse")
+	proto.RegisterType((*SetAvatarRequest)(nil), "SetAvatarRequest")
+	proto.RegisterType((*SetAvatarResponse)(nil), "SetAvatarResponse")
+	proto.RegisterType((*GetPlayerProfileRequest)(nil), "GetPlayerProfileRequest")
+	proto.RegisterType((*GetPlayerProfileResponse)(nil), "GetPlayerProfileResponse")
+	proto.RegisterType((*GetFriendsRequest)(nil), "GetFriendsRequest")
+	proto.RegisterType((*GetFriendsResponse)(nil), "GetFriendsResponse")
+	proto.RegisterType((*AddFriendRequest)(nil), "AddFriendRequest")
+	proto.RegisterType((*AddFriendResponse)(nil), "AddFriendResponse")
+	proto.RegisterType((*AcceptFriendRequest)(nil), "AcceptFriendRequest")
+	proto.RegisterType((*AcceptFriendResponse)(nil), "AcceptFriendResponse")
+	proto.RegisterType((*RemoveFriendRequest)(nil), "RemoveFriendRequest")
+	proto.RegisterType((*RemoveFriendResponse)(nil), "RemoveFriendResponse")
+	proto.RegisterType((*BlockPlayerRequest)(nil), "BlockPlayerRequest")
+	proto.RegisterType((*BlockPlayerResponse)(nil), "BlockPlayerResponse")
+	proto.RegisterType((*InviteToTableRequest)(nil), "InviteToTableRequest")
+	proto.RegisterType((*InviteToTableResponse)(nil), "InviteToTableResponse")
+	proto.RegisterType((*AcceptInvitationRequest)(nil), "AcceptInvitationRequest")
+	proto.RegisterType((*AcceptInvitationResponse)(nil), "AcceptInvitationResponse")
+	proto.RegisterType((*Friend)(nil), "Friend")
+	proto.RegisterType((*GetLeaderboardRequest)(nil), "GetLeaderboardRequest")
+	proto.RegisterType((*GetLeaderboardResponse)(nil), "GetLeaderboardResponse")
+	proto.RegisterType((*LeaderboardEntry)(nil), "LeaderboardEntry")
+	proto.RegisterType((*GetMatchHistoryRequest)(nil), "GetMatchHistoryRequest")
+	proto.RegisterType((*GetMatchHistoryResponse)(nil), "GetMatchHistoryResponse")
+	proto.RegisterType((*Match)(nil), "Match")
+	proto.RegisterType((*GetPlayerStatsRequest)(nil), "GetPlayerStatsRequest")
+	proto.RegisterType((*GetPlayerStatsResponse)(nil), "GetPlayerStatsResponse")
+	proto.RegisterType((*PlayerStats)(nil), "PlayerStats")
+	proto.RegisterMapType((map[string]uint32)(nil), "PlayerStats.TrumpsEntry")
+	proto.RegisterType((*GetAchievementsRequest)(nil), "GetAchievementsRequest")
+	proto.RegisterType((*GetAchievementsResponse)(nil), "GetAchievementsResponse")
+	proto.RegisterType((*Achievement)(nil), "Achievement")
+	proto.RegisterType((*ClaimDailyRewardRequest)(nil), "ClaimDailyRewardRequest")
+	proto.RegisterType((*ClaimDailyRewardResponse)(nil), "ClaimDailyRewardResponse")
+	proto.RegisterType((*GetQuestsRequest)(nil), "GetQuestsRequest")
+	proto.RegisterType((*GetQuestsResponse)(nil), "GetQuestsResponse")
+	proto.RegisterType((*Quest)(nil), "Quest")
+	proto.RegisterType((*PendingRewards)(nil), "PendingRewards")
+	proto.RegisterType((*GetTournamentsRequest)(nil), "GetTournamentsRequest")
+	proto.RegisterType((*GetTournamentsResponse)(nil), "GetTournamentsResponse")
+	proto.RegisterType((*GetTournamentRequest)(nil), "GetTournamentRequest")
+	proto.RegisterType((*GetTournamentResponse)(nil), "GetTournamentResponse")
+	proto.RegisterType((*RegisterTournamentRequest)(nil), "RegisterTournamentRequest")
+	proto.RegisterType((*RegisterTournamentResponse)(nil), "RegisterTournamentResponse")
+	proto.RegisterType((*UnregisterTournamentRequest)(nil), "UnregisterTournamentRequest")
+	proto.RegisterType((*UnregisterTournamentResponse)(nil), "UnregisterTournamentResponse")
+	proto.RegisterType((*Tournament)(nil), "Tournament")
+	proto.RegisterType((*TournamentEntry)(nil), "TournamentEntry")
+	proto.RegisterType((*Notification)(nil), "Notification")
+	proto.RegisterType((*GetNotificationsRequest)(nil), "GetNotificationsRequest")
+	proto.RegisterType((*GetNotificationsResponse)(nil), "GetNotificationsResponse")
+	proto.RegisterType((*MarkReadRequest)(nil), "MarkReadRequest")
+	proto.RegisterType((*MarkReadResponse)(nil), "MarkReadResponse")
+	proto.RegisterType((*GetProductsRequest)(nil), "GetProductsRequest")
+	proto.RegisterType((*GetProductsResponse)(nil), "GetProductsResponse")
+	proto.RegisterType((*PurchaseProductRequest)(nil), "PurchaseProductRequest")
+	proto.RegisterType((*PurchaseProductResponse)(nil), "PurchaseProductResponse")
+	proto.RegisterType((*CreateCheckoutRequest)(nil), "CreateCheckoutRequest")
+	proto.RegisterType((*CreateCheckoutResponse)(nil), "CreateCheckoutResponse")
+	proto.RegisterType((*VerifyReceiptRequest)(nil), "VerifyReceiptRequest")
+	proto.RegisterType((*VerifyReceiptResponse)(nil), "VerifyReceiptResponse")
+	proto.RegisterType((*GetInventoryRequest)(nil), "GetInventoryRequest")
+	proto.RegisterType((*GetInventoryResponse)(nil), "GetInventoryResponse")
+	proto.RegisterType((*InventoryItem)(nil), "InventoryItem")
+	proto.RegisterType((*Product)(nil), "Product")
+	proto.RegisterType((*CreateTableRequest)(nil), "CreateTableRequest")
+	proto.RegisterType((*CreateTableResponse)(nil), "CreateTableResponse")
+	proto.RegisterType((*GetOpenTablesRequest)(nil), "GetOpenTablesRequest")
+	proto.RegisterType((*GetOpenTablesResponse)(nil), "GetOpenTablesResponse")
+	proto.RegisterType((*SubscribeLobbyRequest)(nil), "SubscribeLobbyRequest")
+	proto.RegisterType((*SubscribeLobbyResponse)(nil), "SubscribeLobbyResponse")
+	proto.RegisterType((*UnsubscribeLobbyRequest)(nil), "UnsubscribeLobbyRequest")
+	proto.RegisterType((*UnsubscribeLobbyResponse)(nil), "UnsubscribeLobbyResponse")
+	proto.RegisterType((*JoinTableRequest)(nil), "JoinTableRequest")
+	proto.RegisterType((*JoinTableResponse)(nil), "JoinTableResponse")
+	proto.RegisterType((*BecomeParticipantRequest)(nil), "BecomeParticipantRequest")
+	proto.RegisterType((*BecomeParticipantResponse)(nil), "BecomeParticipantResponse")
+	proto.RegisterType((*ReadyRequest)(nil), "ReadyRequest")
+	proto.RegisterType((*ReadyResponse)(nil), "ReadyResponse")
+	proto.RegisterType((*MakeMoveRequest)(nil), "MakeMoveRequest")
+	proto.RegisterType((*MakeMoveResponse)(nil), "MakeMoveResponse")
+	proto.RegisterType((*LeaveTableRequest)(nil), "LeaveTableRequest")
+	proto.RegisterType((*LeaveTableResponse)(nil), "LeaveTableResponse")
+	proto.RegisterType((*StandUpRequest)(nil), "StandUpRequest")
+	proto.RegisterType((*StandUpResponse)(nil), "StandUpResponse")
+	proto.RegisterType((*KickParticipantRequest)(nil), "KickParticipantRequest")
+	proto.RegisterType((*KickParticipantResponse)(nil), "KickParticipantResponse")
+	proto.RegisterType((*LockSeatRequest)(nil), "LockSeatRequest")
+	proto.RegisterType((*LockSeatResponse)(nil), "LockSeatResponse")
+	proto.RegisterType((*CloseTableRequest)(nil), "CloseTableRequest")
+	proto.RegisterType((*CloseTableResponse)(nil), "CloseTableResponse")
+	proto.RegisterType((*MoveToSeatRequest)(nil), "MoveToSeatRequest")
+	proto.RegisterType((*MoveToSeatResponse)(nil), "MoveToSeatResponse")
+	proto.RegisterType((*RequestSeatSwapRequest)(nil), "RequestSeatSwapRequest")
+	proto.RegisterType((*RequestSeatSwapResponse)(nil), "RequestSeatSwapResponse")
+	proto.RegisterType((*AcceptSeatSwapRequest)(nil), "AcceptSeatSwapRequest")
+	proto.RegisterType((*AcceptSeatSwapResponse)(nil), "AcceptSeatSwapResponse")
+	proto.RegisterType((*RematchRequest)(nil), "RematchRequest")
+	proto.RegisterType((*RematchResponse)(nil), "RematchResponse")
+	proto.RegisterType((*GetTableEventsRequest)(nil), "GetTableEventsRequest")
+	proto.RegisterType((*GetTableEventsResponse)(nil), "GetTableEventsResponse")
+	proto.RegisterType((*TableEvent)(nil), "TableEvent")
+	proto.RegisterType((*Participant)(nil), "Participant")
+	proto.RegisterType((*Table)(nil), "Table")
+	proto.RegisterType((*Player)(nil), "Player")
+	proto.RegisterType((*Profile)(nil), "Profile")
+}
+
+func init() { proto.RegisterFile("proto/game.proto", fileDescriptor_5309ac3f9cbe5f84) }
+
+var fileDescriptor_5309ac3f9cbe5f84 = []byte{
+	// 4157 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5b, 0xcd, 0x73, 0x24, 0x47,
+	0x56, 0x8f, 0xee, 0x96, 0xfa, 0xe3, 0x75, 0x4b, 0xdd, 0x9d, 0xea, 0x8f, 0x52, 0xc9, 0xde, 0x1d,
+	0x97, 0x77, 0x17, 0x63, 0xb3, 0x69, 0x8f, 0xbc, 0x66, 0x87, 0x95, 0xd9, 0x40, 0xd6, 0xd8, 0x42,
+	0xde, 0x19, 0x5b, 0x2e, 0x69, 0x30, 0x01, 0x4b, 0x74, 0xa4, 0xba, 0x52, 0x9a, 0x5a, 0x55, 0x57,
+	0x95, 0xab, 0xb2, 0x5b, 0x23, 0x13, 0x1b, 0x04, 0xe1, 0x03, 0x04, 0xdc, 0xb8, 0x70, 0xe6, 0x40,
+	0xf0, 0x2f, 0x70, 0xe4, 0xce, 0x5f, 0x40, 0x70, 0x23, 0x82, 0x08, 0xce, 0xfc, 0x05, 0x44, 0x7e,
+	0x54, 0x55, 0xd6, 0x47, 0x6b, 0x34, 0x30, 0xc0, 0xad, 0xdf, 0xcb, 0x97, 0x2f, 0x5f, 0xe5, 0xcb,
+	0x8f, 0x97, 0xbf, 0xf7, 0x1a, 0x06, 0x61, 0x14, 0xb0, 0xe0, 0xfd, 0x2b, 0xb2, 0xa0, 0x58, 0xfc,
+	0xb4, 0x28, 0xa0, 0x2f, 0x43, 0xea, 0x9f, 0xd1, 0x38, 0x76, 0x03, 0xdf, 0xa6, 0xdf, 0x2c, 0x69,
+	0xcc, 0xd0, 0x08, 0x36, 0x59, 0x70, 0x4d, 0x7d, 0xa3, 0xf6, 0xa0, 0xf6, 0x4e, 0xc7, 0x96, 0x04,
+	0x7a, 0x0b, 0x7a, 0x8b, 0xa5, 0xc7, 0xdc, 0x99, 0x43, 0x57, 0xee, 0x9c, 0x1a, 0xf5, 0x07, 0xb5,
+	0x77, 0xda, 0x76, 0x57, 0xf0, 0x1e, 0x0b, 0x16, 0x9a, 0x40, 0x53, 0x35, 0x36, 0x44, 0x4f, 0x45,
+	0x59, 0x7f, 0x59, 0x87, 0x9d, 0xdc, 0x38, 0x71, 0x18, 0xf8, 0x31, 0x45, 0x6f, 0x02, 0xc4, 0x92,
+	0x35, 0x73, 0x1d, 0x35, 0x5a, 0x47, 0x71, 0x4e, 0x1c, 0xf4, 0x7d, 0x68, 0x86, 0x1e, 0xb9, 0xa5,
+	0x91, 0x18, 0xab, 0xbb, 0xdf, 0xc2, 0xa7, 0x82, 0xb4, 0x15, 0x1b, 0xed, 0x42, 0x9b, 0x91, 0x0b,
+	0x8f, 0xf2, 0xde, 0x72, 0xc4, 0x96, 0xa0, 0x4f, 0x1c, 0xf4, 0x08, 0xfa, 0x21, 0xf5, 0x1d, 0xd7,
+	0xbf, 0x9a, 0x45, 0xf4, 0x86, 0x44, 0x4e, 0x6c, 0x6c, 0x08, 0x25, 0x7d, 0x7c, 0x2a, 0xf9, 0xb6,
+	0x64, 0xdb, 0xdb, 0x61, 0x8e, 0x46, 0x1f, 0xc2, 0x96, 0x1f, 0x30, 0xf7, 0xd2, 0x9d, 0x13, 0xe6,
+	0x06, 0x7e, 0x6c, 0x6c, 0x3e, 0x68, 0xbc, 0xd3, 0xdd, 0xdf, 0xc2, 0x5f, 0x68, 0x5c, 0x3b, 0x2f,
+	0x83, 0x7e, 0x03, 0xfa, 0x31, 0x25, 0x6c, 0x36, 0x0f, 0x7c, 0x16, 0x05, 0x9e, 0x47, 0x23, 0xa3,
+	0x29, 0xe6, 0x67, 0x9b, 0xb3, 0x8f, 0x52, 0xae, 0x35, 0x86, 0x9d, 0x23, 0x2f, 0x88, 0x69, 0x7e,
+	0xca, 0xad, 0x8f, 0x60, 0x94, 0x67, 0xdf, 0x6b, 0x86, 0xac, 0xbf, 0xaa, 0x41, 0x4b, 0x75, 0x41,
+	0xdb, 0x50, 0x4f, 0x45, 0xea, 0xae, 0xa3, 0x39, 0xa3, 0xae, 0x3b, 0x83, 0xab, 0x9c, 0x47, 0x94,
+	0x30, 0xea, 0xcc, 0x08, 0x13, 0xd3, 0xd6, 0xb0, 0x3b, 0x8a, 0x73, 0xc8, 0xd0, 0xf7, 0x00, 0xb4,
+	0x8f, 0xd8, 0x10, 0x1f, 0xa1, 0x71, 0x90, 0x01, 0xad, 0xf9, 0x32, 0x8a, 0xa8, 0xcf, 0x8c, 0x4d,
+	0xd1, 0x98, 0x90, 0xfc, 0xd3, 0x9e, 0xb8, 0x31, 0x53, 0xf6, 0xc4, 0xc9, 0xa7, 0x7d, 0x0c, 0xa3,
+	0x3c, 0x5b, 0x7d, 0xda, 0x0f, 0xa0, 0xad, 0x3e, 0x24, 0x36, 0x6a, 0x62, 0x8a, 0xdb, 0x38, 0xf9,
+	0xfc, 0xb4, 0xc5, 0x7a, 0x04, 0xd3, 0x73, 0x1a, 0x2d, 0x5c, 0x9f, 0xb0, 0xc2, 0x9c, 0xbd, 0x6c,
+	0x6e, 0x4c, 0x30, 0xca, 0x3d, 0xe5, 0xd8, 0x96, 0x01, 0x93, 0x73, 0x72, 0x4d, 0xcf, 0x32, 0xdf,
+	0x24, 0xd6, 0xee, 0xc2, 0xb4, 0xd4, 0xa2, 0x3a, 0xfd, 0x09, 0x8c, 0x8f, 0x9e, 0x13, 0xff, 0x8a,
+	0x9e, 0x92, 0x38, 0xbe, 0x09, 0x22, 0x27, 0x31, 0xe4, 0x2d, 0xe8, 0x05, 0x9e, 0x33, 0x0b, 0x15,
+	0x5b, 0x99, 0xd2, 0x0d, 0x3c, 0x27, 0x91, 0xe4, 0x22, 0x3e, 0xbd, 0xc9, 0x44, 0xa4, 0x4b, 0xba,
+	0x3e, 0xbd, 0x49, 0x44, 0xb8, 0x4d, 0x45, 0xf5, 0x6a, 0xe0, 0x9f, 0xc1, 0xe8, 0x59, 0xe8, 0x10,
+	0x46, 0x4f, 0xa3, 0xe0, 0xd2, 0xf5, 0x68, 0x32, 0xae, 0x05, 0xad, 0x50, 0x72, 0xc4, 0x90, 0x7c,
+	0x02, 0x13, 0x89, 0xa4, 0xc1, 0x3a, 0x80, 0x71, 0xa1, 0xaf, 0x9a, 0xfe, 0xfb, 0x74, 0xfe, 0x14,
+	0x06, 0x67, 0x94, 0x1d, 0xae, 0x08, 0x23, 0x51, 0x32, 0xe8, 0x1e, 0x74, 0x88, 0x60, 0x64, 0x93,
+	0xde, 0x96, 0x8c, 0x13, 0x87, 0x9f, 0x1c, 0xee, 0x82, 0x5c, 0xc9, 0x25, 0xd7, 0xb3, 0x25, 0x61,
+	0xbd, 0x07, 0x43, 0x4d, 0x8d, 0x1a, 0x7f, 0x02, 0x4d, 0xd9, 0x4d, 0x29, 0x51, 0x94, 0xf5, 0xdb,
+	0x30, 0x3d, 0xa6, 0x4c, 0x6e, 0xf4, 0xc2, 0xf7, 0xee, 0x41, 0x47, 0x6e, 0x7c, 0x6d, 0x68, 0xc9,
+	0x38, 0x71, 0xac, 0x03, 0x30, 0xca, 0xfd, 0xd4, 0x58, 0xd9, 0x41, 0x52, 0xab, 0x3c, 0x48, 0xac,
+	0x1d, 0x18, 0x1e, 0x53, 0xf6, 0x59, 0xe4, 0x52, 0xdf, 0x49, 0x17, 0xee, 0x4f, 0x01, 0xe9, 0x4c,
+	0xa5, 0xeb, 0x2d, 0x68, 0x5d, 0x4a, 0x96, 0x5a, 0xb5, 0x2d, 0x2c, 0x45, 0xec, 0x84, 0x6f, 0xbd,
+	0x0f, 0x83, 0x43, 0xc7, 0x51, 0xdc, 0xfb, 0xd8, 0xfe, 0x13, 0x18, 0x6a, 0x1d, 0x32, 0xa3, 0xa5,
+	0xc2, 0xd4, 0x68, 0x25, 0xa0, 0xd8, 0xd6, 0x3e, 0xec, 0x1c, 0xce, 0xe7, 0x34, 0x64, 0xaf, 0x30,
+	0xd2, 0x4f, 0x61, 0x94, 0xef, 0xf3, 0x0a, 0x83, 0xd9, 0x74, 0x11, 0xac, 0xe8, 0x2b, 0x0c, 0x36,
+	0x81, 0x51, 0xbe, 0x8f, 0x5a, 0xcf, 0x0f, 0x01, 0x7d, 0xe2, 0x05, 0xf3, 0x6b, 0xe5, 0x84, 0xfb,
+	0xa8, 0x1a, 0xc3, 0x4e, 0xae, 0x8b, 0xd2, 0xf4, 0x05, 0x8c, 0x4e, 0xfc, 0x95, 0xcb, 0xe8, 0x79,
+	0x70, 0xce, 0x0f, 0xfe, 0x44, 0x97, 0x7e, 0x31, 0xd4, 0xf2, 0x17, 0x43, 0x6e, 0x98, 0x7a, 0x61,
+	0x98, 0x3f, 0x86, 0x71, 0x41, 0x9f, 0x9a, 0x9f, 0xb7, 0x61, 0xcb, 0xe5, 0x0d, 0xe2, 0xb8, 0xcf,
+	0xb4, 0xf6, 0x32, 0xe6, 0x89, 0xc3, 0x0f, 0x24, 0xfa, 0x22, 0x74, 0x23, 0x1a, 0xf3, 0x93, 0xb5,
+	0x2e, 0x4f, 0x56, 0xc5, 0x39, 0x64, 0xd6, 0xcf, 0x61, 0x2a, 0xe7, 0xfe, 0x24, 0xed, 0x94, 0xd8,
+	0x7b, 0x1f, 0xf5, 0xd6, 0x23, 0x30, 0xca, 0xfd, 0x95, 0x7d, 0x6f, 0xc0, 0xa6, 0xf8, 0x40, 0xe5,
+	0xbe, 0x26, 0x96, 0xe6, 0x4b, 0xa6, 0xf5, 0x17, 0x35, 0x68, 0x4a, 0x1f, 0xdc, 0x39, 0xcb, 0xc8,
+	0x84, 0xb6, 0xef, 0xce, 0xaf, 0x7d, 0xb2, 0x48, 0x2e, 0x8d, 0x94, 0xd6, 0xf6, 0x6b, 0x43, 0xdf,
+	0xaf, 0x7c, 0xcb, 0xc7, 0x8c, 0x30, 0x2a, 0xae, 0x8a, 0x8e, 0x2d, 0x09, 0x2e, 0x1d, 0xf8, 0x9e,
+	0xeb, 0x53, 0x75, 0x49, 0x28, 0xca, 0xfa, 0x87, 0x1a, 0x8c, 0x8f, 0x29, 0x7b, 0x42, 0x89, 0x43,
+	0xa3, 0x8b, 0x80, 0x64, 0x87, 0x28, 0xd7, 0x33, 0x0f, 0x42, 0x9a, 0x04, 0x1d, 0x82, 0xe0, 0x7a,
+	0x6e, 0x5c, 0xdf, 0x09, 0x6e, 0x92, 0x4b, 0x4c, 0x52, 0x9c, 0xbf, 0xa0, 0x2c, 0x72, 0xe7, 0x89,
+	0x35, 0x92, 0xe2, 0x5f, 0x20, 0xaf, 0xa3, 0xf9, 0xad, 0x32, 0x28, 0xa5, 0x85, 0x4d, 0x97, 0x97,
+	0x31, 0x95, 0x17, 0xd7, 0x96, 0xad, 0x28, 0x3e, 0xb2, 0xe7, 0x2e, 0x5c, 0x26, 0x6e, 0xec, 0x2d,
+	0x5b, 0x12, 0xd6, 0xaf, 0x60, 0x52, 0x34, 0x54, 0xcd, 0xf5, 0x7b, 0xd0, 0xa2, 0x3e, 0x8b, 0x5c,
+	0x9a, 0x9c, 0x00, 0x43, 0xac, 0x89, 0x7d, 0xea, 0xb3, 0xe8, 0xd6, 0x4e, 0x24, 0xd0, 0xdb, 0xd0,
+	0x08, 0x6e, 0x7c, 0x15, 0xc0, 0x54, 0x08, 0xf2, 0x56, 0x3e, 0x2b, 0x83, 0x62, 0x0b, 0x42, 0xb0,
+	0x11, 0x11, 0xff, 0x5a, 0xcc, 0xc7, 0x86, 0x2d, 0x7e, 0xdf, 0xb9, 0x78, 0x73, 0xde, 0x6b, 0xac,
+	0xf5, 0xde, 0x46, 0xce, 0x7b, 0xfc, 0x36, 0x0f, 0x96, 0x7c, 0x3c, 0x31, 0x29, 0x1d, 0x3b, 0x21,
+	0x95, 0x3f, 0x22, 0x2a, 0x66, 0xa5, 0x61, 0x4b, 0xc2, 0xfa, 0x4c, 0xcc, 0xca, 0x53, 0xc2, 0xe6,
+	0xcf, 0x7f, 0xdf, 0x8d, 0x59, 0x10, 0xdd, 0x26, 0xfe, 0xcb, 0x66, 0xb7, 0x56, 0x3d, 0xbb, 0x75,
+	0x7d, 0x76, 0xbf, 0x82, 0x69, 0x49, 0x8f, 0x9a, 0xde, 0x07, 0xd0, 0x5a, 0x70, 0x7e, 0x3a, 0xbd,
+	0x4d, 0x2c, 0xe4, 0xec, 0x84, 0x2d, 0xe3, 0x53, 0x46, 0xbc, 0x44, 0xa5, 0x20, 0xac, 0x7f, 0xa9,
+	0xc3, 0xa6, 0x10, 0xbc, 0x6b, 0xf7, 0xf3, 0x98, 0x81, 0x91, 0x88, 0xcd, 0x98, 0xab, 0xd6, 0x78,
+	0xc3, 0xee, 0x08, 0xce, 0xb9, 0xbb, 0xa0, 0xbc, 0x27, 0xf5, 0x1d, 0xd9, 0x28, 0x23, 0xa3, 0x16,
+	0xf5, 0x1d, 0xd1, 0x74, 0xd7, 0xca, 0x1a, 0x40, 0xe3, 0x22, 0x5d, 0x56, 0xfc, 0x27, 0x9f, 0x8d,
+	0x88, 0xc6, 0x4b, 0x4f, 0x2e, 0xaa, 0x8e, 0xad, 0x28, 0x3e, 0x3e, 0xa3, 0x64, 0x31, 0x93, 0xf6,
+	0xb7, 0x44, 0x87, 0x0e, 0xe7, 0x9c, 0x73, 0x06, 0x0f, 0x23, 0x83, 0x30, 0x0c, 0x7c, 0xea, 0xb3,
+	0x58, 0xc9, 0xb4, 0x85, 0xcc, 0x76, 0xca, 0x96, 0x82, 0x6f, 0xc3, 0x56, 0x44, 0x18, 0x8f, 0x6e,
+	0xe7, 0x22, 0x66, 0x30, 0x3a, 0x0f, 0x6a, 0xef, 0x6c, 0xda, 0x3d, 0xc9, 0x94, 0x71, 0x04, 0xbf,
+	0xaa, 0x42, 0x12, 0x31, 0x9f, 0x46, 0x06, 0xe4, 0xef, 0xbd, 0x84, 0x8f, 0x7e, 0x08, 0x9d, 0x54,
+	0xb3, 0xd1, 0x7d, 0xd0, 0xd0, 0x85, 0xb2, 0x16, 0xeb, 0x27, 0x62, 0xd7, 0x4a, 0xfe, 0x19, 0x23,
+	0x2c, 0xbe, 0xd7, 0xa1, 0xfd, 0x31, 0x4c, 0x8a, 0xbd, 0xd2, 0xe0, 0x43, 0x9c, 0x13, 0xb1, 0x3a,
+	0xae, 0x7a, 0x58, 0x17, 0x92, 0x4d, 0xd6, 0x7f, 0xd6, 0xa1, 0xab, 0xb1, 0x79, 0x08, 0xc5, 0x5f,
+	0x2e, 0xf1, 0x4c, 0xe8, 0x77, 0xd4, 0x32, 0xeb, 0x0a, 0x9e, 0x90, 0x13, 0x87, 0x9b, 0x14, 0xb9,
+	0x09, 0x7c, 0xb5, 0x38, 0xda, 0x82, 0xf1, 0x75, 0xe0, 0x8b, 0x29, 0x0b, 0x96, 0xbe, 0x93, 0x2a,
+	0x68, 0x08, 0x81, 0x9e, 0x64, 0x2a, 0x0d, 0x6f, 0x02, 0x28, 0x21, 0xae, 0x62, 0x43, 0xfa, 0x47,
+	0x72, 0xb8, 0x8e, 0x1f, 0xc0, 0xb6, 0x20, 0x66, 0x37, 0xae, 0x3f, 0x8b, 0xf8, 0xa9, 0xc7, 0x7d,
+	0x5e, 0x53, 0x4a, 0xbe, 0x76, 0x7d, 0x9b, 0x30, 0x8a, 0x7e, 0x04, 0x7d, 0xb2, 0xba, 0x9a, 0xcd,
+	0x49, 0xe4, 0xcc, 0xc2, 0xc0, 0xe5, 0x53, 0xdb, 0x14, 0x62, 0x5b, 0x64, 0x75, 0x75, 0x44, 0x22,
+	0xe7, 0x54, 0x30, 0xb9, 0xb6, 0xb9, 0xb7, 0xbc, 0x98, 0xfd, 0x8a, 0xcc, 0xaf, 0xa5, 0xb6, 0x96,
+	0xd4, 0xc6, 0xb9, 0x9f, 0x93, 0xf9, 0xb5, 0xd0, 0xf6, 0x01, 0x34, 0x59, 0xb4, 0x5c, 0x84, 0xb1,
+	0xd1, 0x16, 0xfe, 0x31, 0xf4, 0xc9, 0xc2, 0xe7, 0xa2, 0x49, 0x9e, 0x25, 0x4a, 0xce, 0xfc, 0x1d,
+	0xe8, 0x6a, 0x6c, 0xbe, 0x3a, 0xaf, 0xe9, 0xad, 0xf2, 0x0e, 0xff, 0xc9, 0x37, 0xd0, 0x8a, 0x78,
+	0x4b, 0x9a, 0x6c, 0x20, 0x41, 0xfc, 0xac, 0xfe, 0xa8, 0xc6, 0x83, 0xd0, 0x63, 0xca, 0x0e, 0xe7,
+	0xcf, 0x5d, 0xba, 0xa2, 0x0b, 0xea, 0xa7, 0x9e, 0xb6, 0x7e, 0x01, 0xd3, 0x52, 0x8b, 0xf2, 0xe6,
+	0x07, 0xd0, 0x23, 0x1a, 0x5f, 0x6d, 0xdb, 0x1e, 0xd6, 0x84, 0xed, 0x9c, 0x84, 0xf5, 0x1f, 0x35,
+	0xe8, 0x6a, 0xad, 0xa5, 0xb7, 0x0b, 0xdf, 0xe1, 0x2e, 0xf3, 0x92, 0x5b, 0x48, 0x12, 0xe8, 0x01,
+	0x74, 0x1d, 0x1a, 0xcf, 0x23, 0x37, 0xe4, 0x77, 0x9f, 0x3a, 0xe3, 0x74, 0x16, 0x3f, 0x33, 0xaf,
+	0x02, 0xe2, 0x29, 0xc7, 0x89, 0xdf, 0x7c, 0xe3, 0x86, 0x51, 0x70, 0x15, 0xd1, 0x38, 0x56, 0x3b,
+	0x34, 0xa5, 0xd1, 0xf7, 0xa1, 0xbb, 0xf4, 0x79, 0x5c, 0x21, 0x1f, 0x43, 0xf2, 0xa8, 0x83, 0x84,
+	0x75, 0xc8, 0xb8, 0x80, 0x7c, 0x3e, 0xce, 0xfc, 0x25, 0x8b, 0x85, 0x7f, 0x36, 0x6c, 0x90, 0xac,
+	0x2f, 0x96, 0x2c, 0xd6, 0x04, 0xae, 0x02, 0xcf, 0x31, 0xda, 0xba, 0xc0, 0x71, 0xe0, 0x39, 0xfc,
+	0x41, 0x71, 0xe4, 0x11, 0x77, 0xf1, 0x98, 0xb8, 0xde, 0xad, 0x7c, 0x63, 0x26, 0x53, 0xfa, 0xa7,
+	0x60, 0x94, 0x9b, 0xb2, 0xf0, 0x38, 0x66, 0x11, 0x25, 0xd7, 0xc9, 0x71, 0x2a, 0xa9, 0xa2, 0x41,
+	0xf5, 0x92, 0x41, 0x08, 0x36, 0x44, 0x4b, 0x43, 0x5e, 0x1b, 0xbe, 0xe2, 0x09, 0xeb, 0x36, 0x24,
+	0x8f, 0xff, 0xb6, 0x10, 0x0c, 0x8e, 0x29, 0xfb, 0x8a, 0x1b, 0x92, 0xfa, 0xf8, 0x43, 0x18, 0x6a,
+	0x3c, 0x65, 0xc9, 0xf7, 0xa0, 0x29, 0x5a, 0xb3, 0xe3, 0x58, 0x08, 0xd8, 0x8a, 0x6b, 0x7d, 0x57,
+	0x87, 0x4d, 0xc1, 0xf9, 0x7f, 0xf3, 0xe2, 0x5b, 0xd0, 0x9b, 0x07, 0x8b, 0xd0, 0xa3, 0x4c, 0x77,
+	0x63, 0x37, 0xe5, 0x1d, 0xb2, 0x42, 0x68, 0xd6, 0x2a, 0x84, 0x66, 0xc5, 0x59, 0x6d, 0xbf, 0xcc,
+	0xcd, 0x9d, 0x92, 0x9b, 0xff, 0xb6, 0x06, 0xdb, 0x79, 0x60, 0x81, 0x9b, 0xe5, 0x70, 0xcf, 0x2a,
+	0x00, 0x42, 0x4c, 0x4c, 0xdb, 0xee, 0x3a, 0x99, 0xb7, 0x35, 0x2f, 0xd7, 0x73, 0x5e, 0x7e, 0x17,
+	0x86, 0x7a, 0xd7, 0x99, 0xe6, 0xd1, 0xbe, 0xd6, 0x5f, 0x98, 0x96, 0xf9, 0x67, 0xa3, 0xd2, 0x3f,
+	0x53, 0x71, 0x76, 0x9f, 0x07, 0xcb, 0xc8, 0x27, 0x6a, 0xdf, 0x4a, 0x6f, 0x1f, 0xc3, 0xa4, 0xd8,
+	0xa0, 0x5c, 0xfe, 0x63, 0xe8, 0xb2, 0x8c, 0xad, 0xfc, 0xde, 0xc5, 0x99, 0xa8, 0xad, 0xb7, 0x5b,
+	0x07, 0x30, 0xca, 0x29, 0xd2, 0xa2, 0xda, 0x4c, 0x4c, 0x8b, 0x6a, 0x33, 0xe6, 0x89, 0x63, 0x85,
+	0x05, 0xf3, 0xb4, 0x30, 0x0b, 0x32, 0x41, 0x75, 0x51, 0xe4, 0x6c, 0xd0, 0x9a, 0xd1, 0xbb, 0x59,
+	0x4c, 0x56, 0x17, 0xd6, 0x0e, 0x34, 0xc9, 0x7c, 0x48, 0x66, 0xcd, 0x60, 0xd7, 0xa6, 0x57, 0x6e,
+	0xcc, 0x68, 0xf4, 0xdf, 0xb3, 0x99, 0xaf, 0x26, 0x75, 0x81, 0x66, 0x71, 0x58, 0x47, 0x71, 0x4e,
+	0x1c, 0xeb, 0x31, 0x98, 0x55, 0x03, 0xa8, 0xef, 0x4a, 0x36, 0x68, 0xad, 0x62, 0x83, 0xd6, 0xb5,
+	0x0d, 0xfa, 0x09, 0xec, 0x3d, 0xf3, 0xa3, 0xff, 0x91, 0xa1, 0xd6, 0x67, 0xf0, 0x46, 0xb5, 0x8e,
+	0x57, 0xb4, 0xe5, 0x5f, 0xeb, 0x00, 0x59, 0xf7, 0x7b, 0x6e, 0xf4, 0x09, 0x34, 0x2f, 0x83, 0x68,
+	0xa1, 0x40, 0xa6, 0x8e, 0xad, 0xa8, 0x35, 0x2f, 0x86, 0x3d, 0x10, 0x71, 0xd0, 0x2c, 0x76, 0xbf,
+	0xa5, 0xc9, 0x0e, 0xe7, 0x8c, 0x33, 0xf7, 0xdb, 0x7c, 0xf0, 0xd5, 0x2c, 0x04, 0x5f, 0x7b, 0xd0,
+	0xe1, 0x9e, 0xbd, 0x9d, 0x5d, 0x52, 0xaa, 0x22, 0xaa, 0xb6, 0x60, 0x7c, 0x46, 0x05, 0xd8, 0x15,
+	0x46, 0xee, 0xb7, 0x74, 0x16, 0x06, 0x81, 0xa7, 0xf6, 0x75, 0x47, 0x70, 0x4e, 0x83, 0xc0, 0xe3,
+	0xe1, 0x6f, 0xb2, 0x6c, 0x3a, 0xa2, 0x67, 0x42, 0xf2, 0x0d, 0xbf, 0x20, 0x2f, 0x66, 0x49, 0x2b,
+	0x88, 0x56, 0x58, 0x90, 0x17, 0x9f, 0x2a, 0x81, 0x3d, 0x90, 0x71, 0xa3, 0x38, 0x50, 0xba, 0xe2,
+	0x40, 0x69, 0x4b, 0xc6, 0xa1, 0xf8, 0x44, 0x11, 0x11, 0x18, 0x3d, 0x79, 0xc1, 0x0a, 0x42, 0x04,
+	0x85, 0xfc, 0x47, 0x6c, 0x6c, 0xc9, 0xdd, 0x2e, 0x29, 0xeb, 0xdf, 0x6b, 0xd0, 0x2f, 0xac, 0xd6,
+	0xd2, 0x14, 0xbf, 0x14, 0x0b, 0xd5, 0x82, 0xbd, 0xc6, 0x9a, 0x60, 0x6f, 0x02, 0x4d, 0x15, 0x8e,
+	0xc8, 0x93, 0x55, 0x51, 0x1c, 0xf2, 0xa3, 0x9e, 0x2b, 0x91, 0x32, 0x47, 0x3d, 0xd8, 0x34, 0x0e,
+	0xff, 0x9a, 0xd0, 0x23, 0x73, 0x9a, 0x3c, 0x90, 0x04, 0x21, 0xb8, 0x7c, 0x22, 0xd5, 0xa5, 0x28,
+	0x09, 0xee, 0x29, 0x22, 0x1e, 0xa9, 0x54, 0x5e, 0x86, 0x6d, 0x3b, 0xa5, 0xad, 0x3f, 0xaf, 0x41,
+	0x4f, 0x07, 0x51, 0xab, 0xd6, 0x11, 0x5d, 0xf1, 0xdd, 0xae, 0xd6, 0x91, 0x20, 0xb8, 0x93, 0x42,
+	0x72, 0xeb, 0x05, 0x24, 0x05, 0x79, 0x15, 0x29, 0x9e, 0x48, 0x94, 0x38, 0x0a, 0xa5, 0x14, 0xbf,
+	0x0b, 0xf0, 0xe6, 0x66, 0x01, 0xde, 0xb4, 0x9e, 0x8b, 0x30, 0x46, 0xb7, 0x22, 0x8d, 0x65, 0x45,
+	0x30, 0xc0, 0x75, 0xcc, 0x02, 0xdf, 0xbb, 0x55, 0xc7, 0x35, 0x48, 0xd6, 0x97, 0xbe, 0xa7, 0x3f,
+	0x20, 0xeb, 0xd5, 0x4f, 0x9c, 0x86, 0xfe, 0xc4, 0xf9, 0x35, 0x18, 0xe5, 0x91, 0xd4, 0xbe, 0x2b,
+	0x61, 0xcc, 0xb5, 0x7b, 0x60, 0xcc, 0x95, 0xcf, 0x1e, 0x6e, 0x94, 0x34, 0x51, 0x8d, 0xae, 0x28,
+	0xeb, 0x63, 0xe8, 0x3f, 0x25, 0xd1, 0xb5, 0x4d, 0x49, 0xfa, 0xc4, 0xfe, 0x4d, 0x18, 0xe8, 0x1a,
+	0x67, 0xae, 0xc2, 0xb0, 0x3a, 0x76, 0x5f, 0xe7, 0x9f, 0x38, 0xb1, 0xf5, 0x2e, 0x0c, 0xb2, 0xde,
+	0x59, 0x48, 0xa2, 0x46, 0xaa, 0xe5, 0x46, 0x1a, 0x09, 0x9c, 0xec, 0x34, 0x0a, 0x9c, 0xe5, 0x3c,
+	0xbb, 0x5d, 0x0e, 0x60, 0x27, 0xc7, 0xcd, 0x50, 0xdf, 0x50, 0xf1, 0x52, 0xd4, 0x57, 0x09, 0xd9,
+	0x69, 0x8b, 0xf5, 0x87, 0x30, 0x39, 0x5d, 0x46, 0xf3, 0xe7, 0x24, 0xa6, 0x49, 0x63, 0x06, 0xfa,
+	0x2a, 0x29, 0x0d, 0xf4, 0x55, 0x1c, 0x91, 0x32, 0xe8, 0x86, 0xaa, 0x63, 0x76, 0x34, 0x43, 0xc2,
+	0x3a, 0x71, 0xac, 0x3f, 0x83, 0x69, 0x49, 0x73, 0x8a, 0x81, 0xe5, 0xfa, 0xd6, 0x8a, 0x7d, 0xd3,
+	0xd3, 0xb2, 0x5e, 0x71, 0x5a, 0x36, 0xb2, 0xd3, 0x92, 0x1f, 0x0d, 0x2b, 0x37, 0x9c, 0x2d, 0x7d,
+	0xe6, 0xca, 0x20, 0xa6, 0x61, 0xb7, 0x57, 0x6e, 0xf8, 0x8c, 0xd3, 0xd6, 0xd7, 0x30, 0x3e, 0x12,
+	0xab, 0xf1, 0xe8, 0x39, 0x9d, 0x5f, 0x07, 0xcb, 0xd7, 0xf6, 0x65, 0xbf, 0x84, 0x49, 0x51, 0xf1,
+	0x7d, 0x3f, 0x8c, 0x07, 0x50, 0xaa, 0xd3, 0x6c, 0x19, 0x79, 0x09, 0x3a, 0x9d, 0xf0, 0x9e, 0x45,
+	0x9e, 0xf5, 0x15, 0x8c, 0xfe, 0x80, 0x46, 0xee, 0xe5, 0xad, 0x4d, 0xe7, 0xd4, 0x0d, 0x99, 0xb6,
+	0x69, 0xee, 0xd6, 0x6d, 0x40, 0x2b, 0x92, 0x5d, 0x94, 0xda, 0x84, 0xb4, 0x7e, 0x0d, 0xe3, 0x82,
+	0xca, 0xff, 0x53, 0x47, 0x8c, 0xc5, 0x02, 0x3d, 0xf1, 0xf9, 0x19, 0x93, 0xe1, 0x18, 0x3c, 0x5d,
+	0x91, 0x67, 0xa7, 0x0b, 0x77, 0xd3, 0x65, 0x74, 0x91, 0xac, 0xda, 0x6d, 0x9c, 0x8a, 0x9c, 0x30,
+	0xba, 0xb0, 0x65, 0xa3, 0xf5, 0x37, 0x35, 0xd8, 0xca, 0x35, 0xbc, 0xb6, 0xa0, 0xd8, 0x84, 0xf6,
+	0x37, 0x4b, 0xe2, 0x33, 0x97, 0xdd, 0xaa, 0xe3, 0x3b, 0xa5, 0x0b, 0xd1, 0xed, 0x66, 0x11, 0x78,
+	0xfc, 0xae, 0x06, 0x2d, 0xb5, 0xd8, 0x5f, 0x9b, 0x39, 0xf2, 0xf4, 0x9f, 0x53, 0x65, 0x8b, 0x24,
+	0x72, 0xf7, 0xf4, 0x66, 0xfe, 0x9e, 0xb6, 0x56, 0x80, 0xe4, 0xfa, 0xcc, 0x21, 0xb5, 0x7a, 0x8f,
+	0x5a, 0x35, 0xac, 0x52, 0xcf, 0x60, 0x15, 0x7e, 0x15, 0x44, 0xee, 0x8a, 0x30, 0x09, 0xcf, 0xb4,
+	0xed, 0x84, 0xe4, 0x2d, 0x2b, 0x12, 0xb9, 0xc4, 0x67, 0x2a, 0xac, 0x48, 0x48, 0x6b, 0x06, 0x3b,
+	0xb9, 0x71, 0x95, 0x3f, 0xef, 0x86, 0x88, 0x97, 0xbe, 0xcb, 0x66, 0xec, 0x36, 0x4c, 0x71, 0x50,
+	0xce, 0x38, 0xbf, 0x0d, 0x69, 0x62, 0x54, 0x23, 0x35, 0xca, 0xfa, 0xe7, 0x9a, 0x58, 0x32, 0x3c,
+	0xc1, 0x29, 0x86, 0x88, 0xef, 0xf3, 0x6d, 0x9a, 0xbd, 0xf5, 0x9c, 0xbd, 0x68, 0x0a, 0xad, 0x85,
+	0xeb, 0xcf, 0xb2, 0x41, 0x9a, 0x0b, 0xd7, 0xff, 0x84, 0xca, 0x06, 0xf2, 0x42, 0x34, 0xa8, 0xfb,
+	0x7b, 0x41, 0x5e, 0xf0, 0x86, 0x37, 0x01, 0x2e, 0x23, 0x4a, 0x67, 0x31, 0x25, 0x2c, 0x79, 0x1d,
+	0x75, 0x38, 0xe7, 0x8c, 0x33, 0xb4, 0x6b, 0xab, 0x59, 0x7d, 0x6d, 0xb5, 0xf4, 0x6b, 0xeb, 0x29,
+	0x8c, 0x0b, 0x1f, 0x93, 0xbd, 0x03, 0xc5, 0x04, 0x65, 0xef, 0x40, 0x39, 0xa1, 0x8a, 0xbb, 0x06,
+	0x95, 0x9b, 0xc2, 0xf8, 0x6c, 0x79, 0xc1, 0xd7, 0xcd, 0x05, 0x7d, 0x12, 0x5c, 0x5c, 0xa4, 0xfb,
+	0xcc, 0x80, 0x49, 0xb1, 0x41, 0x81, 0xfa, 0xbb, 0x30, 0x7d, 0xe6, 0xc7, 0x95, 0x9d, 0x4c, 0x30,
+	0xca, 0x4d, 0xaa, 0xdb, 0x8f, 0x61, 0xf0, 0x79, 0xe0, 0xfa, 0xf7, 0xcc, 0x03, 0x58, 0x0f, 0x61,
+	0xa8, 0x89, 0xdf, 0x0b, 0x46, 0xff, 0x25, 0x18, 0x9f, 0xd0, 0x79, 0xb0, 0xa0, 0xa7, 0x24, 0x62,
+	0xee, 0xdc, 0x0d, 0x89, 0xcf, 0x5e, 0x3e, 0x12, 0xfa, 0x21, 0x6c, 0x87, 0x59, 0x87, 0xec, 0xf0,
+	0xde, 0xd2, 0xb8, 0x27, 0x8e, 0xb5, 0x07, 0xbb, 0x15, 0xda, 0xd5, 0xc7, 0x7d, 0x04, 0x3d, 0x7e,
+	0x17, 0xa7, 0x68, 0x6b, 0x59, 0x67, 0xad, 0x4a, 0x67, 0x1f, 0xb6, 0x54, 0x37, 0xa5, 0xe7, 0xf7,
+	0x78, 0x54, 0x70, 0x4d, 0x9f, 0x06, 0xab, 0xfb, 0xe4, 0x4a, 0x10, 0x6c, 0x70, 0x10, 0x4b, 0xd9,
+	0x2b, 0x7e, 0x73, 0xdc, 0x20, 0xd3, 0xa0, 0xb4, 0x62, 0x18, 0x3e, 0xa1, 0x64, 0x45, 0xef, 0x3b,
+	0xf7, 0x23, 0x40, 0xba, 0xbc, 0xd2, 0xf2, 0x1e, 0x6c, 0x9f, 0x31, 0xe2, 0x3b, 0xcf, 0xc2, 0x7b,
+	0xa8, 0x18, 0x42, 0x3f, 0x15, 0x56, 0xfd, 0xff, 0x08, 0x26, 0xbf, 0x70, 0xe7, 0xd7, 0xff, 0x2b,
+	0xce, 0xd9, 0x85, 0x69, 0x49, 0xb7, 0x1a, 0xf6, 0x1a, 0xfa, 0x4f, 0x82, 0xf9, 0x35, 0xdf, 0x6b,
+	0xaf, 0x6d, 0x3c, 0xbe, 0x67, 0x25, 0x06, 0xa5, 0xce, 0x39, 0x45, 0xf1, 0xd9, 0xcf, 0x06, 0xcb,
+	0x66, 0x5f, 0xd4, 0x0e, 0xbc, 0xc2, 0xec, 0xeb, 0xf2, 0x4a, 0xcb, 0x33, 0x18, 0x72, 0x9f, 0x9e,
+	0x07, 0xaf, 0xf5, 0x43, 0xf8, 0x60, 0xba, 0xda, 0xcc, 0x55, 0x6a, 0x08, 0xce, 0x3e, 0xbb, 0x21,
+	0xe1, 0xeb, 0x1b, 0xf1, 0x2b, 0x98, 0x96, 0x74, 0xab, 0xed, 0x3d, 0x85, 0x56, 0x7c, 0x43, 0xc2,
+	0x4c, 0x77, 0x93, 0x93, 0x2f, 0xcf, 0xdc, 0x7d, 0x00, 0x63, 0x99, 0x79, 0x2b, 0x5a, 0xbb, 0x4e,
+	0x21, 0x3f, 0xdd, 0x8a, 0x3d, 0xd4, 0xa7, 0x1f, 0xc1, 0xb6, 0x4d, 0x45, 0x26, 0xe3, 0x1e, 0x9f,
+	0xcc, 0xd3, 0x36, 0x42, 0x8d, 0xaa, 0xb6, 0x51, 0x14, 0x5f, 0xfd, 0xa9, 0x12, 0xa5, 0xf7, 0x4b,
+	0x89, 0xa3, 0xf0, 0x8e, 0x9f, 0xae, 0x34, 0x98, 0xe7, 0x25, 0x17, 0x1d, 0xb9, 0x64, 0x34, 0x9a,
+	0xc5, 0xf4, 0x9b, 0x04, 0x2f, 0x17, 0x8c, 0x33, 0xfa, 0x8d, 0xf5, 0xbb, 0x12, 0x1e, 0xd2, 0x15,
+	0xa6, 0xc9, 0xd0, 0xa6, 0x78, 0x99, 0x69, 0xc8, 0x50, 0x2a, 0x65, 0xab, 0x26, 0xeb, 0xdf, 0x6a,
+	0x00, 0x19, 0x9b, 0x5f, 0x9b, 0x7c, 0x10, 0xf9, 0x72, 0xe0, 0x3f, 0xf9, 0xe1, 0xa2, 0x5d, 0xb0,
+	0xe2, 0x37, 0xbf, 0x43, 0x82, 0xc8, 0x51, 0x4f, 0xd8, 0x2d, 0x5b, 0x12, 0xf9, 0x24, 0xc3, 0x46,
+	0x21, 0xeb, 0x95, 0x9c, 0x51, 0x9b, 0xd9, 0x19, 0x25, 0x54, 0x53, 0xb2, 0x50, 0xf7, 0x9d, 0xf8,
+	0x2d, 0xa6, 0x72, 0xc1, 0x73, 0x5b, 0x0a, 0xfd, 0x53, 0x14, 0x7a, 0x03, 0x3a, 0xb1, 0x7b, 0xe5,
+	0x13, 0xb6, 0x8c, 0xa8, 0xd1, 0x56, 0x45, 0x24, 0x09, 0xa3, 0xf0, 0x9a, 0xec, 0x14, 0x5f, 0x93,
+	0x7f, 0x57, 0x83, 0xae, 0x76, 0x26, 0x54, 0x45, 0x57, 0xf2, 0x7b, 0xea, 0xfa, 0xf7, 0xa4, 0x00,
+	0x48, 0x43, 0x07, 0x40, 0x46, 0xb0, 0x39, 0x4f, 0xeb, 0x94, 0x3a, 0xb6, 0x24, 0x78, 0x2c, 0x2c,
+	0x7e, 0xcc, 0x44, 0x5e, 0x4e, 0x5d, 0xee, 0x20, 0x58, 0x47, 0xc2, 0xfe, 0x0c, 0x18, 0x68, 0x56,
+	0xd7, 0x36, 0xfc, 0x63, 0x03, 0x36, 0x85, 0x23, 0xaa, 0xac, 0x13, 0x19, 0x83, 0x34, 0xf6, 0xe3,
+	0x84, 0x98, 0xbc, 0x65, 0xe4, 0x2b, 0x17, 0x88, 0xdf, 0xdc, 0x0a, 0xb9, 0x86, 0x74, 0x0b, 0x41,
+	0xb0, 0x8e, 0x52, 0x33, 0x79, 0x2a, 0x43, 0x99, 0x92, 0x98, 0xe9, 0x2d, 0x2f, 0xa4, 0x35, 0xb2,
+	0x1a, 0x89, 0x24, 0x75, 0x51, 0x5b, 0xb6, 0xa2, 0xd0, 0x03, 0xe8, 0x09, 0xd8, 0xe7, 0xe1, 0x4c,
+	0x66, 0x1b, 0x65, 0x2c, 0x22, 0x92, 0x64, 0x0f, 0xcf, 0x38, 0x27, 0x95, 0xd8, 0x57, 0x12, 0xed,
+	0x4c, 0x62, 0x3f, 0x2f, 0xf1, 0x50, 0xa5, 0xcc, 0x3a, 0x9a, 0x0e, 0x99, 0x2e, 0xcb, 0x74, 0x48,
+	0x09, 0xd0, 0x74, 0x48, 0x89, 0x0f, 0xa0, 0xa7, 0x1d, 0x23, 0x49, 0x2e, 0xac, 0x87, 0xf5, 0x13,
+	0x3f, 0x27, 0x91, 0x04, 0x82, 0xbd, 0x2c, 0x3a, 0xcd, 0xc5, 0x8d, 0x5b, 0x85, 0xb8, 0x91, 0x07,
+	0x7c, 0x34, 0x8a, 0x79, 0x38, 0xbd, 0x2d, 0x9e, 0x28, 0x09, 0xa9, 0x87, 0x82, 0xfd, 0x7c, 0xe8,
+	0xfa, 0x4f, 0x35, 0x68, 0xaa, 0xf9, 0x2b, 0xfa, 0xee, 0xae, 0x54, 0x3d, 0x0f, 0xec, 0xe8, 0x8a,
+	0x7a, 0x29, 0x1e, 0xc1, 0x09, 0x6e, 0x2f, 0x7d, 0x11, 0xaa, 0x1c, 0x00, 0xff, 0x99, 0x3e, 0xa3,
+	0x36, 0x2b, 0x9e, 0x51, 0x4d, 0xed, 0x19, 0x95, 0x25, 0x8f, 0x5b, 0xb9, 0xe4, 0xb1, 0x56, 0x42,
+	0xd4, 0x5e, 0x57, 0x42, 0xf4, 0xf7, 0xf2, 0xed, 0xc1, 0x7f, 0x8b, 0x38, 0xd5, 0x8d, 0x62, 0x36,
+	0x13, 0x56, 0xab, 0x17, 0xae, 0xe0, 0x7c, 0xc1, 0xcd, 0xde, 0x83, 0x8e, 0x47, 0x92, 0x56, 0xf5,
+	0x4d, 0x1e, 0x51, 0x8d, 0x03, 0x68, 0xf0, 0xba, 0x22, 0x15, 0x76, 0x93, 0x2b, 0x01, 0x47, 0x5c,
+	0x51, 0xdf, 0xa1, 0x69, 0x4a, 0x5b, 0x52, 0x77, 0xa4, 0xb4, 0x4d, 0x68, 0x7b, 0xc4, 0xbf, 0x5a,
+	0x72, 0x45, 0xcd, 0x44, 0xbf, 0xa4, 0xf7, 0xff, 0x7a, 0x17, 0xba, 0xc7, 0x64, 0x41, 0xcf, 0x68,
+	0x24, 0xaa, 0xe4, 0x1e, 0x41, 0x57, 0xab, 0x58, 0x44, 0x3b, 0xb8, 0x5c, 0x27, 0x69, 0x8e, 0x70,
+	0x55, 0x51, 0xe3, 0x01, 0xf4, 0xf4, 0x52, 0x3e, 0x34, 0xc2, 0x15, 0x05, 0x7f, 0xe6, 0x18, 0x57,
+	0xd6, 0xfb, 0x1d, 0x40, 0x4f, 0x2f, 0x96, 0x43, 0x23, 0x5c, 0x51, 0x52, 0x67, 0x8e, 0x71, 0x65,
+	0x45, 0xdd, 0x31, 0x0c, 0x8a, 0x15, 0x6f, 0xc8, 0xc0, 0x6b, 0xca, 0xe7, 0xcc, 0x5d, 0xbc, 0xae,
+	0x3c, 0x0e, 0x3d, 0x86, 0x7e, 0xa1, 0x08, 0x0e, 0x4d, 0x71, 0x75, 0xc1, 0x9c, 0x69, 0xe0, 0x35,
+	0xf5, 0x72, 0xe8, 0x10, 0xb6, 0xf3, 0x05, 0x6d, 0x68, 0x82, 0x2b, 0x0b, 0xe8, 0xcc, 0x29, 0xae,
+	0xae, 0x7c, 0x43, 0x3f, 0x87, 0xad, 0x5c, 0xf5, 0x1a, 0x1a, 0xe3, 0xaa, 0x4a, 0x38, 0x73, 0x82,
+	0xab, 0x8b, 0xdc, 0xf6, 0xa1, 0x93, 0x56, 0x9e, 0xa1, 0x21, 0x2e, 0x16, 0xb3, 0x99, 0x08, 0x97,
+	0x0b, 0xd3, 0x8e, 0x45, 0x62, 0x2c, 0x57, 0x48, 0x86, 0x0c, 0xbc, 0xa6, 0x26, 0xcd, 0xdc, 0xc5,
+	0x6b, 0xab, 0xce, 0x3e, 0x02, 0xc8, 0xea, 0xc7, 0x10, 0xc2, 0xa5, 0x0a, 0x33, 0x73, 0x07, 0x57,
+	0x14, 0x98, 0xed, 0x43, 0x27, 0x2d, 0x06, 0x43, 0x43, 0x5c, 0xac, 0x24, 0x33, 0x11, 0x2e, 0xd7,
+	0x8a, 0x1d, 0x40, 0x4f, 0x2f, 0xeb, 0x42, 0x23, 0x5c, 0x51, 0x19, 0x66, 0x8e, 0x71, 0x65, 0xed,
+	0xd7, 0x01, 0xf4, 0xf4, 0x32, 0x2d, 0x34, 0xc2, 0x15, 0x95, 0x5e, 0xe6, 0x18, 0x57, 0xd5, 0x72,
+	0xf1, 0x7d, 0xa2, 0x15, 0x66, 0xa1, 0x1d, 0x5c, 0xae, 0xec, 0x32, 0x47, 0xb8, 0xa2, 0x76, 0x8b,
+	0xfb, 0x36, 0x57, 0x6b, 0x85, 0xc6, 0xb8, 0xaa, 0x96, 0xcb, 0x9c, 0xe0, 0xea, 0x92, 0xac, 0x63,
+	0x18, 0x14, 0xcb, 0xa1, 0x90, 0x81, 0xd7, 0x54, 0x58, 0x99, 0xbb, 0x78, 0x6d, 0xed, 0xd4, 0x21,
+	0x6c, 0xe7, 0x2b, 0x7d, 0xd0, 0x04, 0x57, 0xd6, 0x28, 0x99, 0x53, 0xbc, 0xa6, 0x24, 0xe8, 0x31,
+	0xf4, 0x0b, 0xe5, 0x2c, 0x68, 0x8a, 0x0b, 0x9c, 0x6c, 0xc3, 0xac, 0xab, 0x7c, 0x91, 0x86, 0xe8,
+	0x35, 0x0f, 0x13, 0x5c, 0x59, 0x76, 0x61, 0x4e, 0x4b, 0xfc, 0x9c, 0x21, 0x7a, 0x96, 0x5e, 0x1a,
+	0x52, 0x91, 0xd1, 0x37, 0x8d, 0x72, 0x43, 0x36, 0xb5, 0xc5, 0xc4, 0x34, 0x32, 0xf0, 0x9a, 0x34,
+	0xb6, 0xb9, 0x8b, 0xd7, 0x66, 0xb1, 0xf7, 0xa1, 0x93, 0x26, 0x94, 0xd1, 0x10, 0x17, 0x13, 0xce,
+	0x26, 0xc2, 0xe5, 0x7c, 0xb3, 0x9c, 0x05, 0x2d, 0x2d, 0x29, 0x67, 0xa1, 0x9c, 0xc0, 0x34, 0xa7,
+	0x25, 0x7e, 0xb6, 0xb4, 0x72, 0x2d, 0x68, 0x8c, 0xab, 0x12, 0x94, 0xe6, 0x04, 0x57, 0xa7, 0x1e,
+	0x9f, 0x02, 0x2a, 0x27, 0xf0, 0x90, 0x89, 0xd7, 0xa6, 0x0d, 0xcd, 0x3d, 0x7c, 0x47, 0xc6, 0xef,
+	0x0c, 0x46, 0x55, 0x59, 0x38, 0xf4, 0x06, 0xbe, 0x23, 0xc1, 0x67, 0xbe, 0x89, 0xef, 0x4c, 0xdd,
+	0xc9, 0x63, 0x2a, 0x97, 0x5e, 0x90, 0xc7, 0x54, 0x55, 0x6e, 0xc3, 0xdc, 0xad, 0x68, 0x51, 0x8a,
+	0xde, 0x87, 0x76, 0x02, 0xf5, 0xa3, 0x01, 0x2e, 0xe4, 0x0c, 0xcc, 0x21, 0x2e, 0xe5, 0x01, 0x1e,
+	0x41, 0x57, 0x43, 0xf6, 0xd1, 0x0e, 0xd6, 0xa8, 0x6c, 0xcb, 0x57, 0x81, 0xff, 0x8f, 0xa1, 0x5f,
+	0x00, 0xdf, 0xd1, 0x14, 0x57, 0x03, 0xfd, 0xa6, 0x81, 0xd7, 0xe1, 0xf4, 0xfc, 0x5e, 0xc9, 0x01,
+	0xdd, 0xfc, 0x5e, 0xa9, 0x82, 0xd4, 0xcd, 0x69, 0x89, 0x9f, 0x2d, 0x90, 0x1c, 0xf4, 0x8c, 0xc6,
+	0xb8, 0x0a, 0xdd, 0x36, 0x27, 0xb8, 0x1a, 0xa1, 0x3e, 0x80, 0x9e, 0x0e, 0x12, 0xa3, 0x11, 0xd6,
+	0xc9, 0xec, 0xc8, 0xac, 0x44, 0x92, 0x1f, 0x41, 0x57, 0x03, 0x24, 0xd1, 0x0e, 0x2e, 0xc3, 0xa2,
+	0xe6, 0x08, 0x57, 0x61, 0x96, 0x72, 0x5d, 0x67, 0xd8, 0x9c, 0x5c, 0xd7, 0x25, 0xe0, 0xd1, 0x9c,
+	0x14, 0xd9, 0xd9, 0xcc, 0xe5, 0x31, 0x37, 0x34, 0xc1, 0x95, 0xe8, 0x9c, 0x39, 0xc5, 0xd5, 0xe0,
+	0x1c, 0x5f, 0x76, 0x45, 0x04, 0x0e, 0x19, 0x78, 0x0d, 0x5e, 0x67, 0xee, 0xe2, 0x75, 0x70, 0x1d,
+	0x3f, 0x1a, 0x52, 0xfc, 0x0d, 0x0d, 0x71, 0x11, 0xba, 0x33, 0x11, 0x2e, 0xc3, 0x73, 0x9f, 0xc3,
+	0xb0, 0x04, 0x91, 0xa1, 0x5d, 0xbc, 0x0e, 0x94, 0x33, 0x4d, 0xbc, 0x16, 0x51, 0x43, 0x3f, 0x82,
+	0x4d, 0x01, 0x8d, 0xa1, 0x2d, 0xac, 0x23, 0x6b, 0xe6, 0x36, 0xce, 0x21, 0x66, 0x72, 0x7b, 0x48,
+	0xbc, 0x4b, 0x6c, 0x8f, 0x1c, 0x78, 0x66, 0x0e, 0x35, 0x4e, 0x76, 0xed, 0x67, 0xe0, 0x16, 0x42,
+	0xb8, 0x84, 0x8c, 0x99, 0x3b, 0xb8, 0x8c, 0x7e, 0xa1, 0xdf, 0x82, 0x96, 0x02, 0xb4, 0x50, 0x1f,
+	0xe7, 0x71, 0x30, 0x73, 0x80, 0x0b, 0x58, 0x17, 0xdf, 0x49, 0x05, 0x3c, 0x0a, 0x4d, 0x71, 0x35,
+	0xfa, 0x65, 0x1a, 0x78, 0x0d, 0x74, 0xc5, 0xbf, 0x2d, 0x41, 0x93, 0xd0, 0x00, 0x17, 0x50, 0x2c,
+	0x73, 0x88, 0x8b, 0x50, 0x13, 0xff, 0xb6, 0x0c, 0x3a, 0x42, 0x08, 0x97, 0x70, 0x27, 0x73, 0x07,
+	0x97, 0xb1, 0x25, 0xde, 0x2d, 0x03, 0x81, 0x10, 0xc2, 0x25, 0xa0, 0xc9, 0xdc, 0xc1, 0x65, 0x94,
+	0x88, 0x7f, 0x64, 0x01, 0xc9, 0x41, 0x53, 0x5c, 0x8d, 0x1b, 0x99, 0x06, 0x5e, 0x07, 0xfa, 0x1c,
+	0xc2, 0x76, 0x1e, 0x8a, 0x41, 0x13, 0x5c, 0x89, 0xe6, 0x98, 0x53, 0x5c, 0x8d, 0xd9, 0x70, 0xdf,
+	0x28, 0xb8, 0x05, 0xf5, 0x71, 0x1e, 0xbd, 0x31, 0x07, 0xb8, 0x80, 0xc4, 0x24, 0x17, 0x58, 0x06,
+	0x9c, 0xa8, 0x0b, 0xac, 0x04, 0xcd, 0x98, 0xd3, 0x12, 0x5f, 0xaa, 0xb8, 0x68, 0x8a, 0xbf, 0x67,
+	0x7d, 0xf8, 0x5f, 0x03, 0x00, 0xf4, 0x6d, 0xbd, 0xc8, 0xb2, 0x35, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GameServiceClient is the client API for GameService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GameServiceClient interface {
+	OpenSession(ctx context.Context, in *OpenSessionRequest, opts ...grpc.CallOption) (*OpenSessionResponse, error)
+	CloseSession(ctx context.Context, in *CloseSessionRequest, opts ...grpc.CallOption) (*CloseSessionResponse, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	TerminateSession(ctx context.Context, in *TerminateSessionRequest, opts ...grpc.CallOption) (*TerminateSessionResponse, error)
+	TakeSeatControl(ctx context.Context, in *TakeSeatControlRequest, opts ...grpc.CallOption) (*TakeSeatControlResponse, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	// Profile
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
+	SetAvatar(ctx context.Context, in *SetAvatarRequest, opts ...grpc.CallOption) (*SetAvatarResponse, error)
+	GetPlayerProfile(ctx context.Context, in *GetPlayerProfileRequest, opts ...grpc.CallOption) (*GetPlayerProfileResponse, error)
+	// Social
+	GetFriends(ctx context.Context, in *GetFriendsRequest, opts ...grpc.CallOption) (*GetFriendsResponse, error)
+	AddFriend(ctx context.Context, in *AddFriendRequest, opts ...grpc.CallOption) (*AddFriendResponse, error)
+	AcceptFriend(ctx context.Context, in *AcceptFriendRequest, opts ...grpc.CallOption) (*AcceptFriendResponse, error)
+	RemoveFriend(ctx context.Context, in *RemoveFriendRequest, opts ...grpc.CallOption) (*RemoveFriendResponse, error)
+	BlockPlayer(ctx context.Context, in *BlockPlayerRequest, opts ...grpc.CallOption) (*BlockPlayerResponse, error)
+	InviteToTable(ctx context.Context, in *InviteToTableRequest, opts ...grpc.CallOption) (*InviteToTableResponse, error)
+	AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*AcceptInvitationResponse, error)
+	// Leaderboards
+	GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error)
+	// Statistics
+	GetMatchHistory(ctx context.Context, in *GetMatchHistoryRequest, opts ...grpc.CallOption) (*GetMatchHistoryResponse, error)
+	GetPlayerStats(ctx context.Context, in *GetPlayerStatsRequest, opts ...grpc.CallOption) (*GetPlayerStatsResponse, error)
+	GetAchievements(ctx context.Context, in *GetAchievementsRequest, opts ...grpc.CallOption) (*GetAchievementsResponse, error)
+	// Rewards
+	ClaimDailyReward(ctx context.Context, in *ClaimDailyRewardRequest, opts ...grpc.CallOption) (*ClaimDailyRewardResponse, error)
+	GetQuests(ctx context.Context, in *GetQuestsRequest, opts ...grpc.CallOption) (*GetQuestsResponse, error)
+	// Tournaments
+	GetTournaments(ctx context.Context, in *GetTournamentsRequest, opts ...grpc.CallOption) (*GetTournamentsResponse, error)
+	GetTournament(ctx context.Context, in *GetTournamentRequest, opts ...grpc.CallOption) (*GetTournamentResponse, error)
+	RegisterTournament(ctx context.Context, in *RegisterTournamentRequest, opts ...grpc.CallOption) (*RegisterTournamentResponse, error)
+	UnregisterTournament(ctx context.Context, in *UnregisterTournamentRequest, opts ...grpc.CallOption) (*UnregisterTournamentResponse, error)
+	// Notifications
+	GetNotifications(ctx context.Context, in *GetNotificationsRequest, opts ...grpc.CallOption) (*GetNotificationsResponse, error)
+	MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*MarkReadResponse, error)
+	// Shop
+	GetProducts(ctx context.Context, in *GetProductsRequest, opts ...grpc.CallOption) (*GetProductsResponse, error)
+	PurchaseProduct(ctx context.Context, in *PurchaseProductRequest, opts ...grpc.CallOption) (*PurchaseProductResponse, error)
+	CreateCheckout(ctx context.Context, in *CreateCheckoutRequest, opts ...grpc.CallOption) (*CreateCheckoutResponse, error)
+	VerifyReceipt(ctx context.Context, in *VerifyReceiptRequest, opts ...grpc.CallOption) (*VerifyReceiptResponse, error)
+	GetInventory(ctx context.Context, in *GetInventoryRequest, opts ...grpc.CallOption) (*GetInventoryResponse, error)
+	// Table requests
+	CreateTable(ctx context.Context, in *CreateTableRequest, opts ...grpc.CallOption) (*CreateTableResponse, error)
+	GetOpenTables(ctx context.Context, in *GetOpenTablesRequest, opts ...grpc.CallOption) (*GetOpenTablesResponse, error)
+	SubscribeLobby(ctx context.Context, in *SubscribeLobbyRequest, opts ...grpc.CallOption) (*SubscribeLobbyResponse, error)
+	UnsubscribeLobby(ctx context.Context, in *UnsubscribeLobbyRequest, opts ...grpc.CallOption) (*UnsubscribeLobbyResponse, error)
+	JoinTable(ctx context.Context, in *JoinTableRequest, opts ...grpc.CallOption) (*JoinTableResponse, error)
+	BecomeParticipant(ctx context.Context, in *BecomeParticipantRequest, opts ...grpc.CallOption) (*BecomeParticipantResponse, error)
+	Ready(ctx context.Context, in *ReadyRequest, opts ...grpc.CallOption) (*ReadyResponse, error)
+	MakeMove(ctx context.Context, in *MakeMoveRequest, opts ...grpc.CallOption) (*MakeMoveResponse, error)
+	LeaveTable(ctx context.Context, in *LeaveTableRequest, opts ...grpc.CallOption) (*LeaveTableResponse, error)
+	StandUp(ctx context.Context, in *StandUpRequest, opts ...grpc.CallOption) (*StandUpResponse, error)
+	KickParticipant(ctx context.Context, in *KickParticipantRequest, opts ...grpc.CallOption) (*KickParticipantResponse, error)
+	LockSeat(ctx context.Context, in *LockSeatRequest, opts ...grpc.CallOption) (*LockSeatResponse, error)
+	CloseTable(ctx context.Context, in *CloseTableRequest, opts ...grpc.CallOption) (*CloseTableResponse, error)
+	MoveToSeat(ctx context.Context, in *MoveToSeatRequest, opts ...grpc.CallOption) (*MoveToSeatResponse, error)
+	RequestSeatSwap(ctx context.Context, in *RequestSeatSwapRequest, opts ...grpc.CallOption) (*RequestSeatSwapResponse, error)
+	AcceptSeatSwap(ctx context.Context, in *AcceptSeatSwapRequest, opts ...grpc.CallOption) (*AcceptSeatSwapResponse, error)
+	Rematch(ctx context.Context, in *RematchRequest, opts ...grpc.CallOption) (*RematchResponse, error)
+	GetTableEvents(ctx context.Context, in *GetTableEventsRequest, opts ...grpc.CallOption) (*GetTableEventsResponse, error)
+}
+
+type gameServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGameServiceClient(cc *grpc.ClientConn) GameServiceClient {
+	return &gameServiceClient{cc}
+}
+
+func (c *gameServiceClient) OpenSession(ctx context.Context, in *OpenSessionRequest, opts ...grpc.CallOption) (*OpenSessionResponse, error) {
+	out := new(OpenSessionResponse)
+	err := c.cc.Invoke(ctx, "/GameService/OpenSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) CloseSession(ctx context.Context, in *CloseSessionRequest, opts ...grpc.CallOption) (*CloseSessionResponse, error) {
+	out := new(CloseSessionResponse)
+	err := c.cc.Invoke(ctx, "/GameService/CloseSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, "/GameService/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) TerminateSession(ctx context.Context, in *TerminateSessionRequest, opts ...grpc.CallOption) (*TerminateSessionResponse, error) {
+	out := new(TerminateSessionResponse)
+	err := c.cc.Invoke(ctx, "/GameService/TerminateSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) TakeSeatControl(ctx context.Context, in *TakeSeatControlRequest, opts ...grpc.CallOption) (*TakeSeatControlResponse, error) {
+	out := new(TakeSeatControlResponse)
+	err := c.cc.Invoke(ctx, "/GameService/TakeSeatControl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	out := new(ChangePasswordResponse)
+	err := c.cc.Invoke(ctx, "/GameService/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error) {
+	out := new(UpdateProfileResponse)
+	err := c.cc.Invoke(ctx, "/GameService/UpdateProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) SetAvatar(ctx context.Context, in *SetAvatarRequest, opts ...grpc.CallOption) (*SetAvatarResponse, error) {
+	out := new(SetAvatarResponse)
+	err := c.cc.Invoke(ctx, "/GameService/SetAvatar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetPlayerProfile(ctx context.Context, in *GetPlayerProfileRequest, opts ...grpc.CallOption) (*GetPlayerProfileResponse, error) {
+	out := new(GetPlayerProfileResponse)
+	err := c.cc.Invoke(ctx, "/GameService/GetPlayerProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetFriends(ctx context.Context, in *GetFriendsRequest, opts ...grpc.CallOption) (*GetFriendsResponse, error) {
+	out := new(GetFriendsResponse)
+	err := c.cc.Invoke(ctx, "/GameService/GetFriends", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) AddFriend(ctx context.Context, in *AddFriendRequest, opts ...grpc.CallOption) (*AddFriendResponse, error) {
+	out := new(AddFriendResponse)
+	err := c.cc.Invoke(ctx, "/GameService/AddFriend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) AcceptFriend(ctx context.Context, in *AcceptFriendRequest, opts ...grpc.CallOption) (*AcceptFriendResponse, error) {
+	out := new(AcceptFriendResponse)
+	err := c.cc.Invoke(ctx, "/GameService/AcceptFriend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) RemoveFriend(ctx context.Context, in *RemoveFriendRequest, opts ...grpc.CallOption) (*RemoveFriendResponse, error) {
+	out := new(RemoveFriendResponse)
+	err := c.cc.Invoke(ctx, "/GameService/RemoveFriend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) BlockPlayer(ctx context.Context, in *BlockPlayerRequest, opts ...grpc.CallOption) (*BlockPlayerResponse, error) {
+	out := new(BlockPlayerResponse)
+	err := c.cc.Invoke(ctx, "/GameService/BlockPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) InviteToTable(ctx context.Context, in *InviteToTableRequest, opts ...grpc.CallOption) (*InviteToTableResponse, error) {
+	out := new(InviteToTableResponse)
+	err := c.cc.Invoke(ctx, "/GameService/InviteToTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*AcceptInvitationResponse, error) {
+	out := new(AcceptInvitationResponse)
+	err := c.cc.Invoke(ctx, "/GameService/AcceptInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error) {
+	out := new(GetLeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/GameService/GetLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetMatchHistory(ctx context.Context, in *GetMatchHistoryRequest, opts ...grpc.CallOption) (*GetMatchHistoryResponse, error) {
+	out := new(GetMatchHistoryResponse)
+	err := c.cc.Invoke(ctx, "/GameService/GetMatchHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetPlayerStats(ctx context.Context, in *GetPlayerStatsRequest, opts ...grpc.CallOption) (*GetPlayerStatsResponse, error) {
+	out := new(GetPlayerStatsResponse)
+	err := c.cc.Invoke(ctx, "/GameService/GetPlayerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetAchievements(ctx context.Context, in *GetAchievementsRequest, opts ...grpc.CallOption) (*GetAchievementsResponse, error) {
+	out := new(GetAchievementsResponse)
+	err := c.cc.Invoke(ctx, "/GameService/GetAchievements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ClaimDailyReward(ctx context.Context, in *ClaimDailyRewardRequest, opts ...grpc.CallOption) (*ClaimDailyRewardResponse, error) {
+	out := new(ClaimDailyRewardResponse)
+	err := c.cc.Invoke(ctx, "/GameService/ClaimDailyReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetQuests(ctx context.Context, in *GetQuestsRequest, opts ...grpc.CallOption) (*GetQuestsResponse, error) {
+	out := new(GetQuestsResponse)
+	err := c.cc.Invoke(ctx, "/GameService/GetQuests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetTournaments(ctx context.Context, in *GetTournamentsRequest, opts ...grpc.CallOption) (*GetTournamentsResponse, error) {
+	out := new(GetTournamentsResponse)
+	err := c.cc.Invoke(ctx, "/GameService/GetTournaments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetTournament(ctx context.Context, in *GetTournamentRequest, opts ...grpc.CallOption) (*GetTournamentResponse, error) {
+	out := new(GetTournamentResponse)
+	err := c.cc.Invoke(ctx, "/GameService/GetTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) RegisterTournament(ctx context.Context, in *RegisterTournamentRequest, opts ...grpc.CallOption) (*RegisterTournamentResponse, error) {
+	out := new(RegisterTournamentResponse)
+	err := c.cc.Invoke(ctx, "/GameService/RegisterTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) UnregisterTournament(ctx context.Context, in *UnregisterTournamentRequest, opts ...grpc.CallOption) (*UnregisterTournamentResponse, error) {
+	out := new(UnregisterTournamentResponse)
+	err := c.cc.Invoke(ctx, "/GameService/UnregisterTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetNotifications(ctx context.Context, in *GetNotificationsRequest, opts ...grpc.CallOption) (*GetNotificationsResponse, error) {
+	out := new(GetNotificationsResponse)
+	err := c.cc.Invoke(ctx, "/GameService/GetNotifications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*MarkReadResponse, error) {
+	out := new(MarkReadResponse)
+	err := c.cc.Invoke(ctx, "/GameService/MarkRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
 func (c *gameServiceClient) GetProducts(ctx context.Context, in *GetProductsRequest, opts ...grpc.CallOption) (*GetProductsResponse, error) {
@@ -1513,287 +6669,1189 @@ func (c *gameServiceClient) GetProducts(ctx context.Context, in *GetProductsRequ
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *gameServiceClient) PurchaseProduct(ctx context.Context, in *PurchaseProductRequest, opts ...grpc.CallOption) (*PurchaseProductResponse, error) {
+	out := new(PurchaseProductResponse)
+	err := c.cc.Invoke(ctx, "/GameService/PurchaseProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) CreateCheckout(ctx context.Context, in *CreateCheckoutRequest, opts ...grpc.CallOption) (*CreateCheckoutResponse, error) {
+	out := new(CreateCheckoutResponse)
+	err := c.cc.Invoke(ctx, "/GameService/CreateCheckout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) VerifyReceipt(ctx context.Context, in *VerifyReceiptRequest, opts ...grpc.CallOption) (*VerifyReceiptResponse, error) {
+	out := new(VerifyReceiptResponse)
+	err := c.cc.Invoke(ctx, "/GameService/VerifyReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetInventory(ctx context.Context, in *GetInventoryRequest, opts ...grpc.CallOption) (*GetInventoryResponse, error) {
+	out := new(GetInventoryResponse)
+	err := c.cc.Invoke(ctx, "/GameService/GetInventory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) CreateTable(ctx context.Context, in *CreateTableRequest, opts ...grpc.CallOption) (*CreateTableResponse, error) {
+	out := new(CreateTableResponse)
+	err := c.cc.Invoke(ctx, "/GameService/CreateTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetOpenTables(ctx context.Context, in *GetOpenTablesRequest, opts ...grpc.CallOption) (*GetOpenTablesResponse, error) {
+	out := new(GetOpenTablesResponse)
+	err := c.cc.Invoke(ctx, "/GameService/GetOpenTables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) SubscribeLobby(ctx context.Context, in *SubscribeLobbyRequest, opts ...grpc.CallOption) (*SubscribeLobbyResponse, error) {
+	out := new(SubscribeLobbyResponse)
+	err := c.cc.Invoke(ctx, "/GameService/SubscribeLobby", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) UnsubscribeLobby(ctx context.Context, in *UnsubscribeLobbyRequest, opts ...grpc.CallOption) (*UnsubscribeLobbyResponse, error) {
+	out := new(UnsubscribeLobbyResponse)
+	err := c.cc.Invoke(ctx, "/GameService/UnsubscribeLobby", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) JoinTable(ctx context.Context, in *JoinTableRequest, opts ...grpc.CallOption) (*JoinTableResponse, error) {
+	out := new(JoinTableResponse)
+	err := c.cc.Invoke(ctx, "/GameService/JoinTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) BecomeParticipant(ctx context.Context, in *BecomeParticipantRequest, opts ...grpc.CallOption) (*BecomeParticipantResponse, error) {
+	out := new(BecomeParticipantResponse)
+	err := c.cc.Invoke(ctx, "/GameService/BecomeParticipant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) Ready(ctx context.Context, in *ReadyRequest, opts ...grpc.CallOption) (*ReadyResponse, error) {
+	out := new(ReadyResponse)
+	err := c.cc.Invoke(ctx, "/GameService/Ready", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) MakeMove(ctx context.Context, in *MakeMoveRequest, opts ...grpc.CallOption) (*MakeMoveResponse, error) {
+	out := new(MakeMoveResponse)
+	err := c.cc.Invoke(ctx, "/GameService/MakeMove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) LeaveTable(ctx context.Context, in *LeaveTableRequest, opts ...grpc.CallOption) (*LeaveTableResponse, error) {
+	out := new(LeaveTableResponse)
+	err := c.cc.Invoke(ctx, "/GameService/LeaveTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) StandUp(ctx context.Context, in *StandUpRequest, opts ...grpc.CallOption) (*StandUpResponse, error) {
+	out := new(StandUpResponse)
+	err := c.cc.Invoke(ctx, "/GameService/StandUp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) KickParticipant(ctx context.Context, in *KickParticipantRequest, opts ...grpc.CallOption) (*KickParticipantResponse, error) {
+	out := new(KickParticipantResponse)
+	err := c.cc.Invoke(ctx, "/GameService/KickParticipant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) LockSeat(ctx context.Context, in *LockSeatRequest, opts ...grpc.CallOption) (*LockSeatResponse, error) {
+	out := new(LockSeatResponse)
+	err := c.cc.Invoke(ctx, "/GameService/LockSeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) CloseTable(ctx context.Context, in *CloseTableRequest, opts ...grpc.CallOption) (*CloseTableResponse, error) {
+	out := new(CloseTableResponse)
+	err := c.cc.Invoke(ctx, "/GameService/CloseTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) MoveToSeat(ctx context.Context, in *MoveToSeatRequest, opts ...grpc.CallOption) (*MoveToSeatResponse, error) {
+	out := new(MoveToSeatResponse)
+	err := c.cc.Invoke(ctx, "/GameService/MoveToSeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) RequestSeatSwap(ctx context.Context, in *RequestSeatSwapRequest, opts ...grpc.CallOption) (*RequestSeatSwapResponse, error) {
+	out := new(RequestSeatSwapResponse)
+	err := c.cc.Invoke(ctx, "/GameService/RequestSeatSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) AcceptSeatSwap(ctx context.Context, in *AcceptSeatSwapRequest, opts ...grpc.CallOption) (*AcceptSeatSwapResponse, error) {
+	out := new(AcceptSeatSwapResponse)
+	err := c.cc.Invoke(ctx, "/GameService/AcceptSeatSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) Rematch(ctx context.Context, in *RematchRequest, opts ...grpc.CallOption) (*RematchResponse, error) {
+	out := new(RematchResponse)
+	err := c.cc.Invoke(ctx, "/GameService/Rematch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetTableEvents(ctx context.Context, in *GetTableEventsRequest, opts ...grpc.CallOption) (*GetTableEventsResponse, error) {
+	out := new(GetTableEventsResponse)
+	err := c.cc.Invoke(ctx, "/GameService/GetTableEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GameServiceServer is the server API for GameService service.
+type GameServiceServer interface {
+	OpenSession(context.Context, *OpenSessionRequest) (*OpenSessionResponse, error)
+	CloseSession(context.Context, *CloseSessionRequest) (*CloseSessionResponse, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	TerminateSession(context.Context, *TerminateSessionRequest) (*TerminateSessionResponse, error)
+	TakeSeatControl(context.Context, *TakeSeatControlRequest) (*TakeSeatControlResponse, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	// Profile
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	SetAvatar(context.Context, *SetAvatarRequest) (*SetAvatarResponse, error)
+	GetPlayerProfile(context.Context, *GetPlayerProfileRequest) (*GetPlayerProfileResponse, error)
+	// Social
+	GetFriends(context.Context, *GetFriendsRequest) (*GetFriendsResponse, error)
+	AddFriend(context.Context, *AddFriendRequest) (*AddFriendResponse, error)
+	AcceptFriend(context.Context, *AcceptFriendRequest) (*AcceptFriendResponse, error)
+	RemoveFriend(context.Context, *RemoveFriendRequest) (*RemoveFriendResponse, error)
+	BlockPlayer(context.Context, *BlockPlayerRequest) (*BlockPlayerResponse, error)
+	InviteToTable(context.Context, *InviteToTableRequest) (*InviteToTableResponse, error)
+	AcceptInvitation(context.Context, *AcceptInvitationRequest) (*AcceptInvitationResponse, error)
+	// Leaderboards
+	GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error)
+	// Statistics
+	GetMatchHistory(context.Context, *GetMatchHistoryRequest) (*GetMatchHistoryResponse, error)
+	GetPlayerStats(context.Context, *GetPlayerStatsRequest) (*GetPlayerStatsResponse, error)
+	GetAchievements(context.Context, *GetAchievementsRequest) (*GetAchievementsResponse, error)
+	// Rewards
+	ClaimDailyReward(context.Context, *ClaimDailyRewardRequest) (*ClaimDailyRewardResponse, error)
+	GetQuests(context.Context, *GetQuestsRequest) (*GetQuestsResponse, error)
+	// Tournaments
+	GetTournaments(context.Context, *GetTournamentsRequest) (*GetTournamentsResponse, error)
+	GetTournament(context.Context, *GetTournamentRequest) (*GetTournamentResponse, error)
+	RegisterTournament(context.Context, *RegisterTournamentRequest) (*RegisterTournamentResponse, error)
+	UnregisterTournament(context.Context, *UnregisterTournamentRequest) (*UnregisterTournamentResponse, error)
+	// Notifications
+	GetNotifications(context.Context, *GetNotificationsRequest) (*GetNotificationsResponse, error)
+	MarkRead(context.Context, *MarkReadRequest) (*MarkReadResponse, error)
+	// Shop
+	GetProducts(context.Context, *GetProductsRequest) (*GetProductsResponse, error)
+	PurchaseProduct(context.Context, *PurchaseProductRequest) (*PurchaseProductResponse, error)
+	CreateCheckout(context.Context, *CreateCheckoutRequest) (*CreateCheckoutResponse, error)
+	VerifyReceipt(context.Context, *VerifyReceiptRequest) (*VerifyReceiptResponse, error)
+	GetInventory(context.Context, *GetInventoryRequest) (*GetInventoryResponse, error)
+	// Table requests
+	CreateTable(context.Context, *CreateTableRequest) (*CreateTableResponse, error)
+	GetOpenTables(context.Context, *GetOpenTablesRequest) (*GetOpenTablesResponse, error)
+	SubscribeLobby(context.Context, *SubscribeLobbyRequest) (*SubscribeLobbyResponse, error)
+	UnsubscribeLobby(context.Context, *UnsubscribeLobbyRequest) (*UnsubscribeLobbyResponse, error)
+	JoinTable(context.Context, *JoinTableRequest) (*JoinTableResponse, error)
+	BecomeParticipant(context.Context, *BecomeParticipantRequest) (*BecomeParticipantResponse, error)
+	Ready(context.Context, *ReadyRequest) (*ReadyResponse, error)
+	MakeMove(context.Context, *MakeMoveRequest) (*MakeMoveResponse, error)
+	LeaveTable(context.Context, *LeaveTableRequest) (*LeaveTableResponse, error)
+	StandUp(context.Context, *StandUpRequest) (*StandUpResponse, error)
+	KickParticipant(context.Context, *KickParticipantRequest) (*KickParticipantResponse, error)
+	LockSeat(context.Context, *LockSeatRequest) (*LockSeatResponse, error)
+	CloseTable(context.Context, *CloseTableRequest) (*CloseTableResponse, error)
+	MoveToSeat(context.Context, *MoveToSeatRequest) (*MoveToSeatResponse, error)
+	RequestSeatSwap(context.Context, *RequestSeatSwapRequest) (*RequestSeatSwapResponse, error)
+	AcceptSeatSwap(context.Context, *AcceptSeatSwapRequest) (*AcceptSeatSwapResponse, error)
+	Rematch(context.Context, *RematchRequest) (*RematchResponse, error)
+	GetTableEvents(context.Context, *GetTableEventsRequest) (*GetTableEventsResponse, error)
+}
+
+func RegisterGameServiceServer(s *grpc.Server, srv GameServiceServer) {
+	s.RegisterService(&_GameService_serviceDesc, srv)
+}
+
+func _GameService_OpenSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).OpenSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/OpenSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).OpenSession(ctx, req.(*OpenSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_CloseSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).CloseSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/CloseSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).CloseSession(ctx, req.(*CloseSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_TerminateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TerminateSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).TerminateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/TerminateSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).TerminateSession(ctx, req.(*TerminateSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_TakeSeatControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TakeSeatControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).TakeSeatControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/TakeSeatControl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).TakeSeatControl(ctx, req.(*TakeSeatControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/UpdateProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_SetAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAvatarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).SetAvatar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/SetAvatar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).SetAvatar(ctx, req.(*SetAvatarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetPlayerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetPlayerProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/GetPlayerProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetPlayerProfile(ctx, req.(*GetPlayerProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/GetFriends",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetFriends(ctx, req.(*GetFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_AddFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).AddFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/AddFriend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).AddFriend(ctx, req.(*AddFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_AcceptFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).AcceptFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/AcceptFriend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).AcceptFriend(ctx, req.(*AcceptFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_RemoveFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).RemoveFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/RemoveFriend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).RemoveFriend(ctx, req.(*RemoveFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_BlockPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).BlockPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/BlockPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).BlockPlayer(ctx, req.(*BlockPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_InviteToTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteToTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).InviteToTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/InviteToTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).InviteToTable(ctx, req.(*InviteToTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_AcceptInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).AcceptInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/AcceptInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).AcceptInvitation(ctx, req.(*AcceptInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/GetLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetLeaderboard(ctx, req.(*GetLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetMatchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMatchHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetMatchHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/GetMatchHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetMatchHistory(ctx, req.(*GetMatchHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *gameServiceClient) PurchaseProduct(ctx context.Context, in *PurchaseProductRequest, opts ...grpc.CallOption) (*PurchaseProductResponse, error) {
-	out := new(PurchaseProductResponse)
-	err := c.cc.Invoke(ctx, "/GameService/PurchaseProduct", in, out, opts...)
-	if err != nil {
+func _GameService_GetPlayerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetPlayerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/GetPlayerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetPlayerStats(ctx, req.(*GetPlayerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetAchievements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAchievementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetAchievements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/GetAchievements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetAchievements(ctx, req.(*GetAchievementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ClaimDailyReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimDailyRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ClaimDailyReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/ClaimDailyReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ClaimDailyReward(ctx, req.(*ClaimDailyRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetQuests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetQuests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/GetQuests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetQuests(ctx, req.(*GetQuestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetTournaments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTournamentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetTournaments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/GetTournaments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetTournaments(ctx, req.(*GetTournamentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/GetTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetTournament(ctx, req.(*GetTournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_RegisterTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterTournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).RegisterTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/RegisterTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).RegisterTournament(ctx, req.(*RegisterTournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_UnregisterTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterTournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).UnregisterTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/UnregisterTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).UnregisterTournament(ctx, req.(*UnregisterTournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/GetNotifications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetNotifications(ctx, req.(*GetNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_MarkRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).MarkRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/MarkRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).MarkRead(ctx, req.(*MarkReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/GetProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetProducts(ctx, req.(*GetProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_PurchaseProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).PurchaseProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/PurchaseProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).PurchaseProduct(ctx, req.(*PurchaseProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_CreateCheckout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCheckoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).CreateCheckout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/CreateCheckout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).CreateCheckout(ctx, req.(*CreateCheckoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_VerifyReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).VerifyReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/VerifyReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).VerifyReceipt(ctx, req.(*VerifyReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/GetInventory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetInventory(ctx, req.(*GetInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_CreateTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).CreateTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/CreateTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).CreateTable(ctx, req.(*CreateTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetOpenTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOpenTablesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetOpenTables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/GetOpenTables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetOpenTables(ctx, req.(*GetOpenTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *gameServiceClient) CreateTable(ctx context.Context, in *CreateTableRequest, opts ...grpc.CallOption) (*CreateTableResponse, error) {
-	out := new(CreateTableResponse)
-	err := c.cc.Invoke(ctx, "/GameService/CreateTable", in, out, opts...)
-	if err != nil {
+func _GameService_SubscribeLobby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeLobbyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *gameServiceClient) GetOpenTables(ctx context.Context, in *GetOpenTablesRequest, opts ...grpc.CallOption) (*GetOpenTablesResponse, error) {
-	out := new(GetOpenTablesResponse)
-	err := c.cc.Invoke(ctx, "/GameService/GetOpenTables", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(GameServiceServer).SubscribeLobby(ctx, in)
 	}
-	return out, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/SubscribeLobby",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).SubscribeLobby(ctx, req.(*SubscribeLobbyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *gameServiceClient) JoinTable(ctx context.Context, in *JoinTableRequest, opts ...grpc.CallOption) (*JoinTableResponse, error) {
-	out := new(JoinTableResponse)
-	err := c.cc.Invoke(ctx, "/GameService/JoinTable", in, out, opts...)
-	if err != nil {
+func _GameService_UnsubscribeLobby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeLobbyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GameServiceServer).UnsubscribeLobby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/UnsubscribeLobby",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).UnsubscribeLobby(ctx, req.(*UnsubscribeLobbyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *gameServiceClient) BecomeParticipant(ctx context.Context, in *BecomeParticipantRequest, opts ...grpc.CallOption) (*BecomeParticipantResponse, error) {
-	out := new(BecomeParticipantResponse)
-	err := c.cc.Invoke(ctx, "/GameService/BecomeParticipant", in, out, opts...)
-	if err != nil {
+func _GameService_JoinTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinTableRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GameServiceServer).JoinTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/JoinTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).JoinTable(ctx, req.(*JoinTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *gameServiceClient) Ready(ctx context.Context, in *ReadyRequest, opts ...grpc.CallOption) (*ReadyResponse, error) {
-	out := new(ReadyResponse)
-	err := c.cc.Invoke(ctx, "/GameService/Ready", in, out, opts...)
-	if err != nil {
+func _GameService_BecomeParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BecomeParticipantRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GameServiceServer).BecomeParticipant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/BecomeParticipant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).BecomeParticipant(ctx, req.(*BecomeParticipantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *gameServiceClient) MakeMove(ctx context.Context, in *MakeMoveRequest, opts ...grpc.CallOption) (*MakeMoveResponse, error) {
-	out := new(MakeMoveResponse)
-	err := c.cc.Invoke(ctx, "/GameService/MakeMove", in, out, opts...)
-	if err != nil {
+func _GameService_Ready_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// GameServiceServer is the server API for GameService service.
-type GameServiceServer interface {
-	OpenSession(context.Context, *OpenSessionRequest) (*OpenSessionResponse, error)
-	CloseSession(context.Context, *CloseSessionRequest) (*CloseSessionResponse, error)
-	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
-	// Shop
-	GetProducts(context.Context, *GetProductsRequest) (*GetProductsResponse, error)
-	PurchaseProduct(context.Context, *PurchaseProductRequest) (*PurchaseProductResponse, error)
-	// Table requests
-	CreateTable(context.Context, *CreateTableRequest) (*CreateTableResponse, error)
-	GetOpenTables(context.Context, *GetOpenTablesRequest) (*GetOpenTablesResponse, error)
-	JoinTable(context.Context, *JoinTableRequest) (*JoinTableResponse, error)
-	BecomeParticipant(context.Context, *BecomeParticipantRequest) (*BecomeParticipantResponse, error)
-	Ready(context.Context, *ReadyRequest) (*ReadyResponse, error)
-	MakeMove(context.Context, *MakeMoveRequest) (*MakeMoveResponse, error)
-}
-
-func RegisterGameServiceServer(s *grpc.Server, srv GameServiceServer) {
-	s.RegisterService(&_GameService_serviceDesc, srv)
+	if interceptor == nil {
+		return srv.(GameServiceServer).Ready(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GameService/Ready",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).Ready(ctx, req.(*ReadyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GameService_OpenSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OpenSessionRequest)
+func _GameService_MakeMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MakeMoveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameServiceServer).OpenSession(ctx, in)
+		return srv.(GameServiceServer).MakeMove(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/GameService/OpenSession",
+		FullMethod: "/GameService/MakeMove",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameServiceServer).OpenSession(ctx, req.(*OpenSessionRequest))
+		return srv.(GameServiceServer).MakeMove(ctx, req.(*MakeMoveRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GameService_CloseSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CloseSessionRequest)
+func _GameService_LeaveTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveTableRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameServiceServer).CloseSession(ctx, in)
+		return srv.(GameServiceServer).LeaveTable(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/GameService/CloseSession",
+		FullMethod: "/GameService/LeaveTable",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameServiceServer).CloseSession(ctx, req.(*CloseSessionRequest))
+		return srv.(GameServiceServer).LeaveTable(ctx, req.(*LeaveTableRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GameService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangePasswordRequest)
+func _GameService_StandUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StandUpRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameServiceServer).ChangePassword(ctx, in)
+		return srv.(GameServiceServer).StandUp(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/GameService/ChangePassword",
+		FullMethod: "/GameService/StandUp",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+		return srv.(GameServiceServer).StandUp(ctx, req.(*StandUpRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GameService_GetProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProductsRequest)
+func _GameService_KickParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickParticipantRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameServiceServer).GetProducts(ctx, in)
+		return srv.(GameServiceServer).KickParticipant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/GameService/GetProducts",
+		FullMethod: "/GameService/KickParticipant",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameServiceServer).GetProducts(ctx, req.(*GetProductsRequest))
+		return srv.(GameServiceServer).KickParticipant(ctx, req.(*KickParticipantRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GameService_PurchaseProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PurchaseProductRequest)
+func _GameService_LockSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockSeatRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameServiceServer).PurchaseProduct(ctx, in)
+		return srv.(GameServiceServer).LockSeat(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/GameService/PurchaseProduct",
+		FullMethod: "/GameService/LockSeat",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameServiceServer).PurchaseProduct(ctx, req.(*PurchaseProductRequest))
+		return srv.(GameServiceServer).LockSeat(ctx, req.(*LockSeatRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GameService_CreateTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTableRequest)
+func _GameService_CloseTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseTableRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameServiceServer).CreateTable(ctx, in)
+		return srv.(GameServiceServer).CloseTable(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/GameService/CreateTable",
+		FullMethod: "/GameService/CloseTable",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameServiceServer).CreateTable(ctx, req.(*CreateTableRequest))
+		return srv.(GameServiceServer).CloseTable(ctx, req.(*CloseTableRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GameService_GetOpenTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOpenTablesRequest)
+func _GameService_MoveToSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveToSeatRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameServiceServer).GetOpenTables(ctx, in)
+		return srv.(GameServiceServer).MoveToSeat(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/GameService/GetOpenTables",
+		FullMethod: "/GameService/MoveToSeat",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameServiceServer).GetOpenTables(ctx, req.(*GetOpenTablesRequest))
+		return srv.(GameServiceServer).MoveToSeat(ctx, req.(*MoveToSeatRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GameService_JoinTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JoinTableRequest)
+func _GameService_RequestSeatSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestSeatSwapRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameServiceServer).JoinTable(ctx, in)
+		return srv.(GameServiceServer).RequestSeatSwap(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/GameService/JoinTable",
+		FullMethod: "/GameService/RequestSeatSwap",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameServiceServer).JoinTable(ctx, req.(*JoinTableRequest))
+		return srv.(GameServiceServer).RequestSeatSwap(ctx, req.(*RequestSeatSwapRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GameService_BecomeParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BecomeParticipantRequest)
+func _GameService_AcceptSeatSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptSeatSwapRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameServiceServer).BecomeParticipant(ctx, in)
+		return srv.(GameServiceServer).AcceptSeatSwap(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/GameService/BecomeParticipant",
+		FullMethod: "/GameService/AcceptSeatSwap",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameServiceServer).BecomeParticipant(ctx, req.(*BecomeParticipantRequest))
+		return srv.(GameServiceServer).AcceptSeatSwap(ctx, req.(*AcceptSeatSwapRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GameService_Ready_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadyRequest)
+func _GameService_Rematch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RematchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameServiceServer).Ready(ctx, in)
+		return srv.(GameServiceServer).Rematch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/GameService/Ready",
+		FullMethod: "/GameService/Rematch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameServiceServer).Ready(ctx, req.(*ReadyRequest))
+		return srv.(GameServiceServer).Rematch(ctx, req.(*RematchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GameService_MakeMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MakeMoveRequest)
+func _GameService_GetTableEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTableEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GameServiceServer).MakeMove(ctx, in)
+		return srv.(GameServiceServer).GetTableEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/GameService/MakeMove",
+		FullMethod: "/GameService/GetTableEvents",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GameServiceServer).MakeMove(ctx, req.(*MakeMoveRequest))
+		return srv.(GameServiceServer).GetTableEvents(ctx, req.(*GetTableEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1810,10 +7868,110 @@ var _GameService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CloseSession",
 			Handler:    _GameService_CloseSession_Handler,
 		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _GameService_ListSessions_Handler,
+		},
+		{
+			MethodName: "TerminateSession",
+			Handler:    _GameService_TerminateSession_Handler,
+		},
+		{
+			MethodName: "TakeSeatControl",
+			Handler:    _GameService_TakeSeatControl_Handler,
+		},
 		{
 			MethodName: "ChangePassword",
 			Handler:    _GameService_ChangePassword_Handler,
 		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _GameService_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "SetAvatar",
+			Handler:    _GameService_SetAvatar_Handler,
+		},
+		{
+			MethodName: "GetPlayerProfile",
+			Handler:    _GameService_GetPlayerProfile_Handler,
+		},
+		{
+			MethodName: "GetFriends",
+			Handler:    _GameService_GetFriends_Handler,
+		},
+		{
+			MethodName: "AddFriend",
+			Handler:    _GameService_AddFriend_Handler,
+		},
+		{
+			MethodName: "AcceptFriend",
+			Handler:    _GameService_AcceptFriend_Handler,
+		},
+		{
+			MethodName: "RemoveFriend",
+			Handler:    _GameService_RemoveFriend_Handler,
+		},
+		{
+			MethodName: "BlockPlayer",
+			Handler:    _GameService_BlockPlayer_Handler,
+		},
+		{
+			MethodName: "InviteToTable",
+			Handler:    _GameService_InviteToTable_Handler,
+		},
+		{
+			MethodName: "AcceptInvitation",
+			Handler:    _GameService_AcceptInvitation_Handler,
+		},
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _GameService_GetLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetMatchHistory",
+			Handler:    _GameService_GetMatchHistory_Handler,
+		},
+		{
+			MethodName: "GetPlayerStats",
+			Handler:    _GameService_GetPlayerStats_Handler,
+		},
+		{
+			MethodName: "GetAchievements",
+			Handler:    _GameService_GetAchievements_Handler,
+		},
+		{
+			MethodName: "ClaimDailyReward",
+			Handler:    _GameService_ClaimDailyReward_Handler,
+		},
+		{
+			MethodName: "GetQuests",
+			Handler:    _GameService_GetQuests_Handler,
+		},
+		{
+			MethodName: "GetTournaments",
+			Handler:    _GameService_GetTournaments_Handler,
+		},
+		{
+			MethodName: "GetTournament",
+			Handler:    _GameService_GetTournament_Handler,
+		},
+		{
+			MethodName: "RegisterTournament",
+			Handler:    _GameService_RegisterTournament_Handler,
+		},
+		{
+			MethodName: "UnregisterTournament",
+			Handler:    _GameService_UnregisterTournament_Handler,
+		},
+		{
+			MethodName: "GetNotifications",
+			Handler:    _GameService_GetNotifications_Handler,
+		},
+		{
+			MethodName: "MarkRead",
+			Handler:    _GameService_MarkRead_Handler,
+		},
 		{
 			MethodName: "GetProducts",
 			Handler:    _GameService_GetProducts_Handler,
@@ -1822,6 +7980,18 @@ var _GameService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PurchaseProduct",
 			Handler:    _GameService_PurchaseProduct_Handler,
 		},
+		{
+			MethodName: "CreateCheckout",
+			Handler:    _GameService_CreateCheckout_Handler,
+		},
+		{
+			MethodName: "VerifyReceipt",
+			Handler:    _GameService_VerifyReceipt_Handler,
+		},
+		{
+			MethodName: "GetInventory",
+			Handler:    _GameService_GetInventory_Handler,
+		},
 		{
 			MethodName: "CreateTable",
 			Handler:    _GameService_CreateTable_Handler,
@@ -1830,6 +8000,14 @@ var _GameService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetOpenTables",
 			Handler:    _GameService_GetOpenTables_Handler,
 		},
+		{
+			MethodName: "SubscribeLobby",
+			Handler:    _GameService_SubscribeLobby_Handler,
+		},
+		{
+			MethodName: "UnsubscribeLobby",
+			Handler:    _GameService_UnsubscribeLobby_Handler,
+		},
 		{
 			MethodName: "JoinTable",
 			Handler:    _GameService_JoinTable_Handler,
@@ -1846,6 +8024,46 @@ var _GameService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MakeMove",
 			Handler:    _GameService_MakeMove_Handler,
 		},
+		{
+			MethodName: "LeaveTable",
+			Handler:    _GameService_LeaveTable_Handler,
+		},
+		{
+			MethodName: "StandUp",
+			Handler:    _GameService_StandUp_Handler,
+		},
+		{
+			MethodName: "KickParticipant",
+			Handler:    _GameService_KickParticipant_Handler,
+		},
+		{
+			MethodName: "LockSeat",
+			Handler:    _GameService_LockSeat_Handler,
+		},
+		{
+			MethodName: "CloseTable",
+			Handler:    _GameService_CloseTable_Handler,
+		},
+		{
+			MethodName: "MoveToSeat",
+			Handler:    _GameService_MoveToSeat_Handler,
+		},
+		{
+			MethodName: "RequestSeatSwap",
+			Handler:    _GameService_RequestSeatSwap_Handler,
+		},
+		{
+			MethodName: "AcceptSeatSwap",
+			Handler:    _GameService_AcceptSeatSwap_Handler,
+		},
+		{
+			MethodName: "Rematch",
+			Handler:    _GameService_Rematch_Handler,
+		},
+		{
+			MethodName: "GetTableEvents",
+			Handler:    _GameService_GetTableEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/game.proto",
@@ -0,0 +1,99 @@
+package game
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Handzo/gogame/gameservice/code"
+)
+
+const (
+	maxNameLength   = 32
+	minAge          = 5
+	maxAge          = 120
+	maxAvatarLength = 64
+)
+
+// Validate checks every profile field, empty values are allowed
+// and clear the field.
+func (p *Profile) Validate() error {
+	if !validName(p.FirstName) || !validName(p.LastName) {
+		return code.InvalidName
+	}
+
+	if p.Age != 0 && (p.Age < minAge || p.Age > maxAge) {
+		return code.InvalidAge
+	}
+
+	if p.Gender != "" && p.Gender != "male" && p.Gender != "female" {
+		return code.InvalidGender
+	}
+
+	// ISO 3166-1 alpha-2
+	if p.Country != "" && !validCode(p.Country, unicode.IsUpper) {
+		return code.InvalidCountry
+	}
+
+	// ISO 639-1
+	if p.Language != "" && !validCode(p.Language, unicode.IsLower) {
+		return code.InvalidLanguage
+	}
+
+	return nil
+}
+
+func (r *UpdateProfileRequest) Validate() error {
+	if r.Profile == nil {
+		return code.InvalidProfile
+	}
+
+	return r.Profile.Validate()
+}
+
+func (r *SetAvatarRequest) Validate() error {
+	if (r.AvatarId == "") == (len(r.Image) == 0) {
+		return code.InvalidAvatar
+	}
+
+	if len(r.AvatarId) > maxAvatarLength {
+		return code.InvalidAvatar
+	}
+
+	return nil
+}
+
+func (r *GetPlayerProfileRequest) Validate() error {
+	if r.PlayerId == "" {
+		return code.PlayerNotFound
+	}
+
+	return nil
+}
+
+func validName(name string) bool {
+	if utf8.RuneCountInString(name) > maxNameLength {
+		return false
+	}
+
+	for _, r := range name {
+		if !unicode.IsLetter(r) && r != ' ' && r != '-' && r != '\'' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func validCode(c string, class func(rune) bool) bool {
+	if len(c) != 2 {
+		return false
+	}
+
+	for _, r := range c {
+		if r > unicode.MaxASCII || !class(r) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+
+	basemodel "github.com/Handzo/gogame/common/model"
+	"github.com/go-pg/pg/v9"
+)
+
+type AchievementProgress struct {
+	basemodel.BaseModel
+	PlayerId      string `pg:",notnull,type:uuid,unique:player_achievement"`
+	Player        *Player
+	AchievementId string `pg:",notnull,unique:player_achievement"`
+	Progress      int    `pg:",notnull,use_zero"`
+	UnlockedAt    time.Time
+}
+
+func (AchievementProgress) Prepare(*pg.DB, bool) error {
+	return nil
+}
+
+func (AchievementProgress) Sync(*pg.DB, bool) error {
+	return nil
+}
+
+func (a AchievementProgress) IsUnlocked() bool {
+	return !a.UnlockedAt.IsZero()
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	basemodel "github.com/Handzo/gogame/common/model"
+	"github.com/go-pg/pg/v9"
+)
+
+// AdminAction is an entry of audit log written for every call of admin
+// service. Admin is the user of authservice, player and table are the
+// ones the call was made for. Admin of rejected call is not known
+// unless a user without admin role made it.
+type AdminAction struct {
+	basemodel.BaseModel
+	AdminId   string `pg:",type:uuid"`
+	AdminName string
+	Method    string `pg:",notnull"`
+	PlayerId  string `pg:",type:uuid"`
+	TableId   string `pg:",type:uuid"`
+	Reason    string
+	Request   string `pg:",type:jsonb"`
+	Error     string
+	Remote    string
+}
+
+func (AdminAction) Prepare(*pg.DB, bool) error {
+	return nil
+}
+
+func (AdminAction) Sync(*pg.DB, bool) error {
+	return nil
+}
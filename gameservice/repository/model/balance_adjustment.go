@@ -0,0 +1,25 @@
+package model
+
+import (
+	basemodel "github.com/Handzo/gogame/common/model"
+	"github.com/go-pg/pg/v9"
+)
+
+// BalanceAdjustment is a manual change of player's balance made by admin.
+type BalanceAdjustment struct {
+	basemodel.BaseModel
+	PlayerId string `pg:",notnull,type:uuid"`
+	Player   *Player
+	AdminId  string   `pg:",notnull,type:uuid"`
+	Currency Currency `pg:",notnull,type:currency"`
+	Amount   int64    `pg:",notnull,use_zero"`
+	Reason   string   `pg:",notnull"`
+}
+
+func (BalanceAdjustment) Prepare(*pg.DB, bool) error {
+	return nil
+}
+
+func (BalanceAdjustment) Sync(*pg.DB, bool) error {
+	return nil
+}
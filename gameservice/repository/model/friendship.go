@@ -0,0 +1,38 @@
+package model
+
+import (
+	basemodel "github.com/Handzo/gogame/common/model"
+	"github.com/go-pg/pg/v9"
+)
+
+type FriendshipState string
+
+var (
+	REQUESTED FriendshipState = "requested"
+	ACCEPTED  FriendshipState = "accepted"
+	BLOCKED   FriendshipState = "blocked"
+)
+
+// Friendship is a directed relation from player to friend. Accepted
+// friendship is stored as a pair of rows, one for each direction.
+type Friendship struct {
+	basemodel.BaseModel
+	PlayerId string `pg:",notnull,type:uuid,unique:player_friend"`
+	Player   *Player
+	FriendId string          `pg:",notnull,type:uuid,unique:player_friend"`
+	Friend   *Player         `pg:",fk:friend_id"`
+	State    FriendshipState `pg:",notnull,type:friendship_state"`
+}
+
+func (Friendship) Prepare(db *pg.DB, force bool) error {
+	return basemodel.CreateEnum(
+		db, force, "friendship_state",
+		string(REQUESTED),
+		string(ACCEPTED),
+		string(BLOCKED),
+	)
+}
+
+func (Friendship) Sync(*pg.DB, bool) error {
+	return nil
+}
@@ -5,6 +5,12 @@ import (
 	"github.com/go-pg/pg/v9"
 )
 
+const (
+	NUTS_ITEM string = "Nuts"
+	GOLD_ITEM string = "Gold"
+	VIP_ITEM  string = "VIP"
+)
+
 type GoodItem struct {
 	basemodel.BaseModel
 	Title       string `pg:",notnull"`
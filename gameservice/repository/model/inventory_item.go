@@ -0,0 +1,36 @@
+package model
+
+import (
+	"time"
+
+	basemodel "github.com/Handzo/gogame/common/model"
+	"github.com/go-pg/pg/v9"
+)
+
+// ItemDurations is time granted for one unit of good item.
+// Items without duration never expire.
+var ItemDurations = map[string]time.Duration{
+	VIP_ITEM: 30 * 24 * time.Hour,
+}
+
+type InventoryItem struct {
+	basemodel.BaseModel
+	PlayerId   string `pg:",notnull,type:uuid,unique:player_item"`
+	Player     *Player
+	GoodItemId string `pg:",notnull,type:uuid,unique:player_item"`
+	GoodItem   *GoodItem
+	Quantity   uint32 `pg:",notnull,use_zero"`
+	ExpiresAt  time.Time
+}
+
+func (InventoryItem) Prepare(*pg.DB, bool) error {
+	return nil
+}
+
+func (InventoryItem) Sync(*pg.DB, bool) error {
+	return nil
+}
+
+func (i InventoryItem) IsActive() bool {
+	return i.Quantity > 0 && (i.ExpiresAt.IsZero() || i.ExpiresAt.After(time.Now()))
+}
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"time"
+
+	basemodel "github.com/Handzo/gogame/common/model"
+	"github.com/go-pg/pg/v9"
+)
+
+type Invitation struct {
+	basemodel.BaseModel
+	TableId    string `pg:",notnull,type:uuid"`
+	Table      *Table
+	FromId     string    `pg:",notnull,type:uuid"`
+	From       *Player   `pg:",fk:from_id"`
+	ToId       string    `pg:",notnull,type:uuid"`
+	To         *Player   `pg:",fk:to_id"`
+	ExpiresAt  time.Time `pg:",notnull"`
+	AcceptedAt time.Time
+}
+
+func (Invitation) Prepare(*pg.DB, bool) error {
+	return nil
+}
+
+func (Invitation) Sync(*pg.DB, bool) error {
+	return nil
+}
+
+func (i Invitation) IsExpired() bool {
+	return time.Now().After(i.ExpiresAt)
+}
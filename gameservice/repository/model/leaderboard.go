@@ -0,0 +1,13 @@
+package model
+
+// LeaderboardRow holds player's results at tables with one currency
+// aggregated over leaderboard window.
+type LeaderboardRow struct {
+	PlayerId     string
+	Country      string
+	Currency     Currency
+	Rating       int
+	RatingChange int
+	Wins         int
+	Net          int64
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	basemodel "github.com/Handzo/gogame/common/model"
+	"github.com/go-pg/pg/v9"
+)
+
+// Notification is an event kept in player's inbox because it could not
+// be delivered while the player was offline.
+type Notification struct {
+	basemodel.BaseModel
+	PlayerId string  `pg:",notnull,type:uuid"`
+	Player   *Player `pg:",fk:player_id"`
+	Event    string  `pg:",notnull"`
+	Payload  string  `pg:",type:jsonb"`
+	ReadAt   time.Time
+}
+
+func (Notification) Prepare(*pg.DB, bool) error {
+	return nil
+}
+
+func (Notification) Sync(*pg.DB, bool) error {
+	return nil
+}
+
+func (n Notification) IsRead() bool {
+	return !n.ReadAt.IsZero()
+}
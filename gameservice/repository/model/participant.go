@@ -12,8 +12,31 @@ var (
 	BUSY       ParticipantState = "busy"
 	READY      ParticipantState = "ready"
 	DISCONNECT ParticipantState = "disconnect"
+	// seat closed by table creator
+	LOCKED ParticipantState = "locked"
+	// player left started game
+	LEFT ParticipantState = "left"
 )
 
+var transitions = map[ParticipantState][]ParticipantState{
+	FREE:       {BUSY, LOCKED},
+	BUSY:       {READY, FREE, DISCONNECT, LEFT},
+	READY:      {BUSY, FREE, DISCONNECT, LEFT},
+	DISCONNECT: {READY, FREE, LEFT},
+	LOCKED:     {FREE},
+}
+
+// CanBecome reports whether participant may change state from s to other.
+func (s ParticipantState) CanBecome(other ParticipantState) bool {
+	for _, t := range transitions[s] {
+		if t == other {
+			return true
+		}
+	}
+
+	return false
+}
+
 type Participant struct {
 	basemodel.BaseModel
 	TableId  string `pg:",notnull,type:uuid"`
@@ -22,6 +45,9 @@ type Participant struct {
 	Player   *Player
 	Order    int              `pg:",notnull,use_zero"`
 	State    ParticipantState `pg:",notnull,type:participant_state"`
+	// game result of the player, set when table is finished
+	Won          bool `pg:",notnull,use_zero"`
+	RatingChange int  `pg:",notnull,use_zero"`
 }
 
 func (Participant) Prepare(db *pg.DB, force bool) error {
@@ -31,6 +57,8 @@ func (Participant) Prepare(db *pg.DB, force bool) error {
 		string(BUSY),
 		string(READY),
 		string(DISCONNECT),
+		string(LOCKED),
+		string(LEFT),
 	)
 }
 
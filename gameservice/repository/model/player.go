@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	basemodel "github.com/Handzo/gogame/common/model"
 	"github.com/go-pg/pg/v9"
 )
@@ -13,10 +15,18 @@ type Player struct {
 	Exp       uint64 `pg:",notnull,default:0"`
 	Nuts      uint64 `pg:",notnull,default:0"`
 	Gold      uint64 `pg:",notnull,default:0"`
+	Rating    int    `pg:",notnull,default:1000"`
 	Avatar    string
 	ProfileId string `pg:",type:uuid"`
 	Profile   *Profile
-	Sessions  []*Session `pg:"fk:player_id"`
+	Sessions  []*Session       `pg:"fk:player_id"`
+	Inventory []*InventoryItem `pg:"fk:player_id"`
+	// Streak is number of consecutive days daily reward has been claimed.
+	Streak       uint32 `pg:",notnull,default:0"`
+	LastRewardAt time.Time
+	// restrictions set by admin, zero time is no restriction
+	BannedUntil time.Time
+	MutedUntil  time.Time
 }
 
 func (Player) Prepare(*pg.DB, bool) error {
@@ -26,3 +36,25 @@ func (Player) Prepare(*pg.DB, bool) error {
 func (Player) Sync(*pg.DB, bool) error {
 	return nil
 }
+
+// IsBanned reports whether player is not allowed to open sessions.
+func (p Player) IsBanned() bool {
+	return p.BannedUntil.After(time.Now())
+}
+
+// IsMuted reports whether player is not allowed to message other players.
+func (p Player) IsMuted() bool {
+	return p.MutedUntil.After(time.Now())
+}
+
+// Item returns player's active inventory item with given title.
+// Inventory must be loaded with good items.
+func (p Player) Item(title string) *InventoryItem {
+	for _, i := range p.Inventory {
+		if i.GoodItem != nil && i.GoodItem.Title == title && i.IsActive() {
+			return i
+		}
+	}
+
+	return nil
+}
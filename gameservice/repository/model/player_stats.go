@@ -0,0 +1,32 @@
+package model
+
+import (
+	basemodel "github.com/Handzo/gogame/common/model"
+	"github.com/go-pg/pg/v9"
+)
+
+// PlayerStats holds player's aggregates which are incremented
+// when rounds and games are finished.
+type PlayerStats struct {
+	basemodel.BaseModel
+	PlayerId      string `pg:",notnull,unique,type:uuid"`
+	Player        *Player
+	GamesPlayed   int   `pg:",notnull,use_zero"`
+	GamesWon      int   `pg:",notnull,use_zero"`
+	RoundsPlayed  int   `pg:",notnull,use_zero"`
+	RoundsWon     int   `pg:",notnull,use_zero"`
+	CardPoints    int64 `pg:",notnull,use_zero"`
+	ClubJacks     int   `pg:",notnull,use_zero"`
+	TrumpClubs    int   `pg:",notnull,use_zero"`
+	TrumpSpades   int   `pg:",notnull,use_zero"`
+	TrumpHearts   int   `pg:",notnull,use_zero"`
+	TrumpDiamonds int   `pg:",notnull,use_zero"`
+}
+
+func (PlayerStats) Prepare(*pg.DB, bool) error {
+	return nil
+}
+
+func (PlayerStats) Sync(*pg.DB, bool) error {
+	return nil
+}
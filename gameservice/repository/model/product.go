@@ -26,9 +26,9 @@ func (Product) Sync(*pg.DB, bool) error {
 
 func (Product) Populate(db *pg.DB, force bool) error {
 	goodItems := []*GoodItem{
-		&GoodItem{Title: "Nuts", Description: "Nuts for playing belka"},
-		&GoodItem{Title: "Gold", Description: "Gold for playing belka"},
-		&GoodItem{Title: "VIP", Description: "VIP for playing belka"},
+		&GoodItem{Title: NUTS_ITEM, Description: "Nuts for playing belka"},
+		&GoodItem{Title: GOLD_ITEM, Description: "Gold for playing belka"},
+		&GoodItem{Title: VIP_ITEM, Description: "VIP for playing belka"},
 	}
 
 	for _, g := range goodItems {
@@ -74,12 +74,12 @@ func (Product) Populate(db *pg.DB, force bool) error {
 
 	productsGood := []*ProductToGood{
 		&ProductToGood{ProductId: products[0].Id, GoodId: goods[0].Id},
-		&ProductToGood{ProductId: products[1].Id, GoodId: goods[0].Id},
-		&ProductToGood{ProductId: products[2].Id, GoodId: goods[0].Id},
-		&ProductToGood{ProductId: products[3].Id, GoodId: goods[1].Id},
-		&ProductToGood{ProductId: products[4].Id, GoodId: goods[1].Id},
-		&ProductToGood{ProductId: products[5].Id, GoodId: goods[1].Id},
-		&ProductToGood{ProductId: products[6].Id, GoodId: goods[2].Id},
+		&ProductToGood{ProductId: products[1].Id, GoodId: goods[1].Id},
+		&ProductToGood{ProductId: products[2].Id, GoodId: goods[2].Id},
+		&ProductToGood{ProductId: products[3].Id, GoodId: goods[3].Id},
+		&ProductToGood{ProductId: products[4].Id, GoodId: goods[4].Id},
+		&ProductToGood{ProductId: products[5].Id, GoodId: goods[5].Id},
+		&ProductToGood{ProductId: products[6].Id, GoodId: goods[6].Id},
 	}
 
 	for _, p := range productsGood {
@@ -0,0 +1,42 @@
+package model
+
+import (
+	basemodel "github.com/Handzo/gogame/common/model"
+	"github.com/go-pg/pg/v9"
+)
+
+type PurchaseState string
+
+var (
+	PENDING   PurchaseState = "pending"
+	COMPLETED PurchaseState = "completed"
+	REFUNDED  PurchaseState = "refunded"
+)
+
+type Purchase struct {
+	basemodel.BaseModel
+	PurchaseId string `pg:",notnull,unique:player_purchase"`
+	PlayerId   string `pg:",notnull,type:uuid,unique:player_purchase"`
+	Player     *Player
+	ProductId  string `pg:",notnull,type:uuid"`
+	Product    *Product
+	Price      uint32        `pg:",notnull,use_zero"`
+	Currency   Currency      `pg:",notnull,type:currency"`
+	State      PurchaseState `pg:",notnull,type:purchase_state"`
+	// checkout created at provider for real money purchase
+	ExternalId  string
+	CheckoutUrl string
+}
+
+func (Purchase) Prepare(db *pg.DB, force bool) error {
+	return basemodel.CreateEnum(
+		db, force, "purchase_state",
+		string(PENDING),
+		string(COMPLETED),
+		string(REFUNDED),
+	)
+}
+
+func (Purchase) Sync(*pg.DB, bool) error {
+	return nil
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+
+	basemodel "github.com/Handzo/gogame/common/model"
+	"github.com/go-pg/pg/v9"
+)
+
+// DailyQuest is quest from configuration active for a single day.
+type DailyQuest struct {
+	basemodel.BaseModel
+	QuestId   string    `pg:",notnull,unique:quest_day"`
+	StartsAt  time.Time `pg:",notnull,unique:quest_day"`
+	ExpiresAt time.Time `pg:",notnull"`
+	ExpiredAt time.Time
+}
+
+func (DailyQuest) Prepare(*pg.DB, bool) error {
+	return nil
+}
+
+func (DailyQuest) Sync(*pg.DB, bool) error {
+	return nil
+}
+
+func (q DailyQuest) IsExpired() bool {
+	return !q.ExpiredAt.IsZero()
+}
+
+type QuestProgress struct {
+	basemodel.BaseModel
+	PlayerId     string `pg:",notnull,type:uuid,unique:player_quest"`
+	Player       *Player
+	DailyQuestId string `pg:",notnull,type:uuid,unique:player_quest"`
+	DailyQuest   *DailyQuest
+	Progress     int `pg:",notnull,use_zero"`
+	CompletedAt  time.Time
+}
+
+func (QuestProgress) Prepare(*pg.DB, bool) error {
+	return nil
+}
+
+func (QuestProgress) Sync(*pg.DB, bool) error {
+	return nil
+}
+
+func (q QuestProgress) IsCompleted() bool {
+	return !q.CompletedAt.IsZero()
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+
+	basemodel "github.com/Handzo/gogame/common/model"
+	"github.com/go-pg/pg/v9"
+)
+
+type RematchState string
+
+var (
+	VOTING   RematchState = "voting"
+	STARTED  RematchState = "started"
+	DECLINED RematchState = "declined"
+)
+
+// Rematch is vote of finished table players to play again
+// with the same seating and stake.
+type Rematch struct {
+	basemodel.BaseModel
+	TableId    string `pg:",notnull,unique,type:uuid"`
+	Table      *Table
+	State      RematchState `pg:",notnull,type:rematch_state"`
+	Votes      []string     `pg:",array"`
+	ExpiresAt  time.Time    `pg:",notnull"`
+	NewTableId string       `pg:",type:uuid"`
+}
+
+func (Rematch) Prepare(db *pg.DB, force bool) error {
+	return basemodel.CreateEnum(
+		db, force, "rematch_state",
+		string(VOTING),
+		string(STARTED),
+		string(DECLINED),
+	)
+}
+
+func (Rematch) Sync(*pg.DB, bool) error {
+	return nil
+}
+
+func (r Rematch) HasVoted(playerId string) bool {
+	for _, v := range r.Votes {
+		if v == playerId {
+			return true
+		}
+	}
+
+	return false
+}
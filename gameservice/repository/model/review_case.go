@@ -0,0 +1,65 @@
+package model
+
+import (
+	"time"
+
+	basemodel "github.com/Handzo/gogame/common/model"
+	"github.com/go-pg/pg/v9"
+)
+
+type ReviewKind string
+
+var (
+	POINT_FEEDING   ReviewKind = "point_feeding"
+	SEATED_TOGETHER ReviewKind = "seated_together"
+	SHARED_REMOTE   ReviewKind = "shared_remote"
+	PERFECT_PLAY    ReviewKind = "perfect_play"
+)
+
+type ReviewState string
+
+var (
+	UNDER_REVIEW ReviewState = "open"
+	CONFIRMED    ReviewState = "confirmed"
+	DISMISSED    ReviewState = "dismissed"
+)
+
+// ReviewCase is suspicious pattern found by collusion analysis and
+// waiting for admin decision. Key identifies the pattern across runs,
+// so repeated findings update the open case instead of adding new ones.
+type ReviewCase struct {
+	basemodel.BaseModel
+	Key        string     `pg:",notnull,unique"`
+	Kind       ReviewKind `pg:",notnull,type:review_kind"`
+	PlayerIds  []string   `pg:",array,type:uuid[]"`
+	TableIds   []string   `pg:",array,type:uuid[]"`
+	Score      float64    `pg:",notnull,use_zero"`
+	Details    string
+	State      ReviewState `pg:",notnull,type:review_state"`
+	ReviewerId string      `pg:",type:uuid"`
+	Note       string
+	ReviewedAt time.Time
+}
+
+func (ReviewCase) Prepare(db *pg.DB, force bool) error {
+	if err := basemodel.CreateEnum(
+		db, force, "review_kind",
+		string(POINT_FEEDING),
+		string(SEATED_TOGETHER),
+		string(SHARED_REMOTE),
+		string(PERFECT_PLAY),
+	); err != nil {
+		return err
+	}
+
+	return basemodel.CreateEnum(
+		db, force, "review_state",
+		string(UNDER_REVIEW),
+		string(CONFIRMED),
+		string(DISMISSED),
+	)
+}
+
+func (ReviewCase) Sync(*pg.DB, bool) error {
+	return nil
+}
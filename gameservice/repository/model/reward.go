@@ -0,0 +1,18 @@
+package model
+
+// Reward is in-game currency credited to player's wallet.
+type Reward struct {
+	Nuts uint64
+	Gold uint64
+}
+
+func (r Reward) IsZero() bool {
+	return r.Nuts == 0 && r.Gold == 0
+}
+
+func (r Reward) Add(other Reward) Reward {
+	return Reward{
+		Nuts: r.Nuts + other.Nuts,
+		Gold: r.Gold + other.Gold,
+	}
+}
@@ -15,6 +15,12 @@ type Round struct {
 	TableId   string `pg:",notnull,type:uuid"`
 	Table     *Table
 	Deals     []*Deal
+	// round result, set when round is finished
+	Trump         string
+	ClubJackOrder int `pg:",notnull,use_zero"`
+	Team1Points   int `pg:",notnull,use_zero"`
+	Team2Points   int `pg:",notnull,use_zero"`
+	Winner        int `pg:",notnull,use_zero"`
 }
 
 func (Round) Prepare(*pg.DB, bool) error {
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"time"
+
+	basemodel "github.com/Handzo/gogame/common/model"
+	"github.com/go-pg/pg/v9"
+)
+
+// SeatSwap is request of player to exchange seats with another
+// player at the same table.
+type SeatSwap struct {
+	basemodel.BaseModel
+	TableId     string `pg:",notnull,type:uuid"`
+	Table       *Table
+	FromId      string       `pg:",notnull,type:uuid"`
+	From        *Participant `pg:",fk:from_id"`
+	ToId        string       `pg:",notnull,type:uuid"`
+	To          *Participant `pg:",fk:to_id"`
+	RequesterId string       `pg:",notnull,type:uuid"`
+	TargetId    string       `pg:",notnull,type:uuid"`
+	ExpiresAt   time.Time    `pg:",notnull"`
+	AcceptedAt  time.Time
+}
+
+func (SeatSwap) Prepare(*pg.DB, bool) error {
+	return nil
+}
+
+func (SeatSwap) Sync(*pg.DB, bool) error {
+	return nil
+}
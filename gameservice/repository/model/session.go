@@ -10,9 +10,12 @@ import (
 type Session struct {
 	basemodel.BaseModel
 	Remote   string `pg:",notnull"`
+	Device   string
 	ClosedAt time.Time
 	PlayerId string `pg:",type:uuid"`
 	Player   *Player
+	// session of player's devices which takes seat actions
+	Controller bool `pg:",notnull,use_zero"`
 }
 
 func (Session) Prepare(*pg.DB, bool) error {
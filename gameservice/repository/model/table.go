@@ -15,6 +15,56 @@ var (
 	USD  Currency = "usd"
 )
 
+// Variant is a set of game rules played at table.
+type Variant string
+
+var (
+	CLASSIC Variant = "classic"
+)
+
+// Variants are rule variants tables may be created with.
+var Variants = []Variant{CLASSIC}
+
+type TableState string
+
+var (
+	WAITING        TableState = "waiting"
+	STARTING       TableState = "starting"
+	IN_ROUND       TableState = "in_round"
+	BETWEEN_ROUNDS TableState = "between_rounds"
+	GAME_FINISHED  TableState = "finished"
+	ABANDONED      TableState = "abandoned"
+)
+
+// Tables go back to waiting if seating changes before start, and
+// forfeit may finish the game in the middle of a round.
+var tableTransitions = map[TableState][]TableState{
+	WAITING:        {STARTING, ABANDONED},
+	STARTING:       {WAITING, BETWEEN_ROUNDS, ABANDONED},
+	BETWEEN_ROUNDS: {IN_ROUND, GAME_FINISHED, ABANDONED},
+	IN_ROUND:       {BETWEEN_ROUNDS, GAME_FINISHED, ABANDONED},
+}
+
+// CanBecome reports whether table may change state from s to other.
+func (s TableState) CanBecome(other TableState) bool {
+	for _, t := range tableTransitions[s] {
+		if t == other {
+			return true
+		}
+	}
+
+	return false
+}
+
+// table results other than regular game finish
+var (
+	FORFEIT  string = "forfeit"
+	CLOSED   string = "closed"
+	IDLE     string = "idle"
+	DESERTED string = "deserted"
+	VOIDED   string = "voided"
+)
+
 type Table struct {
 	basemodel.BaseModel
 	StartTime    time.Time
@@ -22,19 +72,48 @@ type Table struct {
 	Signature    string
 	Currency     Currency `pg:",notnull,type:currency"`
 	Bet          uint32   `pg:",default:0"`
+	Variant      Variant  `pg:",notnull,type:table_variant,default:'classic'"`
 	Result       string
+	State        TableState `pg:",notnull,type:table_state,default:'waiting'"`
+	Version      uint64     `pg:",notnull,use_zero"`
+	Private      bool       `pg:",notnull,use_zero"`
 	Participants []*Participant
 	Rounds       []*Round
 	CreatorId    string  `pg:",notnull,type:uuid"`
 	Creator      *Player `pg:",fk:creator_id"`
+	// set for tables generated by tournament
+	TournamentId    string `pg:",type:uuid"`
+	TournamentRound int    `pg:",notnull,use_zero"`
+	// appended to table log in the same transaction as the next
+	// update of the table
+	Events []*TableEvent `pg:"-"`
 }
 
 func (Table) Prepare(db *pg.DB, force bool) error {
-	return basemodel.CreateEnum(
+	if err := basemodel.CreateEnum(
 		db, force, "currency",
 		string(NUTS),
 		string(GOLD),
 		string(USD),
+	); err != nil {
+		return err
+	}
+
+	if err := basemodel.CreateEnum(
+		db, force, "table_variant",
+		string(CLASSIC),
+	); err != nil {
+		return err
+	}
+
+	return basemodel.CreateEnum(
+		db, force, "table_state",
+		string(WAITING),
+		string(STARTING),
+		string(IN_ROUND),
+		string(BETWEEN_ROUNDS),
+		string(GAME_FINISHED),
+		string(ABANDONED),
 	)
 }
 
@@ -44,7 +123,7 @@ func (Table) Sync(*pg.DB, bool) error {
 
 func (t Table) HasEmptyPlaces() bool {
 	for _, p := range t.Participants {
-		if p.Player == nil {
+		if p.Player == nil && p.State != LOCKED {
 			return true
 		}
 	}
@@ -52,6 +131,38 @@ func (t Table) HasEmptyPlaces() bool {
 	return false
 }
 
+// FreeSeats counts seats available to take.
+func (t Table) FreeSeats() int {
+	n := 0
+	for _, p := range t.Participants {
+		if p.State == FREE {
+			n++
+		}
+	}
+
+	return n
+}
+
+// IsOpen reports whether game is being played at the table.
 func (t Table) IsOpen() bool {
-	return !t.StartTime.IsZero() && t.EndTime.IsZero()
+	return t.State == IN_ROUND || t.State == BETWEEN_ROUNDS
+}
+
+// IsWaiting reports whether game has not been started yet.
+func (t Table) IsWaiting() bool {
+	return t.State == WAITING || t.State == STARTING
+}
+
+// IsClosed reports whether table is finished or abandoned.
+func (t Table) IsClosed() bool {
+	return t.State == GAME_FINISHED || t.State == ABANDONED
+}
+
+// TableFilter narrows lobby listing. Zero fields do not filter.
+type TableFilter struct {
+	Currency  Currency
+	Variant   Variant
+	MinBet    uint32
+	MaxBet    uint32
+	FreeSeats int
 }
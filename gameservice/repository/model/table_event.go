@@ -0,0 +1,56 @@
+package model
+
+import (
+	basemodel "github.com/Handzo/gogame/common/model"
+	"github.com/go-pg/pg/v9"
+)
+
+type TableEventType string
+
+var (
+	SEAT_TAKEN     TableEventType = "seat_taken"
+	SEAT_LEFT      TableEventType = "seat_left"
+	PLAYER_READY   TableEventType = "ready"
+	GAME_STARTED   TableEventType = "game_started"
+	CARDS_DEALT    TableEventType = "cards_dealt"
+	MOVE           TableEventType = "move"
+	TRICK_WON      TableEventType = "trick_won"
+	ROUND_FINISHED TableEventType = "round_finished"
+	PAYOUT         TableEventType = "payout"
+)
+
+// TableEvent is an entry of append-only table log. Entries are numbered
+// from 1 per table. Signature is the one of the table after the event,
+// Amount is the net payout of participant.
+type TableEvent struct {
+	basemodel.BaseModel
+	TableId   string         `pg:",notnull,type:uuid,unique:table_seq"`
+	Table     *Table         `pg:",fk:table_id"`
+	Seq       int            `pg:",notnull,unique:table_seq"`
+	Type      TableEventType `pg:",notnull,type:table_event_type"`
+	Order     int            `pg:",use_zero"`
+	PlayerId  string         `pg:",type:uuid"`
+	Card      string
+	Team      int   `pg:",use_zero"`
+	Amount    int64 `pg:",use_zero"`
+	Signature string
+}
+
+func (TableEvent) Prepare(db *pg.DB, force bool) error {
+	return basemodel.CreateEnum(
+		db, force, "table_event_type",
+		string(SEAT_TAKEN),
+		string(SEAT_LEFT),
+		string(PLAYER_READY),
+		string(GAME_STARTED),
+		string(CARDS_DEALT),
+		string(MOVE),
+		string(TRICK_WON),
+		string(ROUND_FINISHED),
+		string(PAYOUT),
+	)
+}
+
+func (TableEvent) Sync(*pg.DB, bool) error {
+	return nil
+}
@@ -0,0 +1,141 @@
+package model
+
+import (
+	"time"
+
+	basemodel "github.com/Handzo/gogame/common/model"
+	"github.com/go-pg/pg/v9"
+)
+
+type TournamentFormat string
+
+var (
+	ELIMINATION TournamentFormat = "elimination"
+	SWISS       TournamentFormat = "swiss"
+)
+
+type TournamentState string
+
+var (
+	REGISTRATION TournamentState = "registration"
+	RUNNING      TournamentState = "running"
+	FINISHED     TournamentState = "finished"
+	CANCELLED    TournamentState = "cancelled"
+)
+
+type Tournament struct {
+	basemodel.BaseModel
+	// template tournament has been scheduled from
+	TemplateId string           `pg:",notnull,unique:template_start"`
+	StartsAt   time.Time        `pg:",notnull,unique:template_start"`
+	Title      string           `pg:",notnull"`
+	Format     TournamentFormat `pg:",notnull,type:tournament_format"`
+	State      TournamentState  `pg:",notnull,type:tournament_state"`
+	// 1 for individual registration, 2 for teams
+	TeamSize   int      `pg:",notnull"`
+	Currency   Currency `pg:",notnull,type:currency"`
+	EntryFee   uint32   `pg:",notnull,use_zero"`
+	MinEntries int      `pg:",notnull"`
+	MaxEntries int      `pg:",notnull"`
+	// number of rounds of swiss tournament
+	Rounds    int       `pg:",notnull,use_zero"`
+	Round     int       `pg:",notnull,use_zero"`
+	PrizePool uint64    `pg:",notnull,use_zero"`
+	Payouts   []float64 `pg:",array"`
+	Entries   []*TournamentEntry
+}
+
+func (Tournament) Prepare(db *pg.DB, force bool) error {
+	if err := basemodel.CreateEnum(
+		db, force, "tournament_format",
+		string(ELIMINATION),
+		string(SWISS),
+	); err != nil {
+		return err
+	}
+
+	return basemodel.CreateEnum(
+		db, force, "tournament_state",
+		string(REGISTRATION),
+		string(RUNNING),
+		string(FINISHED),
+		string(CANCELLED),
+	)
+}
+
+func (Tournament) Sync(*pg.DB, bool) error {
+	return nil
+}
+
+// Slots is number of entries playing at one table.
+func (t Tournament) Slots() int {
+	return 4 / t.TeamSize
+}
+
+// TournamentEntry is a player or a team registered for tournament.
+type TournamentEntry struct {
+	basemodel.BaseModel
+	TournamentId string `pg:",notnull,type:uuid,unique:tournament_player"`
+	Tournament   *Tournament
+	PlayerId     string `pg:",notnull,type:uuid,unique:tournament_player"`
+	Player       *Player
+	PartnerId    string  `pg:",type:uuid"`
+	Partner      *Player `pg:",fk:partner_id"`
+	// partner has registered back and paid own entry fee
+	Accepted   bool `pg:",notnull,use_zero"`
+	Points     int  `pg:",notnull,use_zero"`
+	Eliminated bool `pg:",notnull,use_zero"`
+	// round entry has been eliminated in
+	EliminatedRound int    `pg:",notnull,use_zero"`
+	Place           int    `pg:",notnull,use_zero"`
+	Prize           uint64 `pg:",notnull,use_zero"`
+	// entries met at tables of previous rounds
+	Opponents []string `pg:",array"`
+}
+
+func (TournamentEntry) Prepare(*pg.DB, bool) error {
+	return nil
+}
+
+func (TournamentEntry) Sync(*pg.DB, bool) error {
+	return nil
+}
+
+// Players returns ids of entry's players.
+func (e TournamentEntry) Players() []string {
+	if e.PartnerId == "" {
+		return []string{e.PlayerId}
+	}
+
+	return []string{e.PlayerId, e.PartnerId}
+}
+
+// Pending reports whether team entry still waits for partner to accept.
+func (e TournamentEntry) Pending() bool {
+	return e.PartnerId != "" && !e.Accepted
+}
+
+// Paying returns ids of players who have paid entry fee. Partner pays
+// when accepting team registration.
+func (e TournamentEntry) Paying() []string {
+	if e.Pending() {
+		return []string{e.PlayerId}
+	}
+
+	return e.Players()
+}
+
+// Met reports whether entry has already played against given entry.
+func (e TournamentEntry) Met(entryId string) bool {
+	for _, id := range e.Opponents {
+		if id == entryId {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e TournamentEntry) Has(playerId string) bool {
+	return e.PlayerId == playerId || e.PartnerId == playerId
+}
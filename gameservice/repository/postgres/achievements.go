@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/go-pg/pg/v9"
+)
+
+func (r *pgGameRepository) GetAchievementProgress(ctx context.Context, playerId string) ([]*model.AchievementProgress, error) {
+	progress := []*model.AchievementProgress{}
+	err := r.DB.ModelContext(ctx, &progress).
+		Where(`player_id = ?`, playerId).
+		Select()
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return progress, err
+}
+
+// AdvanceAchievement increments player's progress of achievement and
+// unlocks it with reward once goal is reached. Player with updated
+// balances is returned only if achievement has been unlocked by the call.
+func (r *pgGameRepository) AdvanceAchievement(ctx context.Context, playerId, achievementId string, goal int, reward model.Reward) (*model.AchievementProgress, *model.Player, error) {
+	progress := &model.AchievementProgress{
+		PlayerId:      playerId,
+		AchievementId: achievementId,
+		Progress:      1,
+	}
+
+	var player *model.Player
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		res, err := tx.ModelContext(ctx, progress).
+			OnConflict(`(player_id, achievement_id) DO UPDATE`).
+			Set(`progress = ?TableAlias.progress + 1`).
+			Set(`updated_at = now()`).
+			Where(`?TableAlias.unlocked_at IS NULL`).
+			Returning(`*`).
+			Insert()
+		if err != nil {
+			// already unlocked, nothing has been updated
+			if err == pg.ErrNoRows {
+				return nil
+			}
+			return err
+		}
+
+		if res.RowsAffected() == 0 || progress.IsUnlocked() || progress.Progress < goal {
+			return nil
+		}
+
+		_, err = tx.ModelContext(ctx, progress).
+			Set(`unlocked_at = now()`).
+			WherePK().
+			Returning(`unlocked_at`).
+			Update()
+		if err != nil {
+			return err
+		}
+
+		if err = grantReward(ctx, tx, playerId, reward); err != nil {
+			return err
+		}
+
+		player = &model.Player{}
+		player.Id = playerId
+		return selectBalances(ctx, tx, player)
+	})
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+		return nil, nil, err
+	}
+
+	return progress, player, nil
+}
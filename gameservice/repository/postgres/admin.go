@@ -0,0 +1,170 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/go-pg/pg/v9"
+	"github.com/go-pg/pg/v9/orm"
+)
+
+// likeEscaper escapes wildcards of LIKE patterns.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// SearchPlayers finds players whose nickname contains query or whose
+// player or user id equals it, nicknames in alphabetical order.
+func (r *pgGameRepository) SearchPlayers(ctx context.Context, query string, offset, limit int) ([]*model.Player, int, error) {
+	players := []*model.Player{}
+	total, err := r.DB.ModelContext(ctx, &players).
+		WhereOrGroup(func(q *orm.Query) (*orm.Query, error) {
+			q.Where(`nickname ILIKE ?`, "%"+likeEscaper.Replace(query)+"%").
+				WhereOr(`id::text = ?`, query).
+				WhereOr(`user_id::text = ?`, query)
+			return q, nil
+		}).
+		Order(`nickname`).
+		Offset(offset).
+		Limit(limit).
+		SelectAndCount()
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+		return nil, 0, err
+	}
+
+	return players, total, nil
+}
+
+// GetPlayerSessions returns sessions of player, latest first.
+func (r *pgGameRepository) GetPlayerSessions(ctx context.Context, playerId string, offset, limit int) ([]*model.Session, int, error) {
+	sessions := []*model.Session{}
+	total, err := r.DB.ModelContext(ctx, &sessions).
+		Where(`player_id = ?`, playerId).
+		Order(`created_at DESC`).
+		Offset(offset).
+		Limit(limit).
+		SelectAndCount()
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+		return nil, 0, err
+	}
+
+	return sessions, total, nil
+}
+
+// GetPlayerTables returns tables player has sat at in any state,
+// latest first.
+func (r *pgGameRepository) GetPlayerTables(ctx context.Context, playerId string, offset, limit int) ([]*model.Table, int, error) {
+	tables := []*model.Table{}
+	total, err := r.DB.ModelContext(ctx, &tables).
+		Relation(`Participants`).
+		Relation(`Participants.Player`).
+		Where(`EXISTS (SELECT 1 FROM participants AS p WHERE p.table_id = "table"."id" AND p.player_id = ?)`, playerId).
+		Order(`table.created_at DESC`).
+		Offset(offset).
+		Limit(limit).
+		SelectAndCount()
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+		return nil, 0, err
+	}
+
+	return tables, total, nil
+}
+
+// GetPurchases returns the latest purchases of player.
+func (r *pgGameRepository) GetPurchases(ctx context.Context, playerId string, limit int) ([]*model.Purchase, error) {
+	purchases := []*model.Purchase{}
+	err := r.DB.ModelContext(ctx, &purchases).
+		Where(`player_id = ?`, playerId).
+		Order(`created_at DESC`).
+		Limit(limit).
+		Select()
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return purchases, err
+}
+
+// GetBalanceAdjustments returns the latest balance adjustments of player.
+func (r *pgGameRepository) GetBalanceAdjustments(ctx context.Context, playerId string, limit int) ([]*model.BalanceAdjustment, error) {
+	adjustments := []*model.BalanceAdjustment{}
+	err := r.DB.ModelContext(ctx, &adjustments).
+		Where(`player_id = ?`, playerId).
+		Order(`created_at DESC`).
+		Limit(limit).
+		Select()
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return adjustments, err
+}
+
+// AdjustBalance changes player's balance and records the adjustment.
+// Balance never goes below zero, code.NotEnoughFunds is returned instead.
+func (r *pgGameRepository) AdjustBalance(ctx context.Context, adjustment *model.BalanceAdjustment) (*model.Player, error) {
+	player := &model.Player{}
+	player.Id = adjustment.PlayerId
+
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		if err := updateBalance(ctx, tx, player.Id, adjustment.Currency, adjustment.Amount); err != nil {
+			return err
+		}
+
+		if _, err := tx.ModelContext(ctx, adjustment).Insert(); err != nil {
+			return err
+		}
+
+		return selectBalances(ctx, tx, player)
+	})
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+		return nil, err
+	}
+
+	return player, nil
+}
+
+// GetOnlineRemotes returns remotes of every open session.
+func (r *pgGameRepository) GetOnlineRemotes(ctx context.Context) ([]string, error) {
+	remotes := []string{}
+	err := r.DB.ModelContext(ctx, &model.Session{}).
+		Column(`remote`).
+		Where(`closed_at IS NULL`).
+		Select(&remotes)
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return remotes, err
+}
+
+// GetAdminActions returns audit log, latest first. Log is filtered by
+// player and table when they are given.
+func (r *pgGameRepository) GetAdminActions(ctx context.Context, playerId, tableId string, offset, limit int) ([]*model.AdminAction, int, error) {
+	actions := []*model.AdminAction{}
+	query := r.DB.ModelContext(ctx, &actions)
+
+	if playerId != "" {
+		query.Where(`player_id = ?`, playerId)
+	}
+
+	if tableId != "" {
+		query.Where(`table_id = ?`, tableId)
+	}
+
+	total, err := query.
+		Order(`created_at DESC`).
+		Offset(offset).
+		Limit(limit).
+		SelectAndCount()
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+		return nil, 0, err
+	}
+
+	return actions, total, nil
+}
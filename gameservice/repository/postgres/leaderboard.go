@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/go-pg/pg/v9"
+)
+
+// SaveGameResult stores participants' results and applies their
+// rating changes. New ratings are set to loaded players.
+func (r *pgGameRepository) SaveGameResult(ctx context.Context, table *model.Table) error {
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		for _, p := range table.Participants {
+			if p.PlayerId == "" {
+				continue
+			}
+
+			_, err := tx.ModelContext(ctx, p).
+				Column(`won`, `rating_change`).
+				WherePK().
+				Update()
+			if err != nil {
+				return err
+			}
+
+			player := &model.Player{}
+			player.Id = p.PlayerId
+
+			_, err = tx.ModelContext(ctx, player).
+				Set(`rating = rating + ?`, p.RatingChange).
+				WherePK().
+				Returning(`rating`).
+				Update()
+			if err != nil {
+				return err
+			}
+
+			if p.Player != nil {
+				p.Player.Rating = player.Rating
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return err
+}
+
+// GetLeaderboardRows aggregates results of tables finished since given
+// time per player and currency.
+func (r *pgGameRepository) GetLeaderboardRows(ctx context.Context, since time.Time) ([]*model.LeaderboardRow, error) {
+	rows := []*model.LeaderboardRow{}
+	_, err := r.DB.QueryContext(ctx, &rows, `
+		SELECT
+			player.id AS player_id,
+			COALESCE(profile.country, '') AS country,
+			t.currency,
+			player.rating,
+			SUM(participant.rating_change) AS rating_change,
+			COUNT(*) FILTER (WHERE participant.won) AS wins,
+			SUM(CASE WHEN participant.won THEN t.bet ELSE -t.bet END) AS net
+		FROM participants AS participant
+		JOIN tables AS t ON t.id = participant.table_id
+		JOIN players AS player ON player.id = participant.player_id
+		LEFT JOIN profiles AS profile ON profile.id = player.profile_id
+		WHERE t.state = 'finished' AND t.end_time >= ?
+		GROUP BY player.id, profile.country, t.currency
+	`, since)
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return rows, err
+}
+
+func (r *pgGameRepository) FindPlayers(ctx context.Context, ids []string) ([]*model.Player, error) {
+	players := []*model.Player{}
+	if len(ids) == 0 {
+		return players, nil
+	}
+
+	err := r.DB.ModelContext(ctx, &players).
+		Relation(`Profile`).
+		Where(`"player"."id" IN (?)`, pg.In(ids)).
+		Select()
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return players, err
+}
+
+// PenalizePlayer takes nuts and rating from player. Nuts balance does
+// not go below zero.
+func (r *pgGameRepository) PenalizePlayer(ctx context.Context, playerId string, nuts uint64, rating int) error {
+	_, err := r.DB.ModelContext(ctx, &model.Player{}).
+		Set(`nuts = GREATEST(nuts - ?, 0)`, nuts).
+		Set(`rating = rating - ?`, rating).
+		Set(`updated_at = now()`).
+		Where(`id = ?`, playerId).
+		Update()
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return err
+}
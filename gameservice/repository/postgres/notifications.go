@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/go-pg/pg/v9"
+	"github.com/go-pg/pg/v9/orm"
+)
+
+// GetNotifications returns player's notifications, latest first, with
+// total count of notifications and count of unread ones.
+func (r *pgGameRepository) GetNotifications(ctx context.Context, playerId string, unreadOnly bool, offset, limit int) ([]*model.Notification, int, int, error) {
+	logger := r.logger.For(ctx)
+
+	notifications := []*model.Notification{}
+	query := r.DB.ModelContext(ctx, &notifications).
+		Where(`player_id = ?`, playerId)
+
+	if unreadOnly {
+		query.Where(`read_at IS NULL`)
+	}
+
+	total, err := query.
+		Order(`created_at DESC`).
+		Offset(offset).
+		Limit(limit).
+		SelectAndCount()
+	if err != nil {
+		logger.Error(err)
+		return nil, 0, 0, err
+	}
+
+	unread, err := countUnread(ctx, r.DB, playerId)
+	if err != nil {
+		logger.Error(err)
+		return nil, 0, 0, err
+	}
+
+	return notifications, total, unread, nil
+}
+
+// MarkNotificationsRead marks given notifications of player read, or all
+// of them when no ids are given. It returns count of notifications left
+// unread.
+func (r *pgGameRepository) MarkNotificationsRead(ctx context.Context, playerId string, ids ...string) (int, error) {
+	unread := 0
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		query := tx.ModelContext(ctx, &model.Notification{}).
+			Set(`read_at = now()`).
+			Set(`updated_at = now()`).
+			Where(`player_id = ?`, playerId).
+			Where(`read_at IS NULL`)
+
+		if len(ids) != 0 {
+			query.Where(`id IN (?)`, pg.In(ids))
+		}
+
+		if _, err := query.Update(); err != nil {
+			return err
+		}
+
+		var err error
+		unread, err = countUnread(ctx, tx, playerId)
+		return err
+	})
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return unread, err
+}
+
+func countUnread(ctx context.Context, db orm.DB, playerId string) (int, error) {
+	return db.ModelContext(ctx, &model.Notification{}).
+		Where(`player_id = ?`, playerId).
+		Where(`read_at IS NULL`).
+		Count()
+}
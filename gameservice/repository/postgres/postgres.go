@@ -2,11 +2,11 @@ package postgres
 
 import (
 	"context"
-
-	// "github.com/Handzo/gogame/gameservice/code"
+	"time"
 
 	"github.com/Handzo/gogame/common/log"
 	basemodel "github.com/Handzo/gogame/common/model"
+	"github.com/Handzo/gogame/gameservice/code"
 	"github.com/Handzo/gogame/gameservice/repository/model"
 	"github.com/go-pg/pg/v9"
 	"github.com/go-pg/pg/v9/orm"
@@ -51,6 +51,23 @@ func New(redis *redis.Client, tracer opentracing.Tracer, logger log.Factory) *pg
 		&model.Good{},
 		&model.Product{},
 		&model.ProductToGood{},
+		&model.Purchase{},
+		&model.InventoryItem{},
+		&model.Friendship{},
+		&model.Invitation{},
+		&model.PlayerStats{},
+		&model.AchievementProgress{},
+		&model.DailyQuest{},
+		&model.QuestProgress{},
+		&model.SeatSwap{},
+		&model.Rematch{},
+		&model.Tournament{},
+		&model.TournamentEntry{},
+		&model.Notification{},
+		&model.TableEvent{},
+		&model.BalanceAdjustment{},
+		&model.AdminAction{},
+		&model.ReviewCase{},
 	}
 
 	force := true
@@ -154,17 +171,49 @@ func (r *pgGameRepository) CreateSession(ctx context.Context, session *model.Ses
 	return err
 }
 
-func (r *pgGameRepository) GetOpenTables(ctx context.Context) ([]*model.Table, error) {
+// GetOpenTables returns public tables waiting for the game to start
+// matching filter, newest first, with seated players and total count.
+func (r *pgGameRepository) GetOpenTables(ctx context.Context, filter *model.TableFilter, offset, limit int) ([]*model.Table, int, error) {
 	tables := []*model.Table{}
-	err := r.DB.ModelContext(ctx, &tables).
-		Where(`end_time IS NULL`).
-		Select()
+	query := r.DB.ModelContext(ctx, &tables).
+		Relation(`Participants`, func(q *orm.Query) (*orm.Query, error) {
+			return q.Order(`order`), nil
+		}).
+		Relation(`Participants.Player`).
+		Where(`"table"."state" IN (?)`, pg.In([]model.TableState{model.WAITING, model.STARTING})).
+		Where(`"table"."private" = false`)
+
+	if filter.Currency != "" {
+		query.Where(`"table"."currency" = ?`, filter.Currency)
+	}
+
+	if filter.Variant != "" {
+		query.Where(`"table"."variant" = ?`, filter.Variant)
+	}
 
+	if filter.MinBet != 0 {
+		query.Where(`"table"."bet" >= ?`, filter.MinBet)
+	}
+
+	if filter.MaxBet != 0 {
+		query.Where(`"table"."bet" <= ?`, filter.MaxBet)
+	}
+
+	if filter.FreeSeats != 0 {
+		query.Where(`(SELECT COUNT(*) FROM participants AS p WHERE p.table_id = "table"."id" AND p.state = ?) >= ?`, model.FREE, filter.FreeSeats)
+	}
+
+	count, err := query.
+		Order(`table.created_at DESC`).
+		Offset(offset).
+		Limit(limit).
+		SelectAndCount()
 	if err != nil {
 		r.logger.For(ctx).Error(err)
+		return nil, 0, err
 	}
 
-	return tables, err
+	return tables, count, nil
 }
 
 func (r *pgGameRepository) GetOpenedSessionForRemote(ctx context.Context, remote string) (*model.Session, error) {
@@ -186,7 +235,7 @@ func (r *pgGameRepository) GetOpenedSessionForRemote(ctx context.Context, remote
 	return session, nil
 }
 
-func (r *pgGameRepository) CreateTable(ctx context.Context, creatorId string, currency string, bet uint32) (*model.Table, error) {
+func (r *pgGameRepository) CreateTable(ctx context.Context, creatorId string, currency, variant string, bet uint32, private bool) (*model.Table, error) {
 	logger := r.logger.For(ctx)
 
 	// unit := &model.Unit{}
@@ -201,14 +250,18 @@ func (r *pgGameRepository) CreateTable(ctx context.Context, creatorId string, cu
 
 	logger.Info("Inserting new table",
 		log.String("currency", currency),
+		log.String("variant", variant),
 		log.Int64("bet", int64(bet)),
 		log.String("creator_id", creatorId),
+		log.Bool("private", private),
 	)
 
 	table := &model.Table{
 		Bet:       bet,
 		Currency:  model.Currency(currency),
+		Variant:   model.Variant(variant),
 		CreatorId: creatorId,
+		Private:   private,
 	}
 
 	_, err := r.DB.ModelContext(ctx, table).Insert()
@@ -240,6 +293,7 @@ func (r *pgGameRepository) FindTable(ctx context.Context, tableId string) (*mode
 	err := r.DB.ModelContext(ctx, table).
 		Relation(`Participants`).
 		Relation(`Participants.Player`).
+		Relation(`Participants.Player.Profile`).
 		Where(`"table"."id" = ?`, tableId).
 		Select()
 	if err != nil {
@@ -255,6 +309,91 @@ func (r *pgGameRepository) FindTable(ctx context.Context, tableId string) (*mode
 	return table, nil
 }
 
+// TransitTable moves table to another state together with given columns.
+// False is returned if table state has been changed concurrently.
+func (r *pgGameRepository) TransitTable(ctx context.Context, table *model.Table, state model.TableState, columns ...string) (bool, error) {
+	from := table.State
+	table.State = state
+
+	updated, err := r.updateTable(ctx, table, from, append([]string{`state`}, columns...)...)
+	if !updated {
+		table.State = from
+	}
+
+	return updated, err
+}
+
+// UpdateTable saves given columns unless table has been changed since
+// it was loaded.
+func (r *pgGameRepository) UpdateTable(ctx context.Context, table *model.Table, columns ...string) (bool, error) {
+	return r.updateTable(ctx, table, table.State, columns...)
+}
+
+// updateTable compares and swaps table version and appends pending
+// events of table to its log in the same transaction. Table is expected
+// to be loaded with its state and version.
+func (r *pgGameRepository) updateTable(ctx context.Context, table *model.Table, state model.TableState, columns ...string) (bool, error) {
+	version := table.Version
+	table.Version++
+	updated := false
+
+	// columns of caller are not appended to in place
+	update := make([]string, 0, len(columns)+2)
+	update = append(update, columns...)
+	update = append(update, `version`, `updated_at`)
+
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		res, err := tx.ModelContext(ctx, table).
+			Column(update...).
+			WherePK().
+			Where(`state = ?`, state).
+			Where(`version = ?`, version).
+			Update()
+		if err != nil || res.RowsAffected() == 0 {
+			return err
+		}
+
+		for _, e := range table.Events {
+			e.TableId = table.Id
+			if err = appendTableEvent(ctx, tx, e); err != nil {
+				return err
+			}
+		}
+
+		updated = true
+		return nil
+	})
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	if !updated {
+		table.Version = version
+		return false, err
+	}
+
+	table.Events = nil
+	return true, nil
+}
+
+// GetIdleTables returns tables in given states which have not been
+// updated since given time. Seat changes update the table too.
+func (r *pgGameRepository) GetIdleTables(ctx context.Context, before time.Time, states ...model.TableState) ([]*model.Table, error) {
+	tables := []*model.Table{}
+	err := r.DB.ModelContext(ctx, &tables).
+		Relation(`Participants`).
+		Where(`"table"."state" IN (?)`, pg.In(states)).
+		Where(`"table"."updated_at" < ?`, before).
+		Order(`table.updated_at`).
+		Select()
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return tables, err
+}
+
 func (r *pgGameRepository) TableReadyCount(ctx context.Context, tableId string) (int, error) {
 	p := &model.Participant{}
 	count, err := r.DB.ModelContext(ctx, p).
@@ -276,8 +415,7 @@ func (r *pgGameRepository) FindTableWithPlayer(ctx context.Context, playerId str
 	err := r.DB.ModelContext(ctx, participant).
 		Relation(`Table`).
 		Where(`"participant"."player_id" = ?`, playerId).
-		Where(`"table"."start_time" IS NOT NULL`).
-		Where(`"table"."end_time" IS NULL`).
+		Where(`"table"."state" IN (?)`, pg.In([]model.TableState{model.IN_ROUND, model.BETWEEN_ROUNDS})).
 		First()
 
 	if err != nil {
@@ -297,7 +435,7 @@ func (r *pgGameRepository) GetParticipantsForPlayer(ctx context.Context, playerI
 	err := r.DB.ModelContext(ctx, &participants).
 		Relation(`Table`).
 		Where(`"participant"."player_id" = ?`, playerId).
-		Where(`"table"."end_time" IS NULL`).
+		Where(`"table"."state" NOT IN (?)`, pg.In([]model.TableState{model.GAME_FINISHED, model.ABANDONED})).
 		Select()
 	if err != nil {
 		r.logger.For(ctx).Error(err)
@@ -416,20 +554,513 @@ func (r *pgGameRepository) GetProduct(ctx context.Context, productId string) (*m
 
 	logger.Info("Request product", log.String("product_id", productId))
 
-	product := &model.Product{}
+	product, err := selectProduct(ctx, r.DB, productId)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return product, nil
+}
+
+// PurchaseProduct charges player for the product and grants its goods
+// in one transaction. Purchases are unique per player and purchase id,
+// so a retried request returns current balances without charging twice.
+func (r *pgGameRepository) PurchaseProduct(ctx context.Context, purchase *model.Purchase) (*model.Player, error) {
+	logger := r.logger.For(ctx)
+
+	logger.Info("Purchase product",
+		log.String("player_id", purchase.PlayerId),
+		log.String("product_id", purchase.ProductId),
+		log.String("purchase_id", purchase.PurchaseId),
+	)
+
+	player := &model.Player{}
+	player.Id = purchase.PlayerId
+
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		// lock player, so concurrent purchases are processed one by one
+		err := tx.ModelContext(ctx, player).
+			Column(`id`).
+			WherePK().
+			For(`UPDATE`).
+			Select()
+		if err != nil {
+			return err
+		}
+
+		processed := &model.Purchase{}
+		err = tx.ModelContext(ctx, processed).
+			Where(`player_id = ?`, purchase.PlayerId).
+			Where(`purchase_id = ?`, purchase.PurchaseId).
+			Select()
+
+		switch err {
+		case nil:
+			if processed.ProductId != purchase.ProductId {
+				return code.PurchaseIdConflict
+			}
+
+			logger.Info("Purchase already processed", log.String("purchase_id", purchase.PurchaseId))
+			*purchase = *processed
+			return selectBalances(ctx, tx, player)
+		case pg.ErrNoRows:
+		default:
+			return err
+		}
+
+		product, err := selectProduct(ctx, tx, purchase.ProductId)
+		if err != nil {
+			return err
+		}
+
+		if product == nil {
+			return code.ProductNotFound
+		}
+
+		// real money products are not sold for in-game currency
+		if product.Currency == model.USD {
+			return code.ProductNotAvailable
+		}
+
+		purchase.Price = product.Price
+		purchase.Currency = product.Currency
+		purchase.State = model.COMPLETED
+
+		if err = updateBalance(ctx, tx, player.Id, product.Currency, -int64(product.Price)); err != nil {
+			return err
+		}
+
+		if _, err = tx.ModelContext(ctx, purchase).Insert(); err != nil {
+			return err
+		}
+
+		if err = grantGoods(ctx, tx, player.Id, product.Goods); err != nil {
+			return err
+		}
+
+		return selectBalances(ctx, tx, player)
+	})
+
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return player, nil
+}
+
+// CreatePendingPurchase stores real money purchase which is waiting for
+// payment. If purchase with the same id already exists it is returned instead.
+func (r *pgGameRepository) CreatePendingPurchase(ctx context.Context, purchase *model.Purchase) error {
+	logger := r.logger.For(ctx)
+
+	logger.Info("Create pending purchase",
+		log.String("player_id", purchase.PlayerId),
+		log.String("product_id", purchase.ProductId),
+		log.String("purchase_id", purchase.PurchaseId),
+	)
+
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		product, err := selectProduct(ctx, tx, purchase.ProductId)
+		if err != nil {
+			return err
+		}
+
+		if product == nil {
+			return code.ProductNotFound
+		}
+
+		if product.Currency != model.USD {
+			return code.CheckoutNotRequired
+		}
+
+		purchase.Price = product.Price
+		purchase.Currency = product.Currency
+		purchase.State = model.PENDING
+
+		res, err := tx.ModelContext(ctx, purchase).
+			OnConflict(`DO NOTHING`).
+			Insert()
+		if err != nil {
+			return err
+		}
+
+		if res.RowsAffected() != 0 {
+			return nil
+		}
+
+		// purchase has been already created by previous request
+		productId := purchase.ProductId
+		err = tx.ModelContext(ctx, purchase).
+			Where(`player_id = ?player_id`).
+			Where(`purchase_id = ?purchase_id`).
+			Select()
+		if err != nil {
+			return err
+		}
+
+		if purchase.ProductId != productId {
+			return code.PurchaseIdConflict
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		logger.Error(err)
+	}
 
-	err := r.DB.ModelContext(ctx, product).
-		Relation(`Goods.good_item_id`).
-		Relation(`Goods.amount`).
-		Relation(`Goods.GoodItem.title`).
-		Relation(`Goods.GoodItem.description`).
-		Where(`id = ?`, productId).
+	return err
+}
+
+func (r *pgGameRepository) FindPurchase(ctx context.Context, playerId, purchaseId string) (*model.Purchase, error) {
+	purchase := &model.Purchase{}
+	err := r.DB.ModelContext(ctx, purchase).
+		Where(`player_id = ?`, playerId).
+		Where(`purchase_id = ?`, purchaseId).
 		Select()
+	if err != nil {
+		if err != pg.ErrNoRows {
+			r.logger.For(ctx).Error(err)
+			return nil, err
+		}
 
+		// no purchase has been found
+		return nil, nil
+	}
+
+	return purchase, nil
+}
+
+// SetPurchaseCheckout stores checkout created for pending purchase. If
+// checkout has been stored by concurrent request, purchase is reloaded
+// with it instead, so every retry leads to the same checkout.
+func (r *pgGameRepository) SetPurchaseCheckout(ctx context.Context, purchase *model.Purchase) error {
+	res, err := r.DB.ModelContext(ctx, purchase).
+		Column(`external_id`, `checkout_url`).
+		WherePK().
+		Where(`external_id IS NULL`).
+		Update()
 	if err != nil {
+		r.logger.For(ctx).Error(err)
+		return err
+	}
+
+	if res.RowsAffected() != 0 {
+		return nil
+	}
+
+	if err = r.DB.ModelContext(ctx, purchase).WherePK().Select(); err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return err
+}
+
+// CompletePurchase grants goods of paid purchase. Completing already
+// completed purchase does nothing, so payment notifications may be repeated.
+func (r *pgGameRepository) CompletePurchase(ctx context.Context, id string) (*model.Purchase, *model.Player, error) {
+	return r.changePurchaseState(ctx, id, model.COMPLETED, func(tx *pg.Tx, purchase *model.Purchase) error {
+		if purchase.State != model.PENDING {
+			return code.InvalidPurchaseState
+		}
+
+		product, err := selectProduct(ctx, tx, purchase.ProductId)
+		if err != nil {
+			return err
+		}
+
+		return grantGoods(ctx, tx, purchase.PlayerId, product.Goods)
+	})
+}
+
+// RefundPurchase takes back goods granted by completed purchase.
+// Pending purchases are just marked as refunded.
+func (r *pgGameRepository) RefundPurchase(ctx context.Context, id string) (*model.Purchase, *model.Player, error) {
+	return r.changePurchaseState(ctx, id, model.REFUNDED, func(tx *pg.Tx, purchase *model.Purchase) error {
+		if purchase.State != model.COMPLETED {
+			return nil
+		}
+
+		product, err := selectProduct(ctx, tx, purchase.ProductId)
+		if err != nil {
+			return err
+		}
+
+		if err = revokeGoods(ctx, tx, purchase.PlayerId, product.Goods); err != nil {
+			return err
+		}
+
+		// in-game currency is returned to player
+		if _, ok := balanceColumns[purchase.Currency]; ok {
+			return updateBalance(ctx, tx, purchase.PlayerId, purchase.Currency, int64(purchase.Price))
+		}
+
+		return nil
+	})
+}
+
+func (r *pgGameRepository) changePurchaseState(ctx context.Context, id string, state model.PurchaseState, apply func(*pg.Tx, *model.Purchase) error) (*model.Purchase, *model.Player, error) {
+	logger := r.logger.For(ctx)
+
+	logger.Info("Change purchase state", log.String("id", id), log.String("state", string(state)))
+
+	purchase := &model.Purchase{}
+	purchase.Id = id
+	player := &model.Player{}
+
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		err := tx.ModelContext(ctx, purchase).
+			WherePK().
+			For(`UPDATE`).
+			Select()
+		if err != nil {
+			if err == pg.ErrNoRows {
+				return code.PurchaseNotFound
+			}
+			return err
+		}
+
+		player.Id = purchase.PlayerId
+
+		if purchase.State != state {
+			if err = apply(tx, purchase); err != nil {
+				return err
+			}
+
+			purchase.State = state
+			if _, err = tx.ModelContext(ctx, purchase).Column(`state`).WherePK().Update(); err != nil {
+				return err
+			}
+		}
+
+		return selectBalances(ctx, tx, player)
+	})
+
+	if err != nil {
+		logger.Error(err)
+		return nil, nil, err
+	}
+
+	return purchase, player, nil
+}
+
+func (r *pgGameRepository) GetInventory(ctx context.Context, playerId string) ([]*model.InventoryItem, error) {
+	items := []*model.InventoryItem{}
+	err := r.DB.ModelContext(ctx, &items).
+		Relation(`GoodItem`).
+		Where(`"inventory_item"."player_id" = ?`, playerId).
+		Where(`"inventory_item"."quantity" > 0`).
+		Where(`"inventory_item"."expires_at" IS NULL OR "inventory_item"."expires_at" > now()`).
+		Order(`inventory_item.created_at`).
+		Select()
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return items, err
+}
+
+func (r *pgGameRepository) FindInventoryItem(ctx context.Context, playerId, title string) (*model.InventoryItem, error) {
+	item := &model.InventoryItem{}
+	err := r.DB.ModelContext(ctx, item).
+		Relation(`GoodItem`).
+		Where(`"inventory_item"."player_id" = ?`, playerId).
+		Where(`"good_item"."title" = ?`, title).
+		Where(`"inventory_item"."quantity" > 0`).
+		Where(`"inventory_item"."expires_at" IS NULL OR "inventory_item"."expires_at" > now()`).
+		First()
+	if err != nil {
+		if err != pg.ErrNoRows {
+			r.logger.For(ctx).Error(err)
+			return nil, err
+		}
+
+		// player does not own item
+		return nil, nil
+	}
+
+	return item, nil
+}
+
+// ExpireInventoryItems removes up to limit items expired by now and
+// returns them. Items prolonged meanwhile are kept.
+func (r *pgGameRepository) ExpireInventoryItems(ctx context.Context, limit int) ([]*model.InventoryItem, error) {
+	logger := r.logger.For(ctx)
+
+	items := []*model.InventoryItem{}
+	err := r.DB.ModelContext(ctx, &items).
+		Relation(`GoodItem`).
+		Where(`"inventory_item"."expires_at" <= now()`).
+		Order(`inventory_item.expires_at`).
+		Limit(limit).
+		Select()
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if len(items) == 0 {
+		return items, nil
+	}
+
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.Id
+	}
+
+	deleted := []string{}
+	_, err = r.DB.ModelContext(ctx, (*model.InventoryItem)(nil)).
+		Where(`id IN (?)`, pg.In(ids)).
+		Where(`expires_at <= now()`).
+		Returning(`id`).
+		Delete(&deleted)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	expired := make([]*model.InventoryItem, 0, len(deleted))
+	for _, item := range items {
+		for _, id := range deleted {
+			if item.Id == id {
+				expired = append(expired, item)
+				break
+			}
+		}
+	}
+
+	return expired, nil
+}
+
+func (r *pgGameRepository) AddExp(ctx context.Context, playerId string, exp uint64) (*model.Player, error) {
+	player := &model.Player{}
+	player.Id = playerId
+
+	_, err := r.DB.ModelContext(ctx, player).
+		Set(`exp = exp + ?`, exp).
+		WherePK().
+		Returning(`id, level, exp, nuts, gold`).
+		Update()
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+		return nil, err
+	}
+
+	return player, nil
+}
+
+// LevelUp locks player and lets raise set the level reached and decide
+// on reward. Reward is granted in the same transaction, so concurrent
+// awards can't grant rewards of the same level twice.
+func (r *pgGameRepository) LevelUp(ctx context.Context, playerId string, raise func(*model.Player) model.Reward) (*model.Player, error) {
+	player := &model.Player{}
+	player.Id = playerId
+
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		err := tx.ModelContext(ctx, player).
+			Column(`id`, `level`, `exp`, `nuts`, `gold`).
+			WherePK().
+			For(`UPDATE`).
+			Select()
+		if err != nil {
+			return err
+		}
+
+		level := player.Level
+		reward := raise(player)
+		if player.Level <= level {
+			return nil
+		}
+
+		_, err = tx.ModelContext(ctx, player).
+			Set(`level = ?level`).
+			WherePK().
+			Update()
+		if err != nil {
+			return err
+		}
+
+		if err = grantReward(ctx, tx, playerId, reward); err != nil {
+			return err
+		}
+
+		return tx.ModelContext(ctx, player).
+			Column(`nuts`, `gold`).
+			WherePK().
+			Select()
+	})
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+		return nil, err
+	}
+
+	return player, nil
+}
+
+func (r *pgGameRepository) FindPlayer(ctx context.Context, playerId string) (*model.Player, error) {
+	player := &model.Player{}
+	player.Id = playerId
+
+	err := r.DB.ModelContext(ctx, player).
+		Relation(`Profile`).
+		Relation(`Sessions`, func(q *orm.Query) (*orm.Query, error) {
+			return q.Where(`closed_at IS NULL`), nil
+		}).
+		WherePK().
+		Select()
+	if err != nil {
+		if err != pg.ErrNoRows {
+			r.logger.For(ctx).Error(err)
+			return nil, err
+		}
+
+		// no player has been found
+		return nil, nil
+	}
+
+	return player, nil
+}
+
+// UpdateProfile overwrites all fields of player's profile.
+func (r *pgGameRepository) UpdateProfile(ctx context.Context, playerId string, profile *model.Profile) (*model.Player, error) {
+	logger := r.logger.For(ctx)
+
+	player := &model.Player{}
+	player.Id = playerId
+
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		err := tx.ModelContext(ctx, player).
+			WherePK().
+			For(`UPDATE`).
+			Select()
+		if err != nil {
+			return err
+		}
+
+		profile.Id = player.ProfileId
+		_, err = tx.ModelContext(ctx, profile).
+			Column(`first_name`, `last_name`, `age`, `gender`, `country`, `language`, `updated_at`).
+			WherePK().
+			Update()
+		if err != nil {
+			return err
+		}
+
+		player.Profile = profile
+		return nil
+	})
+
+	if err != nil {
+		if err == pg.ErrNoRows {
+			return nil, nil
+		}
 		logger.Error(err)
 		return nil, err
 	}
 
-	return nil, nil
+	return player, nil
 }
@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/go-pg/pg/v9"
+)
+
+// VoteRematch adds player's vote to rematch of the table. Nil is returned
+// if there is no rematch vote in progress.
+func (r *pgGameRepository) VoteRematch(ctx context.Context, tableId, playerId string) (*model.Rematch, error) {
+	rematch := &model.Rematch{}
+
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		err := tx.ModelContext(ctx, rematch).
+			Where(`table_id = ?`, tableId).
+			For(`UPDATE`).
+			Select()
+		if err != nil {
+			return err
+		}
+
+		if rematch.State != model.VOTING || time.Now().After(rematch.ExpiresAt) || rematch.HasVoted(playerId) {
+			return nil
+		}
+
+		rematch.Votes = append(rematch.Votes, playerId)
+		_, err = tx.ModelContext(ctx, rematch).
+			Set(`votes = ?votes`).
+			Set(`updated_at = now()`).
+			WherePK().
+			Update()
+		return err
+	})
+
+	if err != nil {
+		if err == pg.ErrNoRows {
+			return nil, nil
+		}
+		r.logger.For(ctx).Error(err)
+		return nil, err
+	}
+
+	if rematch.State != model.VOTING || time.Now().After(rematch.ExpiresAt) {
+		return nil, nil
+	}
+
+	return rematch, nil
+}
+
+// CloseRematch finishes rematch vote in progress with given state.
+// False is returned if vote has already been finished.
+func (r *pgGameRepository) CloseRematch(ctx context.Context, tableId string, state model.RematchState) (bool, error) {
+	res, err := r.DB.ModelContext(ctx, &model.Rematch{}).
+		Set(`state = ?`, state).
+		Set(`updated_at = now()`).
+		Where(`table_id = ?`, tableId).
+		Where(`state = ?`, model.VOTING).
+		Update()
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+		return false, err
+	}
+
+	return res.RowsAffected() != 0, nil
+}
+
+// StartRematch closes rematch vote of finished table and creates table
+// of the next game with its participants in one transaction. False is
+// returned and nothing is created if vote has already been finished.
+func (r *pgGameRepository) StartRematch(ctx context.Context, tableId string, next *model.Table) (bool, error) {
+	started := false
+
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		res, err := tx.ModelContext(ctx, &model.Rematch{}).
+			Set(`state = ?`, model.STARTED).
+			Set(`updated_at = now()`).
+			Where(`table_id = ?`, tableId).
+			Where(`state = ?`, model.VOTING).
+			Update()
+		if err != nil || res.RowsAffected() == 0 {
+			return err
+		}
+
+		if _, err = tx.ModelContext(ctx, next).Insert(); err != nil {
+			return err
+		}
+
+		for _, p := range next.Participants {
+			p.TableId = next.Id
+		}
+
+		if _, err = tx.ModelContext(ctx, &next.Participants).Insert(); err != nil {
+			return err
+		}
+
+		_, err = tx.ModelContext(ctx, &model.Rematch{}).
+			Set(`new_table_id = ?`, next.Id).
+			Where(`table_id = ?`, tableId).
+			Update()
+		if err != nil {
+			return err
+		}
+
+		started = true
+		return nil
+	})
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+		return false, err
+	}
+
+	return started, nil
+}
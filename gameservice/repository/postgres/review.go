@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/go-pg/pg/v9"
+	"github.com/go-pg/pg/v9/orm"
+)
+
+// GetPlayedTables returns tables closed since given time after the game
+// was started, with participants and every move played. Tables are
+// ordered by closing time, so they can be paged through.
+func (r *pgGameRepository) GetPlayedTables(ctx context.Context, since time.Time, offset, limit int) ([]*model.Table, error) {
+	tables := []*model.Table{}
+	err := r.DB.ModelContext(ctx, &tables).
+		Relation(`Participants`).
+		Relation(`Rounds`, func(q *orm.Query) (*orm.Query, error) {
+			return q.Order(`start_time`), nil
+		}).
+		Relation(`Rounds.Deals`, func(q *orm.Query) (*orm.Query, error) {
+			return q.Order(`start_time`), nil
+		}).
+		Relation(`Rounds.Deals.DealOrders`, func(q *orm.Query) (*orm.Query, error) {
+			return q.Order(`start_time`), nil
+		}).
+		Where(`"table"."state" IN (?)`, pg.In([]model.TableState{model.GAME_FINISHED, model.ABANDONED})).
+		Where(`"table"."start_time" IS NOT NULL`).
+		Where(`"table"."end_time" >= ?`, since).
+		Order(`end_time`, `id`).
+		Offset(offset).
+		Limit(limit).
+		Select()
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return tables, err
+}
+
+// GetSessionsOfPlayers returns sessions of given players opened since
+// given time.
+func (r *pgGameRepository) GetSessionsOfPlayers(ctx context.Context, playerIds []string, since time.Time) ([]*model.Session, error) {
+	sessions := []*model.Session{}
+	if len(playerIds) == 0 {
+		return sessions, nil
+	}
+
+	err := r.DB.ModelContext(ctx, &sessions).
+		Where(`player_id IN (?)`, pg.In(playerIds)).
+		Where(`created_at >= ?`, since).
+		Select()
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return sessions, err
+}
+
+// QueueReviewCases adds new cases to review queue and refreshes evidence
+// of cases still open. Resolved cases are kept as they are, so dismissed
+// pattern is not raised again. Number of added or refreshed cases is
+// returned.
+func (r *pgGameRepository) QueueReviewCases(ctx context.Context, cases ...*model.ReviewCase) (int, error) {
+	if len(cases) == 0 {
+		return 0, nil
+	}
+
+	res, err := r.DB.ModelContext(ctx, &cases).
+		OnConflict(`(key) DO UPDATE`).
+		Set(`player_ids = EXCLUDED.player_ids`).
+		Set(`table_ids = EXCLUDED.table_ids`).
+		Set(`score = EXCLUDED.score`).
+		Set(`details = EXCLUDED.details`).
+		Set(`updated_at = now()`).
+		Where(`?TableAlias.state = ?`, model.UNDER_REVIEW).
+		Insert()
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+		return 0, err
+	}
+
+	return res.RowsAffected(), nil
+}
+
+// GetReviewCases returns review queue, the most suspicious cases first.
+// Queue is filtered by state and kind when they are given.
+func (r *pgGameRepository) GetReviewCases(ctx context.Context, state model.ReviewState, kind model.ReviewKind, offset, limit int) ([]*model.ReviewCase, int, error) {
+	cases := []*model.ReviewCase{}
+	query := r.DB.ModelContext(ctx, &cases)
+
+	if state != "" {
+		query.Where(`state = ?`, state)
+	}
+
+	if kind != "" {
+		query.Where(`kind = ?`, kind)
+	}
+
+	total, err := query.
+		Order(`score DESC`, `updated_at DESC`).
+		Offset(offset).
+		Limit(limit).
+		SelectAndCount()
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+		return nil, 0, err
+	}
+
+	return cases, total, nil
+}
+
+// ResolveReviewCase records admin decision on open case. Nil is returned
+// when there is no such open case.
+func (r *pgGameRepository) ResolveReviewCase(ctx context.Context, caseId string, state model.ReviewState, reviewerId, note string) (*model.ReviewCase, error) {
+	now := time.Now()
+	rc := &model.ReviewCase{
+		State:      state,
+		ReviewerId: reviewerId,
+		Note:       note,
+		ReviewedAt: now,
+	}
+	rc.Id = caseId
+	rc.UpdatedAt = now
+
+	_, err := r.DB.ModelContext(ctx, rc).
+		Column(`state`, `reviewer_id`, `note`, `reviewed_at`, `updated_at`).
+		WherePK().
+		Where(`state = ?`, model.UNDER_REVIEW).
+		Returning(`*`).
+		Update()
+	if err != nil {
+		if err != pg.ErrNoRows {
+			r.logger.For(ctx).Error(err)
+			return nil, err
+		}
+
+		return nil, nil
+	}
+
+	return rc, nil
+}
@@ -0,0 +1,187 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/go-pg/pg/v9"
+)
+
+// ClaimDailyReward locks player and lets claim update streak and decide
+// on reward. Reward is granted in the same transaction, so concurrent
+// claims can't grant it twice.
+func (r *pgGameRepository) ClaimDailyReward(ctx context.Context, playerId string, claim func(*model.Player) (model.Reward, error)) (*model.Player, error) {
+	player := &model.Player{}
+	player.Id = playerId
+
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		err := tx.ModelContext(ctx, player).
+			Column(`id`, `streak`, `last_reward_at`).
+			WherePK().
+			For(`UPDATE`).
+			Select()
+		if err != nil {
+			return err
+		}
+
+		reward, err := claim(player)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ModelContext(ctx, player).
+			Set(`streak = ?streak`).
+			Set(`last_reward_at = ?last_reward_at`).
+			Set(`updated_at = now()`).
+			WherePK().
+			Update()
+		if err != nil {
+			return err
+		}
+
+		if err = grantReward(ctx, tx, playerId, reward); err != nil {
+			return err
+		}
+
+		return selectBalances(ctx, tx, player)
+	})
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+		return nil, err
+	}
+
+	return player, nil
+}
+
+// CreateDailyQuests inserts quests of a day. Quests already created by
+// another instance are kept and returned as is.
+func (r *pgGameRepository) CreateDailyQuests(ctx context.Context, quests []*model.DailyQuest) ([]*model.DailyQuest, error) {
+	if len(quests) == 0 {
+		return quests, nil
+	}
+
+	_, err := r.DB.ModelContext(ctx, &quests).
+		OnConflict(`(quest_id, starts_at) DO NOTHING`).
+		Insert()
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+		return nil, err
+	}
+
+	created := []*model.DailyQuest{}
+	err = r.DB.ModelContext(ctx, &created).
+		Where(`starts_at = ?`, quests[0].StartsAt).
+		Select()
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return created, err
+}
+
+func (r *pgGameRepository) ExpireDailyQuest(ctx context.Context, id string) error {
+	_, err := r.DB.ModelContext(ctx, &model.DailyQuest{}).
+		Set(`expired_at = now()`).
+		Set(`updated_at = now()`).
+		Where(`id = ?`, id).
+		Where(`expired_at IS NULL`).
+		Update()
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return err
+}
+
+func (r *pgGameRepository) GetActiveQuests(ctx context.Context, now time.Time) ([]*model.DailyQuest, error) {
+	quests := []*model.DailyQuest{}
+	err := r.DB.ModelContext(ctx, &quests).
+		Where(`starts_at <= ?`, now).
+		Where(`expires_at > ?`, now).
+		Where(`expired_at IS NULL`).
+		Order(`starts_at`, `quest_id`).
+		Select()
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return quests, err
+}
+
+func (r *pgGameRepository) GetQuestProgress(ctx context.Context, playerId string, dailyQuestIds []string) ([]*model.QuestProgress, error) {
+	progress := []*model.QuestProgress{}
+	if len(dailyQuestIds) == 0 {
+		return progress, nil
+	}
+
+	err := r.DB.ModelContext(ctx, &progress).
+		Where(`player_id = ?`, playerId).
+		Where(`daily_quest_id IN (?)`, pg.In(dailyQuestIds)).
+		Select()
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return progress, err
+}
+
+// AdvanceQuest increments player's progress of daily quest and completes
+// it with reward once goal is reached. Player with updated balances is
+// returned only if quest has been completed by the call.
+func (r *pgGameRepository) AdvanceQuest(ctx context.Context, playerId, dailyQuestId string, goal int, reward model.Reward) (*model.QuestProgress, *model.Player, error) {
+	progress := &model.QuestProgress{
+		PlayerId:     playerId,
+		DailyQuestId: dailyQuestId,
+		Progress:     1,
+	}
+
+	var player *model.Player
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		res, err := tx.ModelContext(ctx, progress).
+			OnConflict(`(player_id, daily_quest_id) DO UPDATE`).
+			Set(`progress = ?TableAlias.progress + 1`).
+			Set(`updated_at = now()`).
+			Where(`?TableAlias.completed_at IS NULL`).
+			Returning(`*`).
+			Insert()
+		if err != nil {
+			// already completed, nothing has been updated
+			if err == pg.ErrNoRows {
+				return nil
+			}
+			return err
+		}
+
+		if res.RowsAffected() == 0 || progress.IsCompleted() || progress.Progress < goal {
+			return nil
+		}
+
+		_, err = tx.ModelContext(ctx, progress).
+			Set(`completed_at = now()`).
+			WherePK().
+			Returning(`completed_at`).
+			Update()
+		if err != nil {
+			return err
+		}
+
+		if err = grantReward(ctx, tx, playerId, reward); err != nil {
+			return err
+		}
+
+		player = &model.Player{}
+		player.Id = playerId
+		return selectBalances(ctx, tx, player)
+	})
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+		return nil, nil, err
+	}
+
+	return progress, player, nil
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/go-pg/pg/v9"
+	"github.com/go-pg/pg/v9/orm"
+)
+
+// UpdateParticipant saves given columns of participant and marks its
+// table as updated, so waiting table players keep joining is not idle.
+func (r *pgGameRepository) UpdateParticipant(ctx context.Context, p *model.Participant, columns ...string) error {
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		_, err := tx.ModelContext(ctx, p).
+			Column(columns...).
+			WherePK().
+			Update()
+		if err != nil {
+			return err
+		}
+
+		return touchTable(ctx, tx, p.TableId)
+	})
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return err
+}
+
+// ChangeSeating saves players of given seats and resets ready
+// participants of the table, as seating has changed.
+func (r *pgGameRepository) ChangeSeating(ctx context.Context, tableId string, seats ...*model.Participant) error {
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		for _, p := range seats {
+			_, err := tx.ModelContext(ctx, p).
+				Column(`player_id`, `state`).
+				WherePK().
+				Update()
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err := tx.ModelContext(ctx, &model.Participant{}).
+			Set(`state = ?`, model.BUSY).
+			Where(`table_id = ?`, tableId).
+			Where(`state = ?`, model.READY).
+			Update()
+		if err != nil {
+			return err
+		}
+
+		return touchTable(ctx, tx, tableId)
+	})
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return err
+}
+
+// AcceptSeatSwap marks seat swap as accepted. Nil is returned if swap
+// does not exist, has expired or has already been accepted.
+func (r *pgGameRepository) AcceptSeatSwap(ctx context.Context, id, playerId string) (*model.SeatSwap, error) {
+	swap := &model.SeatSwap{}
+	swap.Id = id
+
+	_, err := r.DB.ModelContext(ctx, swap).
+		Set(`accepted_at = now()`).
+		WherePK().
+		Where(`target_id = ?`, playerId).
+		Where(`accepted_at IS NULL`).
+		Where(`expires_at > now()`).
+		Returning(`*`).
+		Update()
+	if err != nil {
+		if err != pg.ErrNoRows {
+			r.logger.For(ctx).Error(err)
+			return nil, err
+		}
+
+		return nil, nil
+	}
+
+	return swap, nil
+}
+
+// touchTable updates table timestamp idle tables are detected by.
+func touchTable(ctx context.Context, db orm.DB, tableId string) error {
+	_, err := db.ModelContext(ctx, &model.Table{}).
+		Set(`updated_at = now()`).
+		Where(`id = ?`, tableId).
+		Update()
+	return err
+}
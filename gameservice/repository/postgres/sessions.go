@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/go-pg/pg/v9"
+)
+
+// TakeOverSessions closes replaced sessions and opens the new one
+// in a single transaction, so player always has an open session.
+func (r *pgGameRepository) TakeOverSessions(ctx context.Context, session *model.Session, replaced ...*model.Session) error {
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		if len(replaced) != 0 {
+			ids := make([]string, len(replaced))
+			for i, s := range replaced {
+				ids[i] = s.Id
+			}
+
+			_, err := tx.ModelContext(ctx, &model.Session{}).
+				Set(`closed_at = now()`).
+				Set(`controller = false`).
+				Set(`updated_at = now()`).
+				Where(`id IN (?)`, pg.In(ids)).
+				Where(`closed_at IS NULL`).
+				Update()
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err := tx.ModelContext(ctx, session).Insert()
+		return err
+	})
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return err
+}
+
+// GetOpenSessions returns open sessions of player, oldest first.
+func (r *pgGameRepository) GetOpenSessions(ctx context.Context, playerId string) ([]*model.Session, error) {
+	sessions := []*model.Session{}
+	err := r.DB.ModelContext(ctx, &sessions).
+		Where(`player_id = ?`, playerId).
+		Where(`closed_at IS NULL`).
+		Order(`created_at`).
+		Select()
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return sessions, err
+}
+
+// SetSeatController makes given open session the only seat controller
+// of player. It reports false if the session is not open.
+func (r *pgGameRepository) SetSeatController(ctx context.Context, playerId, sessionId string) (bool, error) {
+	set := false
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		count, err := tx.ModelContext(ctx, &model.Session{}).
+			Where(`id = ?`, sessionId).
+			Where(`player_id = ?`, playerId).
+			Where(`closed_at IS NULL`).
+			Count()
+		if err != nil || count == 0 {
+			return err
+		}
+
+		_, err = tx.ModelContext(ctx, &model.Session{}).
+			Set(`controller = (id = ?)`, sessionId).
+			Set(`updated_at = now()`).
+			Where(`player_id = ?`, playerId).
+			Where(`closed_at IS NULL`).
+			Update()
+		set = err == nil
+		return err
+	})
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return set, err
+}
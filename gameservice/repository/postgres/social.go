@@ -0,0 +1,269 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/Handzo/gogame/gameservice/code"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/go-pg/pg/v9"
+	"github.com/go-pg/pg/v9/orm"
+)
+
+// GetFriendships returns player's own relations and incoming friend
+// requests with open sessions of both sides.
+func (r *pgGameRepository) GetFriendships(ctx context.Context, playerId string) ([]*model.Friendship, error) {
+	openSessions := func(q *orm.Query) (*orm.Query, error) {
+		return q.Where(`closed_at IS NULL`), nil
+	}
+
+	friendships := []*model.Friendship{}
+	err := r.DB.ModelContext(ctx, &friendships).
+		Relation(`Player`).
+		Relation(`Player.Sessions`, openSessions).
+		Relation(`Friend`).
+		Relation(`Friend.Sessions`, openSessions).
+		WhereGroup(func(q *orm.Query) (*orm.Query, error) {
+			return q.
+				Where(`"friendship"."player_id" = ?`, playerId).
+				WhereOrGroup(func(q *orm.Query) (*orm.Query, error) {
+					return q.
+						Where(`"friendship"."friend_id" = ?`, playerId).
+						Where(`"friendship"."state" = ?`, model.REQUESTED), nil
+				}), nil
+		}).
+		Order(`friendship.created_at`).
+		Select()
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return friendships, err
+}
+
+// GetFriendIds returns ids of player's accepted friends.
+func (r *pgGameRepository) GetFriendIds(ctx context.Context, playerId string) ([]string, error) {
+	var ids []string
+	err := r.DB.ModelContext(ctx, (*model.Friendship)(nil)).
+		Column(`friend_id`).
+		Where(`player_id = ?`, playerId).
+		Where(`state = ?`, model.ACCEPTED).
+		Select(&ids)
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return ids, err
+}
+
+func (r *pgGameRepository) FindFriendship(ctx context.Context, playerId, friendId string) (*model.Friendship, error) {
+	friendship, err := findFriendship(ctx, r.DB, playerId, friendId, false)
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return friendship, err
+}
+
+// RequestFriendship sends friend request. Pending request in opposite
+// direction is accepted instead.
+func (r *pgGameRepository) RequestFriendship(ctx context.Context, playerId, friendId string) (*model.Friendship, error) {
+	logger := r.logger.For(ctx)
+
+	var friendship *model.Friendship
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		reverse, err := findFriendship(ctx, tx, friendId, playerId, true)
+		if err != nil {
+			return err
+		}
+
+		own, err := findFriendship(ctx, tx, playerId, friendId, true)
+		if err != nil {
+			return err
+		}
+
+		if reverse != nil {
+			switch reverse.State {
+			case model.BLOCKED:
+				return code.PlayerBlocked
+			case model.ACCEPTED:
+				return code.AlreadyFriends
+			case model.REQUESTED:
+				friendship, err = setFriendship(ctx, tx, playerId, friendId, model.ACCEPTED)
+				if err != nil {
+					return err
+				}
+				_, err = setFriendship(ctx, tx, friendId, playerId, model.ACCEPTED)
+				return err
+			}
+		}
+
+		if own != nil && own.State == model.REQUESTED {
+			friendship = own
+			return nil
+		}
+
+		// sending request to blocked player lifts the block
+		friendship, err = setFriendship(ctx, tx, playerId, friendId, model.REQUESTED)
+		return err
+	})
+
+	if err != nil {
+		if err != code.PlayerBlocked && err != code.AlreadyFriends {
+			logger.Error(err)
+		}
+		return nil, err
+	}
+
+	return friendship, nil
+}
+
+// AcceptFriendship accepts friend request sent by friend to player.
+func (r *pgGameRepository) AcceptFriendship(ctx context.Context, playerId, friendId string) (*model.Friendship, error) {
+	logger := r.logger.For(ctx)
+
+	var friendship *model.Friendship
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		request, err := findFriendship(ctx, tx, friendId, playerId, true)
+		if err != nil {
+			return err
+		}
+
+		if request == nil || request.State != model.REQUESTED {
+			return code.FriendRequestNotFound
+		}
+
+		if _, err = setFriendship(ctx, tx, friendId, playerId, model.ACCEPTED); err != nil {
+			return err
+		}
+
+		friendship, err = setFriendship(ctx, tx, playerId, friendId, model.ACCEPTED)
+		return err
+	})
+
+	if err != nil {
+		if err != code.FriendRequestNotFound {
+			logger.Error(err)
+		}
+		return nil, err
+	}
+
+	return friendship, nil
+}
+
+// RemoveFriendship removes friend, cancels or declines friend request
+// and lifts player's block. Block set by friend is kept.
+func (r *pgGameRepository) RemoveFriendship(ctx context.Context, playerId, friendId string) error {
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		_, err := tx.ModelContext(ctx, (*model.Friendship)(nil)).
+			Where(`player_id = ?`, playerId).
+			Where(`friend_id = ?`, friendId).
+			Delete()
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ModelContext(ctx, (*model.Friendship)(nil)).
+			Where(`player_id = ?`, friendId).
+			Where(`friend_id = ?`, playerId).
+			Where(`state != ?`, model.BLOCKED).
+			Delete()
+		return err
+	})
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return err
+}
+
+// BlockPlayer breaks any relation with friend and blocks requests from friend.
+func (r *pgGameRepository) BlockPlayer(ctx context.Context, playerId, friendId string) error {
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		_, err := tx.ModelContext(ctx, (*model.Friendship)(nil)).
+			Where(`player_id = ?`, friendId).
+			Where(`friend_id = ?`, playerId).
+			Where(`state != ?`, model.BLOCKED).
+			Delete()
+		if err != nil {
+			return err
+		}
+
+		_, err = setFriendship(ctx, tx, playerId, friendId, model.BLOCKED)
+		return err
+	})
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return err
+}
+
+// AcceptInvitation marks invitation as accepted. Nil is returned if
+// invitation does not exist, has expired or has already been accepted.
+func (r *pgGameRepository) AcceptInvitation(ctx context.Context, id, playerId string) (*model.Invitation, error) {
+	invitation := &model.Invitation{}
+	invitation.Id = id
+
+	_, err := r.DB.ModelContext(ctx, invitation).
+		Set(`accepted_at = now()`).
+		WherePK().
+		Where(`to_id = ?`, playerId).
+		Where(`accepted_at IS NULL`).
+		Where(`expires_at > now()`).
+		Returning(`*`).
+		Update()
+	if err != nil {
+		if err != pg.ErrNoRows {
+			r.logger.For(ctx).Error(err)
+			return nil, err
+		}
+
+		return nil, nil
+	}
+
+	return invitation, nil
+}
+
+func findFriendship(ctx context.Context, db orm.DB, playerId, friendId string, lock bool) (*model.Friendship, error) {
+	friendship := &model.Friendship{}
+	query := db.ModelContext(ctx, friendship).
+		Where(`player_id = ?`, playerId).
+		Where(`friend_id = ?`, friendId)
+
+	if lock {
+		query = query.For(`UPDATE`)
+	}
+
+	if err := query.Select(); err != nil {
+		if err == pg.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return friendship, nil
+}
+
+func setFriendship(ctx context.Context, db orm.DB, playerId, friendId string, state model.FriendshipState) (*model.Friendship, error) {
+	friendship := &model.Friendship{
+		PlayerId: playerId,
+		FriendId: friendId,
+		State:    state,
+	}
+
+	_, err := db.ModelContext(ctx, friendship).
+		OnConflict(`(player_id, friend_id) DO UPDATE`).
+		Set(`state = EXCLUDED.state`).
+		Set(`updated_at = now()`).
+		Returning(`*`).
+		Insert()
+	if err != nil {
+		return nil, err
+	}
+
+	return friendship, nil
+}
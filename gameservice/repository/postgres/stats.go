@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/go-pg/pg/v9"
+)
+
+var statsColumns = []string{
+	"games_played",
+	"games_won",
+	"rounds_played",
+	"rounds_won",
+	"card_points",
+	"club_jacks",
+	"trump_clubs",
+	"trump_spades",
+	"trump_hearts",
+	"trump_diamonds",
+}
+
+// IncrementPlayerStats adds counters of given stats to players' aggregates.
+func (r *pgGameRepository) IncrementPlayerStats(ctx context.Context, stats ...*model.PlayerStats) error {
+	if len(stats) == 0 {
+		return nil
+	}
+
+	query := r.DB.ModelContext(ctx, &stats).
+		OnConflict(`(player_id) DO UPDATE`).
+		Set(`updated_at = now()`)
+
+	for _, c := range statsColumns {
+		query = query.Set(`? = ?TableAlias.? + EXCLUDED.?`, pg.Ident(c), pg.Ident(c), pg.Ident(c))
+	}
+
+	if _, err := query.Insert(); err != nil {
+		r.logger.For(ctx).Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// FindPlayerStats returns player's aggregates, empty stats if player
+// has not finished any round yet.
+func (r *pgGameRepository) FindPlayerStats(ctx context.Context, playerId string) (*model.PlayerStats, error) {
+	stats := &model.PlayerStats{}
+	err := r.DB.ModelContext(ctx, stats).
+		Where(`player_id = ?`, playerId).
+		Select()
+	if err != nil {
+		if err != pg.ErrNoRows {
+			r.logger.For(ctx).Error(err)
+			return nil, err
+		}
+
+		return &model.PlayerStats{PlayerId: playerId}, nil
+	}
+
+	return stats, nil
+}
+
+// GetMatchHistory returns finished tables of player, latest first,
+// with participants and total count of tables.
+func (r *pgGameRepository) GetMatchHistory(ctx context.Context, playerId string, offset, limit int) ([]*model.Table, int, error) {
+	logger := r.logger.For(ctx)
+
+	tables := []*model.Table{}
+	count, err := r.DB.ModelContext(ctx, &tables).
+		Relation(`Participants`).
+		Relation(`Participants.Player`).
+		Where(`"table"."state" = ?`, model.GAME_FINISHED).
+		Where(`EXISTS (SELECT 1 FROM participants AS p WHERE p.table_id = "table"."id" AND p.player_id = ?)`, playerId).
+		Order(`table.end_time DESC`).
+		Offset(offset).
+		Limit(limit).
+		SelectAndCount()
+	if err != nil {
+		logger.Error(err)
+		return nil, 0, err
+	}
+
+	return tables, count, nil
+}
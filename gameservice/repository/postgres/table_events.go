@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/Handzo/gogame/gameservice/code"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/go-pg/pg/v9"
+	"github.com/go-pg/pg/v9/orm"
+)
+
+// tableEventAttempts limits retries of appending event which raced
+// with another event of the same table for the sequence number.
+const tableEventAttempts = 5
+
+// AppendTableEvent adds event to the end of table log and sets
+// its sequence number.
+func (r *pgGameRepository) AppendTableEvent(ctx context.Context, event *model.TableEvent) error {
+	err := appendTableEvent(ctx, r.DB, event)
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return err
+}
+
+// appendTableEvent inserts event with the next sequence number. Insert
+// skipped on conflict returns no row and is retried with a new number.
+func appendTableEvent(ctx context.Context, db orm.DB, event *model.TableEvent) error {
+	for attempt := 0; attempt < tableEventAttempts; attempt++ {
+		_, err := db.ModelContext(ctx, event).
+			Value(`seq`, `(SELECT COALESCE(MAX(seq), 0) + 1 FROM table_events WHERE table_id = ?)`, event.TableId).
+			OnConflict(`(table_id, seq) DO NOTHING`).
+			Returning(`id`, `seq`).
+			Insert()
+		if err != pg.ErrNoRows {
+			return err
+		}
+	}
+
+	return code.TableLogConflict
+}
+
+// GetTableEvents returns events of table logged after given sequence
+// number in order.
+func (r *pgGameRepository) GetTableEvents(ctx context.Context, tableId string, after int) ([]*model.TableEvent, error) {
+	events := []*model.TableEvent{}
+	err := r.DB.ModelContext(ctx, &events).
+		Where(`table_id = ?`, tableId).
+		Where(`seq > ?`, after).
+		Order(`seq`).
+		Select()
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return events, err
+}
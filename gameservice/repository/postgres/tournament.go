@@ -0,0 +1,473 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Handzo/gogame/gameservice/code"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/go-pg/pg/v9"
+	"github.com/go-pg/pg/v9/orm"
+)
+
+// CreateTournament inserts tournament unless it has already been
+// scheduled by another instance. True is returned if it has been created.
+func (r *pgGameRepository) CreateTournament(ctx context.Context, tournament *model.Tournament) (bool, error) {
+	res, err := r.DB.ModelContext(ctx, tournament).
+		OnConflict(`(template_id, starts_at) DO NOTHING`).
+		Insert()
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+		return false, err
+	}
+
+	return res.RowsAffected() != 0, nil
+}
+
+func (r *pgGameRepository) GetTournaments(ctx context.Context, states ...model.TournamentState) ([]*model.Tournament, error) {
+	tournaments := []*model.Tournament{}
+	err := r.DB.ModelContext(ctx, &tournaments).
+		Relation(`Entries`, func(q *orm.Query) (*orm.Query, error) {
+			return q.Column(`id`, `tournament_id`), nil
+		}).
+		Where(`state IN (?)`, pg.In(states)).
+		Order(`starts_at`).
+		Select()
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return tournaments, err
+}
+
+// FindTournament returns tournament with entries in registration order.
+func (r *pgGameRepository) FindTournament(ctx context.Context, id string) (*model.Tournament, error) {
+	tournament := &model.Tournament{}
+	err := r.DB.ModelContext(ctx, tournament).
+		Relation(`Entries`, func(q *orm.Query) (*orm.Query, error) {
+			return q.Order(`created_at`), nil
+		}).
+		Relation(`Entries.Player`).
+		Relation(`Entries.Partner`).
+		Where(`"tournament"."id" = ?`, id).
+		Select()
+	if err != nil {
+		if err != pg.ErrNoRows {
+			r.logger.For(ctx).Error(err)
+			return nil, err
+		}
+
+		return nil, nil
+	}
+
+	return tournament, nil
+}
+
+// RegisterTournament adds entry to tournament open for registration.
+// Every player pays own entry fee. Team entry waits for partner to
+// register back with the player, which accepts the entry and is
+// returned in it.
+func (r *pgGameRepository) RegisterTournament(ctx context.Context, entry *model.TournamentEntry) (*model.Player, error) {
+	player := &model.Player{}
+	player.Id = entry.PlayerId
+
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		tournament, err := lockTournament(ctx, tx, entry.TournamentId)
+		if err != nil {
+			return err
+		}
+
+		if tournament.State != model.REGISTRATION || !time.Now().Before(tournament.StartsAt) {
+			return code.RegistrationClosed
+		}
+
+		// partner registering back accepts pending team entry
+		pending := &model.TournamentEntry{}
+		accepting := false
+		if entry.PartnerId != "" {
+			err = tx.ModelContext(ctx, pending).
+				Where(`tournament_id = ?`, tournament.Id).
+				Where(`player_id = ?`, entry.PartnerId).
+				Where(`partner_id = ?`, entry.PlayerId).
+				Where(`NOT accepted`).
+				Select()
+			if err != nil && err != pg.ErrNoRows {
+				return err
+			}
+
+			accepting = err == nil
+		}
+
+		query := tx.ModelContext(ctx, &model.TournamentEntry{}).
+			Where(`tournament_id = ?`, tournament.Id).
+			Where(`player_id IN (?) OR partner_id IN (?)`, pg.In(entry.Players()), pg.In(entry.Players()))
+		if accepting {
+			query.Where(`id != ?`, pending.Id)
+		}
+
+		registered, err := query.Exists()
+		if err != nil {
+			return err
+		}
+
+		if registered {
+			return code.AlreadyRegistered
+		}
+
+		if accepting {
+			return acceptEntry(ctx, tx, tournament, pending, entry, player)
+		}
+
+		count, err := tx.ModelContext(ctx, &model.TournamentEntry{}).
+			Where(`tournament_id = ?`, tournament.Id).
+			Count()
+		if err != nil {
+			return err
+		}
+
+		if count >= tournament.MaxEntries {
+			return code.TournamentFull
+		}
+
+		entry.Accepted = false
+		if _, err = tx.ModelContext(ctx, entry).Insert(); err != nil {
+			return err
+		}
+
+		if err = payEntry(ctx, tx, tournament, entry.PlayerId); err != nil {
+			return err
+		}
+
+		return selectBalances(ctx, tx, player)
+	})
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+		return nil, err
+	}
+
+	return player, nil
+}
+
+// UnregisterTournament removes player's entry and refunds entry fees
+// while registration is still open. Either player of team entry can
+// withdraw it.
+func (r *pgGameRepository) UnregisterTournament(ctx context.Context, tournamentId, playerId string) (*model.Player, error) {
+	player := &model.Player{}
+	player.Id = playerId
+
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		tournament, err := lockTournament(ctx, tx, tournamentId)
+		if err != nil {
+			return err
+		}
+
+		if tournament.State != model.REGISTRATION {
+			return code.RegistrationClosed
+		}
+
+		entry := &model.TournamentEntry{}
+		_, err = tx.ModelContext(ctx, entry).
+			Where(`tournament_id = ?`, tournamentId).
+			Where(`player_id = ? OR partner_id = ?`, playerId, playerId).
+			Returning(`*`).
+			Delete()
+		if err != nil {
+			if err == pg.ErrNoRows {
+				return code.NotRegistered
+			}
+			return err
+		}
+
+		if err = refundEntry(ctx, tx, tournament, entry); err != nil {
+			return err
+		}
+
+		return selectBalances(ctx, tx, player)
+	})
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+		return nil, err
+	}
+
+	return player, nil
+}
+
+// DropPendingEntries removes team entries partner has not accepted
+// and refunds their fees. Removed entries are returned.
+func (r *pgGameRepository) DropPendingEntries(ctx context.Context, tournamentId string) ([]*model.TournamentEntry, error) {
+	entries := []*model.TournamentEntry{}
+
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		tournament, err := lockTournament(ctx, tx, tournamentId)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ModelContext(ctx, &entries).
+			Where(`tournament_id = ?`, tournamentId).
+			Where(`partner_id IS NOT NULL`).
+			Where(`NOT accepted`).
+			Returning(`*`).
+			Delete()
+		if err != nil {
+			return err
+		}
+
+		for _, e := range entries {
+			if err = refundEntry(ctx, tx, tournament, e); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// CancelTournament refunds entry fees of all entries.
+func (r *pgGameRepository) CancelTournament(ctx context.Context, tournamentId string) error {
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		tournament, err := lockTournament(ctx, tx, tournamentId)
+		if err != nil {
+			return err
+		}
+
+		if tournament.State != model.REGISTRATION {
+			return code.RegistrationClosed
+		}
+
+		entries := []*model.TournamentEntry{}
+		err = tx.ModelContext(ctx, &entries).
+			Where(`tournament_id = ?`, tournamentId).
+			Select()
+		if err != nil {
+			return err
+		}
+
+		for _, e := range entries {
+			if err = refundEntry(ctx, tx, tournament, e); err != nil {
+				return err
+			}
+		}
+
+		_, err = tx.ModelContext(ctx, tournament).
+			Set(`state = ?`, model.CANCELLED).
+			Set(`updated_at = now()`).
+			WherePK().
+			Update()
+		return err
+	})
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return err
+}
+
+// StartTournamentRound saves round of tournament together with entries
+// changed by pairing and creates tables of the round with their
+// participants and events. The first round also closes registration.
+// False is returned and nothing is saved if the round has already been
+// started.
+func (r *pgGameRepository) StartTournamentRound(ctx context.Context, tournament *model.Tournament, entries []*model.TournamentEntry, tables []*model.Table) (bool, error) {
+	started := false
+
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		res, err := tx.ModelContext(ctx, tournament).
+			Set(`round = ?round`).
+			Set(`state = ?`, model.RUNNING).
+			Set(`updated_at = now()`).
+			WherePK().
+			Where(`state IN (?)`, pg.In([]model.TournamentState{model.REGISTRATION, model.RUNNING})).
+			Where(`round = ?`, tournament.Round-1).
+			Update()
+		if err != nil || res.RowsAffected() == 0 {
+			return err
+		}
+
+		for _, e := range entries {
+			_, err = tx.ModelContext(ctx, e).
+				Column(`points`, `opponents`).
+				WherePK().
+				Update()
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, table := range tables {
+			if _, err = tx.ModelContext(ctx, table).Insert(); err != nil {
+				return err
+			}
+
+			for _, p := range table.Participants {
+				p.TableId = table.Id
+			}
+
+			if _, err = tx.ModelContext(ctx, &table.Participants).Insert(); err != nil {
+				return err
+			}
+
+			for _, e := range table.Events {
+				e.TableId = table.Id
+				if err = appendTableEvent(ctx, tx, e); err != nil {
+					return err
+				}
+			}
+			table.Events = nil
+		}
+
+		started = true
+		return nil
+	})
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+		return false, err
+	}
+
+	return started, nil
+}
+
+// FinishTournament saves places of entries and pays out prizes.
+// Prize of team entry is split between its players.
+func (r *pgGameRepository) FinishTournament(ctx context.Context, tournamentId string, entries []*model.TournamentEntry) error {
+	err := r.DB.RunInTransaction(func(tx *pg.Tx) error {
+		tournament, err := lockTournament(ctx, tx, tournamentId)
+		if err != nil {
+			return err
+		}
+
+		if tournament.State != model.RUNNING {
+			return code.TournamentNotRunning
+		}
+
+		for _, e := range entries {
+			_, err = tx.ModelContext(ctx, e).
+				Column(`place`, `prize`).
+				WherePK().
+				Update()
+			if err != nil {
+				return err
+			}
+
+			players := e.Players()
+			for _, p := range players {
+				share := e.Prize / uint64(len(players))
+				if share == 0 {
+					continue
+				}
+
+				if err = updateBalance(ctx, tx, p, tournament.Currency, int64(share)); err != nil {
+					return err
+				}
+			}
+		}
+
+		_, err = tx.ModelContext(ctx, tournament).
+			Set(`state = ?`, model.FINISHED).
+			Set(`updated_at = now()`).
+			WherePK().
+			Update()
+		return err
+	})
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return err
+}
+
+// CountTournamentTables returns number of tables of tournament round
+// which are still being played.
+func (r *pgGameRepository) CountTournamentTables(ctx context.Context, tournamentId string, round int) (int, error) {
+	count, err := r.DB.ModelContext(ctx, &model.Table{}).
+		Where(`tournament_id = ?`, tournamentId).
+		Where(`tournament_round = ?`, round).
+		Where(`state NOT IN (?)`, pg.In([]model.TableState{model.GAME_FINISHED, model.ABANDONED})).
+		Count()
+
+	if err != nil {
+		r.logger.For(ctx).Error(err)
+	}
+
+	return count, err
+}
+
+func lockTournament(ctx context.Context, db orm.DB, id string) (*model.Tournament, error) {
+	tournament := &model.Tournament{}
+	err := db.ModelContext(ctx, tournament).
+		Where(`id = ?`, id).
+		For(`UPDATE`).
+		Select()
+	if err != nil {
+		if err == pg.ErrNoRows {
+			return nil, code.TournamentNotFound
+		}
+		return nil, err
+	}
+
+	return tournament, nil
+}
+
+// acceptEntry charges partner of pending team entry and marks it
+// accepted.
+func acceptEntry(ctx context.Context, tx *pg.Tx, tournament *model.Tournament, pending, entry *model.TournamentEntry, player *model.Player) error {
+	pending.Accepted = true
+	_, err := tx.ModelContext(ctx, pending).
+		Column(`accepted`).
+		WherePK().
+		Update()
+	if err != nil {
+		return err
+	}
+
+	if err = payEntry(ctx, tx, tournament, entry.PlayerId); err != nil {
+		return err
+	}
+
+	*entry = *pending
+	return selectBalances(ctx, tx, player)
+}
+
+func payEntry(ctx context.Context, db orm.DB, tournament *model.Tournament, playerId string) error {
+	if err := updateBalance(ctx, db, playerId, tournament.Currency, -int64(tournament.EntryFee)); err != nil {
+		return err
+	}
+
+	_, err := db.ModelContext(ctx, tournament).
+		Set(`prize_pool = prize_pool + ?`, tournament.EntryFee).
+		WherePK().
+		Update()
+	return err
+}
+
+// refundEntry returns entry fee to every player who has paid it.
+func refundEntry(ctx context.Context, db orm.DB, tournament *model.Tournament, entry *model.TournamentEntry) error {
+	for _, p := range entry.Paying() {
+		if err := updateBalance(ctx, db, p, tournament.Currency, int64(tournament.EntryFee)); err != nil {
+			return err
+		}
+
+		_, err := db.ModelContext(ctx, tournament).
+			Set(`prize_pool = prize_pool - ?`, tournament.EntryFee).
+			WherePK().
+			Update()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Handzo/gogame/gameservice/code"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/go-pg/pg/v9"
+	"github.com/go-pg/pg/v9/orm"
+)
+
+var balanceColumns = map[model.Currency]string{
+	model.NUTS: "nuts",
+	model.GOLD: "gold",
+}
+
+func selectProduct(ctx context.Context, db orm.DB, productId string) (*model.Product, error) {
+	product := &model.Product{}
+	err := db.ModelContext(ctx, product).
+		Relation(`Goods`).
+		Relation(`Goods.GoodItem`).
+		Where(`"product"."id" = ?`, productId).
+		Select()
+	if err != nil {
+		if err != pg.ErrNoRows {
+			return nil, err
+		}
+
+		// no product has been found
+		return nil, nil
+	}
+
+	return product, nil
+}
+
+func selectBalances(ctx context.Context, db orm.DB, player *model.Player) error {
+	return db.ModelContext(ctx, player).
+		Column(`id`, `nuts`, `gold`).
+		Relation(`Inventory`).
+		Relation(`Inventory.GoodItem`).
+		WherePK().
+		Select()
+}
+
+// updateBalance adds delta to player's balance in given currency.
+// Balance never goes below zero, code.NotEnoughFunds is returned instead.
+func updateBalance(ctx context.Context, db orm.DB, playerId string, currency model.Currency, delta int64) error {
+	column, ok := balanceColumns[currency]
+	if !ok {
+		return code.ProductNotAvailable
+	}
+
+	res, err := db.ModelContext(ctx, &model.Player{}).
+		Set(`? = ? + ?`, pg.Ident(column), pg.Ident(column), delta).
+		Where(`id = ?`, playerId).
+		Where(`? + ? >= 0`, pg.Ident(column), delta).
+		Update()
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return code.NotEnoughFunds
+	}
+
+	return nil
+}
+
+// grantItem adds good item to player's inventory. Items with duration
+// are prolonged, counting from now if the item has already expired.
+func grantItem(ctx context.Context, db orm.DB, playerId string, good *model.Good) error {
+	item := &model.InventoryItem{
+		PlayerId:   playerId,
+		GoodItemId: good.GoodItemId,
+		Quantity:   good.Amount,
+	}
+
+	query := db.ModelContext(ctx, item).
+		OnConflict(`(player_id, good_item_id) DO UPDATE`).
+		Set(`quantity = inventory_item.quantity + EXCLUDED.quantity`)
+
+	if d, ok := model.ItemDurations[good.GoodItem.Title]; ok {
+		d = time.Duration(good.Amount) * d
+		item.ExpiresAt = time.Now().Add(d)
+		query = query.Set(`expires_at = GREATEST(inventory_item.expires_at, now()) + ? * interval '1 second'`, int64(d.Seconds()))
+	}
+
+	_, err := query.Insert()
+	return err
+}
+
+// revokeItem takes good item back from player's inventory.
+func revokeItem(ctx context.Context, db orm.DB, playerId string, good *model.Good) error {
+	query := db.ModelContext(ctx, &model.InventoryItem{}).
+		Set(`quantity = GREATEST(quantity - ?, 0)`, good.Amount).
+		Where(`player_id = ?`, playerId).
+		Where(`good_item_id = ?`, good.GoodItemId)
+
+	if d, ok := model.ItemDurations[good.GoodItem.Title]; ok {
+		d = time.Duration(good.Amount) * d
+		query = query.Set(`expires_at = expires_at - ? * interval '1 second'`, int64(d.Seconds()))
+	}
+
+	_, err := query.Update()
+	return err
+}
+
+// revokeBalance takes amount from player's balance in given currency.
+// Spent currency can not be taken back, so balance stops at zero.
+func revokeBalance(ctx context.Context, db orm.DB, playerId string, currency model.Currency, amount uint32) error {
+	column, ok := balanceColumns[currency]
+	if !ok {
+		return code.ProductNotAvailable
+	}
+
+	_, err := db.ModelContext(ctx, &model.Player{}).
+		Set(`? = GREATEST(? - ?, 0)`, pg.Ident(column), pg.Ident(column), amount).
+		Where(`id = ?`, playerId).
+		Update()
+	return err
+}
+
+func grantReward(ctx context.Context, db orm.DB, playerId string, reward model.Reward) error {
+	if reward.Nuts != 0 {
+		if err := updateBalance(ctx, db, playerId, model.NUTS, int64(reward.Nuts)); err != nil {
+			return err
+		}
+	}
+
+	if reward.Gold != 0 {
+		if err := updateBalance(ctx, db, playerId, model.GOLD, int64(reward.Gold)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func grantGoods(ctx context.Context, db orm.DB, playerId string, goods []*model.Good) error {
+	for _, g := range goods {
+		var err error
+
+		switch g.GoodItem.Title {
+		case model.NUTS_ITEM:
+			err = updateBalance(ctx, db, playerId, model.NUTS, int64(g.Amount))
+		case model.GOLD_ITEM:
+			err = updateBalance(ctx, db, playerId, model.GOLD, int64(g.Amount))
+		default:
+			err = grantItem(ctx, db, playerId, g)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func revokeGoods(ctx context.Context, db orm.DB, playerId string, goods []*model.Good) error {
+	for _, g := range goods {
+		var err error
+
+		switch g.GoodItem.Title {
+		case model.NUTS_ITEM:
+			err = revokeBalance(ctx, db, playerId, model.NUTS, g.Amount)
+		case model.GOLD_ITEM:
+			err = revokeBalance(ctx, db, playerId, model.GOLD, g.Amount)
+		default:
+			err = revokeItem(ctx, db, playerId, g)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
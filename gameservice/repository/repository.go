@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/Handzo/gogame/gameservice/repository/model"
 )
@@ -13,9 +14,17 @@ type GameRepository interface {
 	SelectOrInsertPlayer(context.Context, *model.Player) (bool, error)
 	CreateSession(context.Context, *model.Session) error
 	GetOpenedSessionForRemote(context.Context, string) (*model.Session, error)
-	CreateTable(context.Context, string, string, uint32) (*model.Table, error)
-	GetOpenTables(context.Context) ([]*model.Table, error)
+	GetOpenSessions(context.Context, string) ([]*model.Session, error)
+	TakeOverSessions(context.Context, *model.Session, ...*model.Session) error
+	SetSeatController(context.Context, string, string) (bool, error)
+	CreateTable(context.Context, string, string, string, uint32, bool) (*model.Table, error)
+	GetOpenTables(context.Context, *model.TableFilter, int, int) ([]*model.Table, int, error)
 	FindTable(context.Context, string) (*model.Table, error)
+	TransitTable(context.Context, *model.Table, model.TableState, ...string) (bool, error)
+	UpdateTable(context.Context, *model.Table, ...string) (bool, error)
+	AppendTableEvent(context.Context, *model.TableEvent) error
+	GetTableEvents(context.Context, string, int) ([]*model.TableEvent, error)
+	GetIdleTables(context.Context, time.Time, ...model.TableState) ([]*model.Table, error)
 	TableReadyCount(context.Context, string) (int, error)
 	FindTableWithPlayer(context.Context, string) (*model.Table, error)
 	GetParticipantsForPlayer(context.Context, string) ([]*model.Participant, error)
@@ -25,4 +34,71 @@ type GameRepository interface {
 	FindParticipantWithOrder(context.Context, string, int) (*model.Participant, error)
 	GetProducts(context.Context) ([]*model.Product, error)
 	GetProduct(context.Context, string) (*model.Product, error)
+	PurchaseProduct(context.Context, *model.Purchase) (*model.Player, error)
+	CreatePendingPurchase(context.Context, *model.Purchase) error
+	FindPurchase(context.Context, string, string) (*model.Purchase, error)
+	SetPurchaseCheckout(context.Context, *model.Purchase) error
+	CompletePurchase(context.Context, string) (*model.Purchase, *model.Player, error)
+	RefundPurchase(context.Context, string) (*model.Purchase, *model.Player, error)
+	GetInventory(context.Context, string) ([]*model.InventoryItem, error)
+	FindInventoryItem(context.Context, string, string) (*model.InventoryItem, error)
+	ExpireInventoryItems(context.Context, int) ([]*model.InventoryItem, error)
+	AddExp(context.Context, string, uint64) (*model.Player, error)
+	LevelUp(context.Context, string, func(*model.Player) model.Reward) (*model.Player, error)
+	FindPlayer(context.Context, string) (*model.Player, error)
+	UpdateProfile(context.Context, string, *model.Profile) (*model.Player, error)
+	GetFriendships(context.Context, string) ([]*model.Friendship, error)
+	GetFriendIds(context.Context, string) ([]string, error)
+	FindFriendship(context.Context, string, string) (*model.Friendship, error)
+	RequestFriendship(context.Context, string, string) (*model.Friendship, error)
+	AcceptFriendship(context.Context, string, string) (*model.Friendship, error)
+	RemoveFriendship(context.Context, string, string) error
+	BlockPlayer(context.Context, string, string) error
+	AcceptInvitation(context.Context, string, string) (*model.Invitation, error)
+	SaveGameResult(context.Context, *model.Table) error
+	GetLeaderboardRows(context.Context, time.Time) ([]*model.LeaderboardRow, error)
+	FindPlayers(context.Context, []string) ([]*model.Player, error)
+	IncrementPlayerStats(context.Context, ...*model.PlayerStats) error
+	FindPlayerStats(context.Context, string) (*model.PlayerStats, error)
+	GetMatchHistory(context.Context, string, int, int) ([]*model.Table, int, error)
+	GetAchievementProgress(context.Context, string) ([]*model.AchievementProgress, error)
+	AdvanceAchievement(context.Context, string, string, int, model.Reward) (*model.AchievementProgress, *model.Player, error)
+	ClaimDailyReward(context.Context, string, func(*model.Player) (model.Reward, error)) (*model.Player, error)
+	CreateDailyQuests(context.Context, []*model.DailyQuest) ([]*model.DailyQuest, error)
+	ExpireDailyQuest(context.Context, string) error
+	GetActiveQuests(context.Context, time.Time) ([]*model.DailyQuest, error)
+	GetQuestProgress(context.Context, string, []string) ([]*model.QuestProgress, error)
+	PenalizePlayer(context.Context, string, uint64, int) error
+	UpdateParticipant(context.Context, *model.Participant, ...string) error
+	ChangeSeating(context.Context, string, ...*model.Participant) error
+	AcceptSeatSwap(context.Context, string, string) (*model.SeatSwap, error)
+	VoteRematch(context.Context, string, string) (*model.Rematch, error)
+	CloseRematch(context.Context, string, model.RematchState) (bool, error)
+	StartRematch(context.Context, string, *model.Table) (bool, error)
+	CreateTournament(context.Context, *model.Tournament) (bool, error)
+	GetTournaments(context.Context, ...model.TournamentState) ([]*model.Tournament, error)
+	FindTournament(context.Context, string) (*model.Tournament, error)
+	RegisterTournament(context.Context, *model.TournamentEntry) (*model.Player, error)
+	UnregisterTournament(context.Context, string, string) (*model.Player, error)
+	DropPendingEntries(context.Context, string) ([]*model.TournamentEntry, error)
+	CancelTournament(context.Context, string) error
+	FinishTournament(context.Context, string, []*model.TournamentEntry) error
+	StartTournamentRound(context.Context, *model.Tournament, []*model.TournamentEntry, []*model.Table) (bool, error)
+	CountTournamentTables(context.Context, string, int) (int, error)
+	GetNotifications(context.Context, string, bool, int, int) ([]*model.Notification, int, int, error)
+	MarkNotificationsRead(context.Context, string, ...string) (int, error)
+	AdvanceQuest(context.Context, string, string, int, model.Reward) (*model.QuestProgress, *model.Player, error)
+	SearchPlayers(context.Context, string, int, int) ([]*model.Player, int, error)
+	GetPlayerSessions(context.Context, string, int, int) ([]*model.Session, int, error)
+	GetPlayerTables(context.Context, string, int, int) ([]*model.Table, int, error)
+	GetPurchases(context.Context, string, int) ([]*model.Purchase, error)
+	GetBalanceAdjustments(context.Context, string, int) ([]*model.BalanceAdjustment, error)
+	AdjustBalance(context.Context, *model.BalanceAdjustment) (*model.Player, error)
+	GetOnlineRemotes(context.Context) ([]string, error)
+	GetAdminActions(context.Context, string, string, int, int) ([]*model.AdminAction, int, error)
+	GetPlayedTables(context.Context, time.Time, int, int) ([]*model.Table, error)
+	GetSessionsOfPlayers(context.Context, []string, time.Time) ([]*model.Session, error)
+	QueueReviewCases(context.Context, ...*model.ReviewCase) (int, error)
+	GetReviewCases(context.Context, model.ReviewState, model.ReviewKind, int, int) ([]*model.ReviewCase, int, error)
+	ResolveReviewCase(context.Context, string, model.ReviewState, string, string) (*model.ReviewCase, error)
 }
@@ -0,0 +1,169 @@
+package achievement
+
+import (
+	"github.com/Handzo/gogame/gameengine/service/deck"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+)
+
+// Event is a game stage at which achievements are evaluated.
+type Event string
+
+var (
+	DEAL  Event = "deal"
+	ROUND Event = "round"
+	GAME  Event = "game"
+)
+
+// Outcome describes result of a deal, round or game for one player.
+type Outcome struct {
+	Event Event
+	Won   bool
+	// hand at round start and round trump
+	Hand  string
+	Trump string
+	// cards of trick taken by player
+	Trick string
+	// game totals of player's team and opponents
+	TeamTotal      int
+	OpponentsTotal int
+}
+
+// Condition reports whether outcome advances achievement.
+type Condition func(*Outcome) bool
+
+// Achievement is unlocked when its condition has been met Goal times.
+type Achievement struct {
+	Id          string
+	Title       string
+	Description string
+	Event       Event
+	Goal        int
+	Condition   Condition
+	Reward      model.Reward
+}
+
+// Defaults returns achievements available in game.
+func Defaults() []*Achievement {
+	return []*Achievement{
+		{
+			Id:          "dry_win",
+			Title:       "Dry win",
+			Description: "Win a game 12:0",
+			Event:       GAME,
+			Goal:        1,
+			Condition:   All(Won, Shutout),
+			Reward:      model.Reward{Nuts: 1000},
+		},
+		{
+			Id:          "no_trumps",
+			Title:       "Bare hands",
+			Description: "Win a round holding no trumps",
+			Event:       ROUND,
+			Goal:        1,
+			Condition:   All(Won, NoTrumps),
+			Reward:      model.Reward{Nuts: 300},
+		},
+		{
+			Id:          "four_jacks",
+			Title:       "Jack of all jacks",
+			Description: "Win a round holding all four jacks",
+			Event:       ROUND,
+			Goal:        1,
+			Condition:   All(Won, FourJacks),
+			Reward:      model.Reward{Nuts: 500},
+		},
+		{
+			Id:          "four_aces",
+			Title:       "Ace collector",
+			Description: "Take a trick with all four aces",
+			Event:       DEAL,
+			Goal:        1,
+			Condition:   FourAces,
+			Reward:      model.Reward{Nuts: 200},
+		},
+		{
+			Id:          "veteran",
+			Title:       "Veteran",
+			Description: "Play 100 games",
+			Event:       GAME,
+			Goal:        100,
+			Condition:   Always,
+			Reward:      model.Reward{Gold: 10},
+		},
+	}
+}
+
+// Evaluate returns achievements of event advanced by outcome.
+func Evaluate(achievements []*Achievement, outcome *Outcome) []*Achievement {
+	advanced := []*Achievement{}
+	for _, a := range achievements {
+		if a.Event == outcome.Event && a.Condition(outcome) {
+			advanced = append(advanced, a)
+		}
+	}
+	return advanced
+}
+
+func All(conditions ...Condition) Condition {
+	return func(o *Outcome) bool {
+		for _, c := range conditions {
+			if !c(o) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func Always(*Outcome) bool {
+	return true
+}
+
+func Won(o *Outcome) bool {
+	return o.Won
+}
+
+// Trump checks round has been played with given trump suit.
+func Trump(suit deck.Suit) Condition {
+	return func(o *Outcome) bool {
+		return o.Trump != "" && deck.GetSuit(o.Trump) == suit
+	}
+}
+
+func Shutout(o *Outcome) bool {
+	return o.OpponentsTotal == 0
+}
+
+// NoTrumps checks hand has neither trump suit cards nor jacks,
+// which are always trumps.
+func NoTrumps(o *Outcome) bool {
+	trump := deck.GetSuit(o.Trump)
+	for _, c := range cards(o.Hand) {
+		if c.Suit() == trump || c.Face() == deck.JACK {
+			return false
+		}
+	}
+	return o.Hand != ""
+}
+
+func FourJacks(o *Outcome) bool {
+	return count(o.Hand, deck.JACK) == 4
+}
+
+func FourAces(o *Outcome) bool {
+	return count(o.Trick, deck.ACE) == 4
+}
+
+func cards(sig string) []deck.Card {
+	return deck.New(deck.Unshuffled, deck.FromSignature(sig)).Cards
+}
+
+func count(sig string, face deck.Face) int {
+	n := 0
+	for _, c := range cards(sig) {
+		if c.Face() == face {
+			n++
+		}
+	}
+	return n
+}
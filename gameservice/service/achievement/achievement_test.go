@@ -0,0 +1,71 @@
+package achievement
+
+import "testing"
+
+func ids(achievements []*Achievement) map[string]bool {
+	res := make(map[string]bool)
+	for _, a := range achievements {
+		res[a.Id] = true
+	}
+	return res
+}
+
+func TestEvaluateGame(t *testing.T) {
+	advanced := ids(Evaluate(Defaults(), &Outcome{
+		Event:          GAME,
+		Won:            true,
+		TeamTotal:      12,
+		OpponentsTotal: 0,
+	}))
+
+	if !advanced["dry_win"] || !advanced["veteran"] || len(advanced) != 2 {
+		t.Fatalf("unexpected achievements %v", advanced)
+	}
+
+	advanced = ids(Evaluate(Defaults(), &Outcome{
+		Event:          GAME,
+		TeamTotal:      0,
+		OpponentsTotal: 12,
+	}))
+
+	if !advanced["veteran"] || len(advanced) != 1 {
+		t.Fatalf("unexpected achievements %v", advanced)
+	}
+}
+
+func TestEvaluateRound(t *testing.T) {
+	// all jacks, trump is club
+	advanced := ids(Evaluate(Defaults(), &Outcome{
+		Event: ROUND,
+		Won:   true,
+		Hand:  "90919293c1c2c3b1",
+		Trump: "0",
+	}))
+
+	if !advanced["four_jacks"] || advanced["no_trumps"] {
+		t.Fatalf("unexpected achievements %v", advanced)
+	}
+
+	// hearts and diamonds only, trump is club
+	advanced = ids(Evaluate(Defaults(), &Outcome{
+		Event: ROUND,
+		Won:   true,
+		Hand:  "c2c3b2b3a2a382",
+		Trump: "0",
+	}))
+
+	if !advanced["no_trumps"] || advanced["four_jacks"] {
+		t.Fatalf("unexpected achievements %v", advanced)
+	}
+}
+
+func TestEvaluateDeal(t *testing.T) {
+	advanced := ids(Evaluate(Defaults(), &Outcome{
+		Event: DEAL,
+		Trick: "c0c1c2c3",
+	}))
+
+	if !advanced["four_aces"] {
+		t.Fatalf("unexpected achievements %v", advanced)
+	}
+}
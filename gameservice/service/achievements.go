@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Handzo/gogame/common/log"
+	enginesig "github.com/Handzo/gogame/gameengine/service"
+	pb "github.com/Handzo/gogame/gameservice/proto"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/Handzo/gogame/gameservice/service/achievement"
+	"github.com/Handzo/gogame/gameservice/service/pubsub"
+)
+
+func (g *gameService) GetAchievements(ctx context.Context, req *pb.GetAchievementsRequest) (*pb.GetAchievementsResponse, error) {
+	progress, err := g.repo.GetAchievementProgress(ctx, ctx.Value("player_id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	byId := make(map[string]*model.AchievementProgress, len(progress))
+	for _, p := range progress {
+		byId[p.AchievementId] = p
+	}
+
+	achievements := make([]*pb.Achievement, len(g.config.Achievements))
+	for i, a := range g.config.Achievements {
+		achievements[i] = &pb.Achievement{
+			Id:          a.Id,
+			Title:       a.Title,
+			Description: a.Description,
+			Goal:        uint32(a.Goal),
+			RewardNuts:  a.Reward.Nuts,
+			RewardGold:  a.Reward.Gold,
+		}
+
+		if p, ok := byId[a.Id]; ok {
+			achievements[i].Progress = uint32(p.Progress)
+			if p.IsUnlocked() {
+				achievements[i].UnlockedAt = p.UnlockedAt.Unix()
+			}
+		}
+	}
+
+	return &pb.GetAchievementsResponse{
+		Achievements: achievements,
+	}, nil
+}
+
+// advanceAchievements evaluates outcome for player and pushes
+// achievements unlocked by it.
+func (g *gameService) advanceAchievements(ctx context.Context, playerId string, outcome *achievement.Outcome) {
+	logger := g.logger.For(ctx)
+
+	for _, a := range achievement.Evaluate(g.config.Achievements, outcome) {
+		_, player, err := g.repo.AdvanceAchievement(ctx, playerId, a.Id, a.Goal, a.Reward)
+		if err != nil || player == nil {
+			continue
+		}
+
+		logger.Info("Achievement unlocked", log.String("player_id", playerId), log.String("achievement", a.Id))
+
+		g.notify(ctx, playerId, &pubsub.Event{
+			Event: "AchievementUnlocked",
+			Payload: &pubsub.AchievementUnlocked{
+				Id:          a.Id,
+				Title:       a.Title,
+				Description: a.Description,
+				RewardNuts:  a.Reward.Nuts,
+				RewardGold:  a.Reward.Gold,
+				Nuts:        player.Nuts,
+				Gold:        player.Gold,
+			},
+		})
+	}
+}
+
+// dealAchievements evaluates trick just taken at the table.
+func (g *gameService) dealAchievements(ctx context.Context, table *model.Table) {
+	sig, err := enginesig.Parse(table.Signature)
+	if err != nil {
+		return
+	}
+
+	// player who took the trick leads next one
+	order := sig.Turn + 1
+	cards := sig.Team1Cards
+	if team(order) == 2 {
+		cards = sig.Team2Cards
+	}
+
+	if len(cards) < 8 {
+		return
+	}
+
+	participant, err := g.repo.FindParticipantWithOrder(ctx, table.Id, order)
+	if err != nil || participant.PlayerId == "" {
+		return
+	}
+
+	g.trackOutcome(ctx, participant.PlayerId, &achievement.Outcome{
+		Event: achievement.DEAL,
+		Trick: cards[len(cards)-8:],
+	})
+}
+
+// roundAchievements evaluates hands players started finished round with.
+func (g *gameService) roundAchievements(ctx context.Context, table *model.Table, round *model.Round, winner int) {
+	start, err := enginesig.Parse(round.Signature)
+	if err != nil {
+		return
+	}
+
+	for _, p := range table.Participants {
+		if p.PlayerId == "" {
+			continue
+		}
+
+		g.trackOutcome(ctx, p.PlayerId, &achievement.Outcome{
+			Event: achievement.ROUND,
+			Won:   team(p.Order) == winner,
+			Hand:  start.PlayerCards[p.Order-1],
+			Trump: start.Trump,
+		})
+	}
+}
+
+// gameAchievements evaluates final totals of finished table.
+func (g *gameService) gameAchievements(ctx context.Context, table *model.Table, winner int) {
+	sig, err := enginesig.Parse(table.Signature)
+	if err != nil {
+		return
+	}
+
+	totals := map[int]int{1: sig.Team1Total, 2: sig.Team2Total}
+
+	for _, p := range table.Participants {
+		if p.PlayerId == "" {
+			continue
+		}
+
+		own := team(p.Order)
+		g.trackOutcome(ctx, p.PlayerId, &achievement.Outcome{
+			Event:          achievement.GAME,
+			Won:            own == winner,
+			TeamTotal:      totals[own],
+			OpponentsTotal: totals[3-own],
+		})
+	}
+}
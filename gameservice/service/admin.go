@@ -0,0 +1,472 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Handzo/gogame/common/log"
+	"github.com/Handzo/gogame/gameservice/code"
+	pb "github.com/Handzo/gogame/gameservice/proto"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/Handzo/gogame/gameservice/service/pubsub"
+)
+
+const (
+	defaultAdminLimit = 20
+	maxAdminLimit     = 100
+	// walletHistory is how many latest purchases and adjustments
+	// wallet shows
+	walletHistory = 20
+)
+
+// adminService moderates players and tables of game service.
+type adminService struct {
+	*gameService
+}
+
+// NewAdminService creates admin service on top of game service created
+// by NewGameService.
+func NewAdminService(gamesvc pb.GameServiceServer) pb.AdminServiceServer {
+	return &adminService{gamesvc.(*gameService)}
+}
+
+func (a *adminService) SearchPlayers(ctx context.Context, req *pb.SearchPlayersRequest) (*pb.SearchPlayersResponse, error) {
+	players, total, err := a.repo.SearchPlayers(ctx, req.Query, int(req.Offset), adminLimit(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*pb.AdminPlayer, len(players))
+	for i, p := range players {
+		infos[i] = adminPlayerInfo(p)
+	}
+
+	return &pb.SearchPlayersResponse{
+		Players: infos,
+		Total:   uint32(total),
+	}, nil
+}
+
+func (a *adminService) GetPlayerSessions(ctx context.Context, req *pb.GetPlayerSessionsRequest) (*pb.GetPlayerSessionsResponse, error) {
+	sessions, total, err := a.repo.GetPlayerSessions(ctx, req.PlayerId, int(req.Offset), adminLimit(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*pb.AdminSession, len(sessions))
+	for i, s := range sessions {
+		infos[i] = &pb.AdminSession{
+			Id:         s.Id,
+			Remote:     s.Remote,
+			Device:     s.Device,
+			Controller: s.Controller,
+			CreatedAt:  s.CreatedAt.Unix(),
+			ClosedAt:   unixOrZero(s.ClosedAt),
+		}
+	}
+
+	return &pb.GetPlayerSessionsResponse{
+		Sessions: infos,
+		Total:    uint32(total),
+	}, nil
+}
+
+func (a *adminService) GetPlayerTables(ctx context.Context, req *pb.GetPlayerTablesRequest) (*pb.GetPlayerTablesResponse, error) {
+	tables, total, err := a.repo.GetPlayerTables(ctx, req.PlayerId, int(req.Offset), adminLimit(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*pb.AdminTable, len(tables))
+	for i, t := range tables {
+		infos[i] = &pb.AdminTable{
+			Id:           t.Id,
+			State:        string(t.State),
+			Result:       t.Result,
+			Currency:     string(t.Currency),
+			Bet:          t.Bet,
+			Private:      t.Private,
+			TournamentId: t.TournamentId,
+			CreatedAt:    t.CreatedAt.Unix(),
+			EndTime:      unixOrZero(t.EndTime),
+		}
+
+		for _, p := range t.Participants {
+			if p.PlayerId != "" {
+				infos[i].PlayerIds = append(infos[i].PlayerIds, p.PlayerId)
+			}
+		}
+	}
+
+	return &pb.GetPlayerTablesResponse{
+		Tables: infos,
+		Total:  uint32(total),
+	}, nil
+}
+
+func (a *adminService) GetPlayerWallet(ctx context.Context, req *pb.GetPlayerWalletRequest) (*pb.GetPlayerWalletResponse, error) {
+	player, err := a.findPlayer(ctx, req.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	purchases, err := a.repo.GetPurchases(ctx, player.Id, walletHistory)
+	if err != nil {
+		return nil, err
+	}
+
+	adjustments, err := a.repo.GetBalanceAdjustments(ctx, player.Id, walletHistory)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.GetPlayerWalletResponse{
+		Nuts:        player.Nuts,
+		Gold:        player.Gold,
+		Purchases:   make([]*pb.AdminPurchase, len(purchases)),
+		Adjustments: make([]*pb.BalanceAdjustment, len(adjustments)),
+	}
+
+	for i, p := range purchases {
+		res.Purchases[i] = &pb.AdminPurchase{
+			Id:        p.Id,
+			ProductId: p.ProductId,
+			Price:     p.Price,
+			Currency:  string(p.Currency),
+			State:     string(p.State),
+			CreatedAt: p.CreatedAt.Unix(),
+		}
+	}
+
+	for i, adj := range adjustments {
+		res.Adjustments[i] = &pb.BalanceAdjustment{
+			Id:        adj.Id,
+			AdminId:   adj.AdminId,
+			Currency:  string(adj.Currency),
+			Amount:    adj.Amount,
+			Reason:    adj.Reason,
+			CreatedAt: adj.CreatedAt.Unix(),
+		}
+	}
+
+	return res, nil
+}
+
+// BanPlayer closes every session of banned player, so player leaves
+// tables the same way as on disconnect.
+func (a *adminService) BanPlayer(ctx context.Context, req *pb.BanPlayerRequest) (*pb.BanPlayerResponse, error) {
+	if req.Reason == "" {
+		return nil, code.ReasonRequired
+	}
+
+	player, err := a.findPlayer(ctx, req.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	player.BannedUntil = restrictedUntil(req.Duration)
+	if err = a.repo.Update(ctx, player, "banned_until"); err != nil {
+		return nil, err
+	}
+
+	if !player.IsBanned() {
+		return &pb.BanPlayerResponse{}, nil
+	}
+
+	a.notify(ctx, player.Id, &pubsub.Event{
+		Event: "PlayerBanned",
+		Payload: &pubsub.PlayerBanned{
+			BannedUntil: player.BannedUntil.Unix(),
+			Reason:      req.Reason,
+		},
+	})
+
+	sessions, err := a.repo.GetOpenSessions(ctx, player.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range sessions {
+		if err = a.closeSession(ctx, s); err != nil {
+			return nil, err
+		}
+	}
+
+	a.notifyFriends(ctx, player, "FriendOffline")
+
+	a.logger.For(ctx).Info("Player banned", log.String("player_id", player.Id), log.Int("sessions", len(sessions)))
+
+	return &pb.BanPlayerResponse{
+		BannedUntil: player.BannedUntil.Unix(),
+	}, nil
+}
+
+// MutePlayer blocks AddFriend and InviteToTable of player.
+func (a *adminService) MutePlayer(ctx context.Context, req *pb.MutePlayerRequest) (*pb.MutePlayerResponse, error) {
+	if req.Reason == "" {
+		return nil, code.ReasonRequired
+	}
+
+	player, err := a.findPlayer(ctx, req.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	player.MutedUntil = restrictedUntil(req.Duration)
+	if err = a.repo.Update(ctx, player, "muted_until"); err != nil {
+		return nil, err
+	}
+
+	return &pb.MutePlayerResponse{
+		MutedUntil: unixOrZero(player.MutedUntil),
+	}, nil
+}
+
+func (a *adminService) AdjustBalance(ctx context.Context, req *pb.AdjustBalanceRequest) (*pb.AdjustBalanceResponse, error) {
+	if req.Reason == "" {
+		return nil, code.ReasonRequired
+	}
+
+	currency := model.Currency(req.Currency)
+	if currency != model.NUTS && currency != model.GOLD || req.Amount == 0 {
+		return nil, code.InvalidAdjustment
+	}
+
+	player, err := a.findPlayer(ctx, req.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if player, err = a.repo.AdjustBalance(ctx, &model.BalanceAdjustment{
+		PlayerId: player.Id,
+		AdminId:  ctx.Value("admin_id").(string),
+		Currency: currency,
+		Amount:   req.Amount,
+		Reason:   req.Reason,
+	}); err != nil {
+		return nil, err
+	}
+
+	a.notify(ctx, player.Id, &pubsub.Event{
+		Event: "BalanceAdjusted",
+		Payload: &pubsub.BalanceAdjusted{
+			Currency: req.Currency,
+			Amount:   req.Amount,
+			Reason:   req.Reason,
+			Nuts:     player.Nuts,
+			Gold:     player.Gold,
+		},
+	})
+
+	return &pb.AdjustBalanceResponse{
+		Nuts: player.Nuts,
+		Gold: player.Gold,
+	}, nil
+}
+
+// ForceCloseTable abandons stuck table. Bets are settled only by finished
+// games and never escrowed, so there is nothing to refund.
+func (a *adminService) ForceCloseTable(ctx context.Context, req *pb.ForceCloseTableRequest) (*pb.ForceCloseTableResponse, error) {
+	if req.Reason == "" {
+		return nil, code.ReasonRequired
+	}
+
+	table, err := a.repo.FindTable(ctx, req.TableId)
+	if err != nil {
+		return nil, err
+	}
+
+	if table == nil {
+		return nil, code.TableNotFound
+	}
+
+	if table.IsClosed() {
+		return nil, code.TableClosed
+	}
+
+	if err = a.abandonTable(ctx, table, model.VOIDED, "closed_by_admin"); err != nil {
+		return nil, err
+	}
+
+	return &pb.ForceCloseTableResponse{}, nil
+}
+
+// BroadcastMessage sends system message to every open session.
+func (a *adminService) BroadcastMessage(ctx context.Context, req *pb.BroadcastMessageRequest) (*pb.BroadcastMessageResponse, error) {
+	if req.Text == "" {
+		return nil, code.EmptyMessage
+	}
+
+	remotes, err := a.repo.GetOnlineRemotes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ev := &pubsub.Event{
+		Event: "SystemMessage",
+		Payload: &pubsub.SystemMessage{
+			Text:   req.Text,
+			SentAt: time.Now().Unix(),
+		},
+	}
+
+	for _, remote := range remotes {
+		a.pubsub.Publish(ctx, remote, ev)
+	}
+
+	return &pb.BroadcastMessageResponse{
+		Recipients: uint32(len(remotes)),
+	}, nil
+}
+
+func (a *adminService) GetAuditLog(ctx context.Context, req *pb.GetAuditLogRequest) (*pb.GetAuditLogResponse, error) {
+	actions, total, err := a.repo.GetAdminActions(ctx, req.PlayerId, req.TableId, int(req.Offset), adminLimit(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*pb.AdminAction, len(actions))
+	for i, act := range actions {
+		infos[i] = &pb.AdminAction{
+			Id:        act.Id,
+			AdminId:   act.AdminId,
+			AdminName: act.AdminName,
+			Method:    act.Method,
+			PlayerId:  act.PlayerId,
+			TableId:   act.TableId,
+			Reason:    act.Reason,
+			Request:   act.Request,
+			Error:     act.Error,
+			CreatedAt: act.CreatedAt.Unix(),
+		}
+	}
+
+	return &pb.GetAuditLogResponse{
+		Actions: infos,
+		Total:   uint32(total),
+	}, nil
+}
+
+func (a *adminService) GetReviewQueue(ctx context.Context, req *pb.GetReviewQueueRequest) (*pb.GetReviewQueueResponse, error) {
+	cases, total, err := a.repo.GetReviewCases(ctx, model.ReviewState(req.State), model.ReviewKind(req.Kind), int(req.Offset), adminLimit(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*pb.ReviewCase, len(cases))
+	for i, c := range cases {
+		infos[i] = reviewCaseInfo(c)
+	}
+
+	return &pb.GetReviewQueueResponse{
+		Cases: infos,
+		Total: uint32(total),
+	}, nil
+}
+
+// ResolveReviewCase confirms or dismisses open case. Confirmed case is
+// only a verdict, sanctions are applied by separate admin actions.
+func (a *adminService) ResolveReviewCase(ctx context.Context, req *pb.ResolveReviewCaseRequest) (*pb.ResolveReviewCaseResponse, error) {
+	if req.Reason == "" {
+		return nil, code.ReasonRequired
+	}
+
+	state := model.ReviewState(req.State)
+	if state != model.CONFIRMED && state != model.DISMISSED {
+		return nil, code.InvalidReviewState
+	}
+
+	if req.CaseId == "" {
+		return nil, code.ReviewCaseNotFound
+	}
+
+	rc, err := a.repo.ResolveReviewCase(ctx, req.CaseId, state, ctx.Value("admin_id").(string), req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if rc == nil {
+		return nil, code.ReviewCaseNotFound
+	}
+
+	return &pb.ResolveReviewCaseResponse{
+		Case: reviewCaseInfo(rc),
+	}, nil
+}
+
+func (a *adminService) findPlayer(ctx context.Context, playerId string) (*model.Player, error) {
+	if playerId == "" {
+		return nil, code.PlayerNotFound
+	}
+
+	player, err := a.repo.FindPlayer(ctx, playerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if player == nil {
+		return nil, code.PlayerNotFound
+	}
+
+	return player, nil
+}
+
+func adminPlayerInfo(p *model.Player) *pb.AdminPlayer {
+	return &pb.AdminPlayer{
+		Id:          p.Id,
+		UserId:      p.UserId,
+		Nickname:    p.Nickname,
+		Level:       p.Level,
+		Rating:      int32(p.Rating),
+		Nuts:        p.Nuts,
+		Gold:        p.Gold,
+		BannedUntil: unixOrZero(p.BannedUntil),
+		MutedUntil:  unixOrZero(p.MutedUntil),
+		CreatedAt:   p.CreatedAt.Unix(),
+	}
+}
+
+func reviewCaseInfo(c *model.ReviewCase) *pb.ReviewCase {
+	return &pb.ReviewCase{
+		Id:         c.Id,
+		Kind:       string(c.Kind),
+		PlayerIds:  c.PlayerIds,
+		TableIds:   c.TableIds,
+		Score:      c.Score,
+		Details:    c.Details,
+		State:      string(c.State),
+		ReviewerId: c.ReviewerId,
+		Note:       c.Note,
+		ReviewedAt: unixOrZero(c.ReviewedAt),
+		CreatedAt:  c.CreatedAt.Unix(),
+		UpdatedAt:  c.UpdatedAt.Unix(),
+	}
+}
+
+func adminLimit(limit uint32) int {
+	if limit == 0 {
+		return defaultAdminLimit
+	}
+	if limit > maxAdminLimit {
+		return maxAdminLimit
+	}
+	return int(limit)
+}
+
+// restrictedUntil returns end of restriction lasting duration seconds.
+// Zero duration lifts restriction.
+func restrictedUntil(duration uint64) time.Time {
+	if duration == 0 {
+		return time.Time{}
+	}
+
+	return time.Now().Add(time.Duration(duration) * time.Second)
+}
+
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.Unix()
+}
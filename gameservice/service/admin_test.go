@@ -0,0 +1,52 @@
+package service
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/Handzo/gogame/gameservice/proto"
+)
+
+func TestAuditAction(t *testing.T) {
+	action := auditAction(&pb.BanPlayerRequest{PlayerId: "p", Duration: 60, Reason: "abuse"})
+	if action.PlayerId != "p" || action.TableId != "" || action.Reason != "abuse" {
+		t.Fatalf("unexpected action: %+v", action)
+	}
+
+	if action.Request != `{"player_id":"p","duration":60,"reason":"abuse"}` {
+		t.Fatalf("unexpected request: %s", action.Request)
+	}
+
+	action = auditAction(&pb.ForceCloseTableRequest{TableId: "t", Reason: "stuck"})
+	if action.PlayerId != "" || action.TableId != "t" || action.Reason != "stuck" {
+		t.Fatalf("unexpected action: %+v", action)
+	}
+}
+
+func TestRestrictedUntil(t *testing.T) {
+	if !restrictedUntil(0).IsZero() {
+		t.Fatal("zero duration must lift restriction")
+	}
+
+	until := restrictedUntil(3600)
+	if d := time.Until(until); d < 59*time.Minute || d > time.Hour {
+		t.Fatalf("unexpected restriction end: %v", until)
+	}
+}
+
+func TestAdminLimit(t *testing.T) {
+	if adminLimit(0) != defaultAdminLimit || adminLimit(5) != 5 || adminLimit(1000) != maxAdminLimit {
+		t.Fatal("unexpected limits")
+	}
+}
+
+func TestAdminMutations(t *testing.T) {
+	admin := reflect.TypeOf(&adminService{})
+	for method := range adminMutations {
+		if _, ok := admin.MethodByName(strings.TrimPrefix(method, adminPrefix)); !ok {
+			t.Errorf("%s is not a method of admin service", method)
+		}
+	}
+}
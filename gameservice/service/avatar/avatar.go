@@ -0,0 +1,112 @@
+package avatar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+)
+
+// MaxImageSize limits size of uploaded avatar images.
+const MaxImageSize = 64 * 1024
+
+var (
+	ErrImageTooLarge = errors.New("avatar image is too large")
+	ErrInvalidImage  = errors.New("avatar image must be png or jpeg")
+)
+
+// Storage keeps images uploaded by players.
+type Storage interface {
+	// Save stores player's image and returns its url.
+	Save(ctx context.Context, playerId string, image []byte) (string, error)
+}
+
+// Catalogue maps server provided avatar ids to urls.
+type Catalogue struct {
+	BaseUrl string
+	Ids     []string
+}
+
+func NewCatalogue(baseUrl string, ids ...string) *Catalogue {
+	sort.Strings(ids)
+	return &Catalogue{BaseUrl: baseUrl, Ids: ids}
+}
+
+// DefaultCatalogue returns avatars shipped with the client.
+func DefaultCatalogue() *Catalogue {
+	ids := make([]string, 0, 12)
+	for i := 1; i <= 12; i++ {
+		ids = append(ids, fmt.Sprintf("default_%02d", i))
+	}
+	return NewCatalogue("/avatars/catalogue", ids...)
+}
+
+// Url returns url of catalogue avatar, false if id is unknown.
+func (c *Catalogue) Url(id string) (string, bool) {
+	i := sort.SearchStrings(c.Ids, id)
+	if i == len(c.Ids) || c.Ids[i] != id {
+		return "", false
+	}
+
+	return path.Join(c.BaseUrl, id+".png"), true
+}
+
+// DiskStorage stores images in local directory, one file per player.
+type DiskStorage struct {
+	dir     string
+	baseUrl string
+}
+
+func NewDiskStorage(dir, baseUrl string) (*DiskStorage, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	return &DiskStorage{dir: dir, baseUrl: baseUrl}, nil
+}
+
+func (s *DiskStorage) Save(ctx context.Context, playerId string, image []byte) (string, error) {
+	ext, err := imageExt(image)
+	if err != nil {
+		return "", err
+	}
+
+	// remove image with other extension if any
+	for _, e := range []string{".png", ".jpg"} {
+		if e != ext {
+			os.Remove(filepath.Join(s.dir, playerId+e))
+		}
+	}
+
+	name := playerId + ext
+	if err = ioutil.WriteFile(filepath.Join(s.dir, name), image, 0644); err != nil {
+		return "", err
+	}
+
+	return path.Join(s.baseUrl, name), nil
+}
+
+// Handler serves stored images.
+func (s *DiskStorage) Handler() http.Handler {
+	return http.StripPrefix(s.baseUrl+"/", http.FileServer(http.Dir(s.dir)))
+}
+
+func imageExt(image []byte) (string, error) {
+	if len(image) > MaxImageSize {
+		return "", ErrImageTooLarge
+	}
+
+	switch http.DetectContentType(image) {
+	case "image/png":
+		return ".png", nil
+	case "image/jpeg":
+		return ".jpg", nil
+	}
+
+	return "", ErrInvalidImage
+}
@@ -0,0 +1,57 @@
+package avatar
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var pngHeader = []byte("\x89PNG\x0D\x0A\x1A\x0A\x00\x00\x00\x0DIHDR")
+
+func TestCatalogueUrl(t *testing.T) {
+	c := NewCatalogue("/avatars", "b", "a")
+
+	if url, ok := c.Url("a"); !ok || url != "/avatars/a.png" {
+		t.Fatalf("unexpected url %q %v", url, ok)
+	}
+
+	if _, ok := c.Url("c"); ok {
+		t.Fatal("unknown id accepted")
+	}
+}
+
+func TestDiskStorageSave(t *testing.T) {
+	dir, err := ioutil.TempDir("", "avatars")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s, err := NewDiskStorage(dir, "/avatars/players")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	url, err := s.Save(context.Background(), "p1", pngHeader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if url != "/avatars/players/p1.png" {
+		t.Fatalf("unexpected url %q", url)
+	}
+
+	if _, err = os.Stat(filepath.Join(dir, "p1.png")); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = s.Save(context.Background(), "p1", []byte("GIF89a")); err != ErrInvalidImage {
+		t.Fatalf("expected invalid image, got %v", err)
+	}
+
+	if _, err = s.Save(context.Background(), "p1", make([]byte, MaxImageSize+1)); err != ErrImageTooLarge {
+		t.Fatalf("expected too large, got %v", err)
+	}
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Handzo/gogame/common/log"
+	"github.com/Handzo/gogame/gameservice/service/collusion"
+	"github.com/Handzo/gogame/rmq"
+)
+
+// CollusionRules define how often recent games are analyzed for
+// collusion and multiple accounts.
+type CollusionRules struct {
+	// Interval between analysis runs
+	Interval time.Duration
+	// Window is how far back games and sessions are analyzed
+	Window time.Duration
+	// Batch is number of tables loaded at once
+	Batch int
+	collusion.Rules
+}
+
+// analyzeCollusion replays games finished within the window and queues
+// suspicious patterns for admin review.
+func (g *gameService) analyzeCollusion(ctx context.Context, task *rmq.Task) error {
+	now := time.Now()
+	defer g.scheduleCollusion(now)
+
+	rules := g.config.Collusion
+	since := now.Add(-rules.Window)
+
+	// tables are replayed batch by batch, only aggregates are kept
+	analysis := collusion.New(rules.Rules)
+	count := 0
+	for {
+		tables, err := g.repo.GetPlayedTables(ctx, since, count, rules.Batch)
+		if err != nil {
+			return err
+		}
+
+		analysis.Add(tables...)
+		count += len(tables)
+
+		if len(tables) == 0 || len(tables) < rules.Batch {
+			break
+		}
+	}
+
+	sessions, err := g.repo.GetSessionsOfPlayers(ctx, analysis.Players(), since)
+	if err != nil {
+		return err
+	}
+
+	cases := analysis.Cases(sessions)
+
+	queued, err := g.repo.QueueReviewCases(ctx, cases...)
+	if err != nil {
+		return err
+	}
+
+	g.logger.For(ctx).Info("Collusion analyzed", log.Int("tables", count), log.Int("cases", len(cases)), log.Int("queued", queued))
+
+	return nil
+}
+
+// scheduleCollusion adds analysis task at the next interval boundary.
+func (g *gameService) scheduleCollusion(now time.Time) {
+	interval := g.config.Collusion.Interval
+	at := now.Truncate(interval).Add(interval)
+
+	g.worker.AddTask(rmq.NewTask(
+		ANALYZE_COLLUSION,
+		"collusion",
+		rmq.WithExecTime(at),
+		rmq.WithId(ANALYZE_COLLUSION+":"+at.UTC().Format(time.RFC3339)),
+	))
+}
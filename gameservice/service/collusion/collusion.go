@@ -0,0 +1,581 @@
+// Package collusion looks through finished games for patterns of players
+// cooperating across teams or playing several accounts.
+package collusion
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	enginesig "github.com/Handzo/gogame/gameengine/service"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+)
+
+const (
+	// feedPoints is the least worth of card counted as fed to opponent
+	feedPoints = 10
+	// maxEvidence limits tables attached to a case
+	maxEvidence = 20
+)
+
+// Rules define when pattern is suspicious enough to be reviewed.
+type Rules struct {
+	// MinFeeds is how many times player has to give points to the same
+	// opponent, FeedRate is share of feeds among chances to do so
+	MinFeeds int
+	FeedRate float64
+	// MinTogether is how many tables pair of players has to share,
+	// TogetherRate is share of tables of the less active of them
+	MinTogether  int
+	TogetherRate float64
+	// MinDecisions is how many moves with different outcomes player has
+	// to make, PerfectRate is share of them which are best in hindsight
+	MinDecisions int
+	PerfectRate  float64
+	// SharedRate is share of time the less active of players seated
+	// together spent on shared remote host while the other was online
+	// from it too
+	SharedRate float64
+}
+
+// trick is a deal replayed from stored moves. Seats are participant
+// orders minus one.
+type trick struct {
+	tableId string
+	players [4]string
+	hands   [4]string
+	trump   string
+	leader  int
+	cards   []string
+}
+
+// pair is ordered for feeding and sorted otherwise.
+type pair struct {
+	a, b string
+}
+
+// span is time session was open.
+type span struct {
+	from, to time.Time
+}
+
+// Analysis collects patterns of tables added in batches.
+type Analysis struct {
+	rules       Rules
+	feeds       map[pair]int
+	feedChances map[pair]int
+	feedTables  map[pair][]string
+	together    map[pair]int
+	opposed     map[pair]int
+	pairTables  map[pair][]string
+	games       map[string]int
+	decisions   map[string]int
+	perfect     map[string]int
+	tables      map[string][]string
+}
+
+// Analyze returns cases found in tables, which have to be loaded with
+// participants, rounds, deals and deal orders. Sessions are checked for
+// accounts sharing remote host.
+func Analyze(rules Rules, tables []*model.Table, sessions []*model.Session) []*model.ReviewCase {
+	a := New(rules)
+	a.Add(tables...)
+	return a.Cases(sessions)
+}
+
+// New returns empty analysis tables are added to.
+func New(rules Rules) *Analysis {
+	return &Analysis{
+		rules:       rules,
+		feeds:       map[pair]int{},
+		feedChances: map[pair]int{},
+		feedTables:  map[pair][]string{},
+		together:    map[pair]int{},
+		opposed:     map[pair]int{},
+		pairTables:  map[pair][]string{},
+		games:       map[string]int{},
+		decisions:   map[string]int{},
+		perfect:     map[string]int{},
+		tables:      map[string][]string{},
+	}
+}
+
+// Add replays tables, so they may be released before the next batch.
+func (a *Analysis) Add(tables ...*model.Table) {
+	for _, table := range tables {
+		a.seating(table)
+		for _, t := range tricks(table) {
+			a.play(t)
+		}
+	}
+}
+
+// Players returns ids of players seated at added tables.
+func (a *Analysis) Players() []string {
+	ids := make([]string, 0, len(a.games))
+	for id := range a.games {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+// Cases returns cases found in added tables and given sessions.
+func (a *Analysis) Cases(sessions []*model.Session) []*model.ReviewCase {
+	cases := append(a.feeding(), a.seatedTogether()...)
+	cases = append(cases, a.sharedRemotes(sessions)...)
+	cases = append(cases, a.perfectPlay()...)
+
+	sort.Slice(cases, func(i, j int) bool {
+		return cases[i].Key < cases[j].Key
+	})
+
+	return cases
+}
+
+func (a *Analysis) seating(table *model.Table) {
+	var seats [4]string
+	for _, p := range table.Participants {
+		if p.PlayerId != "" && p.Order >= 1 && p.Order <= 4 {
+			seats[p.Order-1] = p.PlayerId
+		}
+	}
+
+	for i, id := range seats {
+		if id == "" {
+			continue
+		}
+
+		a.games[id]++
+		for j := i + 1; j < len(seats); j++ {
+			if seats[j] == "" || seats[j] == id {
+				continue
+			}
+
+			p := sorted(id, seats[j])
+			a.together[p]++
+			if i%2 != j%2 {
+				a.opposed[p]++
+			}
+			a.pairTables[p] = evidence(a.pairTables[p], table.Id)
+		}
+	}
+}
+
+func (a *Analysis) play(t *trick) {
+	winner := t.seat(enginesig.TrickWinner(t.cards, t.trump))
+
+	for i, card := range t.cards {
+		s := t.seat(i)
+		player := t.players[s]
+		if player == "" {
+			continue
+		}
+
+		options := t.options(i)
+
+		if s%2 != winner%2 && t.players[winner] != "" {
+			cheapest, dearest := bounds(options)
+			if cheapest < dearest {
+				p := pair{player, t.players[winner]}
+				a.feedChances[p]++
+				if points := enginesig.Points(card); points >= feedPoints && points > cheapest {
+					a.feeds[p]++
+					a.feedTables[p] = evidence(a.feedTables[p], t.tableId)
+				}
+			}
+		}
+
+		// the last card is played knowing the whole trick
+		if i == len(t.cards)-1 || len(options) < 2 {
+			continue
+		}
+
+		best, worst := t.outcome(i, options[0]), t.outcome(i, options[0])
+		for _, o := range options[1:] {
+			v := t.outcome(i, o)
+			if v > best {
+				best = v
+			}
+			if v < worst {
+				worst = v
+			}
+		}
+
+		if best == worst {
+			continue
+		}
+
+		a.decisions[player]++
+		if t.outcome(i, card) == best {
+			a.perfect[player]++
+			a.tables[player] = evidence(a.tables[player], t.tableId)
+		}
+	}
+}
+
+func (a *Analysis) feeding() []*model.ReviewCase {
+	var cases []*model.ReviewCase
+	for p, n := range a.feeds {
+		rate := float64(n) / float64(a.feedChances[p])
+		if n < a.rules.MinFeeds || rate < a.rules.FeedRate {
+			continue
+		}
+
+		cases = append(cases, &model.ReviewCase{
+			Key:       key(model.POINT_FEEDING, p.a, p.b),
+			Kind:      model.POINT_FEEDING,
+			PlayerIds: []string{p.a, p.b},
+			TableIds:  a.feedTables[p],
+			Score:     rate,
+			Details:   fmt.Sprintf("first player gave points to opponent %d times of %d chances", n, a.feedChances[p]),
+			State:     model.UNDER_REVIEW,
+		})
+	}
+
+	return cases
+}
+
+func (a *Analysis) seatedTogether() []*model.ReviewCase {
+	var cases []*model.ReviewCase
+	for p, n := range a.together {
+		games := a.games[p.a]
+		if a.games[p.b] < games {
+			games = a.games[p.b]
+		}
+
+		rate := float64(n) / float64(games)
+		if n < a.rules.MinTogether || rate < a.rules.TogetherRate {
+			continue
+		}
+
+		cases = append(cases, &model.ReviewCase{
+			Key:       key(model.SEATED_TOGETHER, p.a, p.b),
+			Kind:      model.SEATED_TOGETHER,
+			PlayerIds: []string{p.a, p.b},
+			TableIds:  a.pairTables[p],
+			Score:     rate,
+			Details:   fmt.Sprintf("%d tables together, %d of them as opponents", n, a.opposed[p]),
+			State:     model.UNDER_REVIEW,
+		})
+	}
+
+	return cases
+}
+
+// sharedRemotes finds players seated together who were online from
+// the same host at the same time. Players behind the same NAT or proxy
+// who come at different times overlap little and are not raised.
+func (a *Analysis) sharedRemotes(sessions []*model.Session) []*model.ReviewCase {
+	now := time.Now()
+
+	// sessions of every player by host
+	hosts := map[string]map[string][]span{}
+	for _, s := range sessions {
+		if s.PlayerId == "" {
+			continue
+		}
+
+		h := host(s.Remote)
+		if hosts[h] == nil {
+			hosts[h] = map[string][]span{}
+		}
+
+		to := s.ClosedAt
+		if to.IsZero() {
+			to = now
+		}
+		hosts[h][s.PlayerId] = append(hosts[h][s.PlayerId], span{s.CreatedAt, to})
+	}
+
+	together := map[pair]time.Duration{}
+	online := map[pair][2]time.Duration{}
+	shared := map[pair][]string{}
+	for h, players := range hosts {
+		ids := make([]string, 0, len(players))
+		for id, spans := range players {
+			players[id] = merge(spans)
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+
+		for i := range ids {
+			for j := i + 1; j < len(ids); j++ {
+				p := pair{ids[i], ids[j]}
+				if len(a.pairTables[p]) == 0 {
+					continue
+				}
+
+				together[p] += overlap(players[p.a], players[p.b])
+				o := online[p]
+				online[p] = [2]time.Duration{o[0] + duration(players[p.a]), o[1] + duration(players[p.b])}
+				shared[p] = append(shared[p], h)
+			}
+		}
+	}
+
+	var cases []*model.ReviewCase
+	for p, d := range together {
+		least := online[p][0]
+		if online[p][1] < least {
+			least = online[p][1]
+		}
+
+		if d == 0 || least == 0 {
+			continue
+		}
+
+		rate := float64(d) / float64(least)
+		if rate < a.rules.SharedRate {
+			continue
+		}
+
+		sort.Strings(shared[p])
+		cases = append(cases, &model.ReviewCase{
+			Key:       key(model.SHARED_REMOTE, p.a, p.b),
+			Kind:      model.SHARED_REMOTE,
+			PlayerIds: []string{p.a, p.b},
+			TableIds:  a.pairTables[p],
+			Score:     rate,
+			Details:   fmt.Sprintf("sessions from %s, online together %d%% of time", strings.Join(shared[p], ", "), int(rate*100+0.5)),
+			State:     model.UNDER_REVIEW,
+		})
+	}
+
+	return cases
+}
+
+func (a *Analysis) perfectPlay() []*model.ReviewCase {
+	var cases []*model.ReviewCase
+	for player, n := range a.decisions {
+		rate := float64(a.perfect[player]) / float64(n)
+		if n < a.rules.MinDecisions || rate < a.rules.PerfectRate {
+			continue
+		}
+
+		cases = append(cases, &model.ReviewCase{
+			Key:       key(model.PERFECT_PLAY, player),
+			Kind:      model.PERFECT_PLAY,
+			PlayerIds: []string{player},
+			TableIds:  a.tables[player],
+			Score:     rate,
+			Details:   fmt.Sprintf("best move in hindsight %d times of %d", a.perfect[player], n),
+			State:     model.UNDER_REVIEW,
+		})
+	}
+
+	return cases
+}
+
+// tricks replays deals of table. Deals not played out completely or not
+// matching the seating are skipped.
+func tricks(table *model.Table) []*trick {
+	seats := map[string]int{}
+	var players [4]string
+	for _, p := range table.Participants {
+		if p.Order < 1 || p.Order > 4 {
+			continue
+		}
+
+		seats[p.Id] = p.Order - 1
+		players[p.Order-1] = p.PlayerId
+	}
+
+	var ts []*trick
+	for _, round := range table.Rounds {
+	deals:
+		for _, deal := range round.Deals {
+			if len(deal.DealOrders) != 4 {
+				continue
+			}
+
+			sig, err := enginesig.Parse(deal.Signature)
+			if err != nil {
+				continue
+			}
+
+			orders := append([]*model.DealOrder(nil), deal.DealOrders...)
+			sort.SliceStable(orders, func(i, j int) bool {
+				return orders[i].StartTime.Before(orders[j].StartTime)
+			})
+
+			t := &trick{
+				tableId: table.Id,
+				players: players,
+				trump:   sig.Trump,
+				leader:  sig.Turn,
+			}
+			copy(t.hands[:], sig.PlayerCards)
+
+			for i, o := range orders {
+				s, ok := seats[o.ParticipantId]
+				if !ok || s != t.seat(i) || !held(t.hands[s], o.Signature) {
+					continue deals
+				}
+				t.cards = append(t.cards, o.Signature)
+			}
+
+			ts = append(ts, t)
+		}
+	}
+
+	return ts
+}
+
+// seat returns seat which plays i-th card of the trick.
+func (t *trick) seat(i int) int {
+	return (t.leader + i) % 4
+}
+
+// options returns cards the i-th player was allowed to play.
+func (t *trick) options(i int) []string {
+	hand := t.hands[t.seat(i)]
+	table := strings.Join(t.cards[:i], "")
+
+	var options []string
+	for j := 0; j+2 <= len(hand); j += 2 {
+		if card := hand[j : j+2]; enginesig.ValidMove(table, hand, card, t.trump) {
+			options = append(options, card)
+		}
+	}
+
+	return options
+}
+
+// outcome returns points the team of i-th player wins, or loses when
+// negative, had the player played card and the others kept their cards.
+func (t *trick) outcome(i int, card string) int {
+	cards := append([]string(nil), t.cards...)
+	cards[i] = card
+
+	points := 0
+	for _, c := range cards {
+		points += enginesig.Points(c)
+	}
+
+	if t.seat(enginesig.TrickWinner(cards, t.trump))%2 != t.seat(i)%2 {
+		return -points
+	}
+
+	return points
+}
+
+func bounds(cards []string) (min, max int) {
+	for i, c := range cards {
+		p := enginesig.Points(c)
+		if i == 0 || p < min {
+			min = p
+		}
+		if p > max {
+			max = p
+		}
+	}
+
+	return min, max
+}
+
+// held reports whether hand has card.
+func held(hand, card string) bool {
+	for i := 0; i+2 <= len(hand); i += 2 {
+		if hand[i:i+2] == card {
+			return true
+		}
+	}
+
+	return false
+}
+
+func contains(ids []string, id string) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func key(kind model.ReviewKind, playerIds ...string) string {
+	return string(kind) + ":" + strings.Join(playerIds, ":")
+}
+
+func sorted(a, b string) pair {
+	if b < a {
+		return pair{b, a}
+	}
+
+	return pair{a, b}
+}
+
+// evidence appends table unless it is already the last one or the limit
+// is reached.
+func evidence(tables []string, tableId string) []string {
+	if len(tables) >= maxEvidence || len(tables) > 0 && tables[len(tables)-1] == tableId {
+		return tables
+	}
+
+	return append(tables, tableId)
+}
+
+// host strips port from remote address.
+func host(remote string) string {
+	if h, _, err := net.SplitHostPort(remote); err == nil {
+		return h
+	}
+
+	return remote
+}
+
+// merge sorts spans and joins overlapping ones.
+func merge(spans []span) []span {
+	sort.Slice(spans, func(i, j int) bool {
+		return spans[i].from.Before(spans[j].from)
+	})
+
+	merged := []span{}
+	for _, s := range spans {
+		if n := len(merged); n > 0 && !s.from.After(merged[n-1].to) {
+			if s.to.After(merged[n-1].to) {
+				merged[n-1].to = s.to
+			}
+			continue
+		}
+
+		merged = append(merged, s)
+	}
+
+	return merged
+}
+
+// overlap returns time both merged span lists cover.
+func overlap(a, b []span) time.Duration {
+	var d time.Duration
+	for _, x := range a {
+		for _, y := range b {
+			from, to := x.from, x.to
+			if y.from.After(from) {
+				from = y.from
+			}
+			if y.to.Before(to) {
+				to = y.to
+			}
+			if to.After(from) {
+				d += to.Sub(from)
+			}
+		}
+	}
+
+	return d
+}
+
+func duration(spans []span) time.Duration {
+	var d time.Duration
+	for _, s := range spans {
+		d += s.to.Sub(s.from)
+	}
+
+	return d
+}
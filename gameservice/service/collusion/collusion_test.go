@@ -0,0 +1,129 @@
+package collusion
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+)
+
+// table has the same trick played by p1..p4 seated in order. Spades are
+// trump, p1 leads seven of hearts, p2 and p4 give ace and ten of hearts
+// to p3 who trumps instead of discarding seven of diamonds.
+func table(id string) *model.Table {
+	t := &model.Table{}
+	t.Id = id
+
+	cards := []string{"52", "c2", "71", "82"}
+	deal := &model.Deal{Signature: "52:c262:7153:8272:1:0::0:0:0:0:::0:0"}
+	start := time.Now()
+
+	for i := 0; i < 4; i++ {
+		p := &model.Participant{PlayerId: "p" + string(rune('1'+i)), Order: i + 1}
+		p.Id = id + string(rune('a'+i))
+		t.Participants = append(t.Participants, p)
+
+		// orders are not guaranteed to be loaded sorted
+		deal.DealOrders = append([]*model.DealOrder{{
+			StartTime:     start.Add(time.Duration(i) * time.Second),
+			Signature:     cards[i],
+			ParticipantId: p.Id,
+		}}, deal.DealOrders...)
+	}
+
+	t.Rounds = []*model.Round{{Deals: []*model.Deal{deal}}}
+	return t
+}
+
+func TestAnalyze(t *testing.T) {
+	rules := Rules{
+		MinFeeds:     3,
+		FeedRate:     0.5,
+		MinTogether:  3,
+		TogetherRate: 0.9,
+		MinDecisions: 3,
+		PerfectRate:  0.9,
+		SharedRate:   0.5,
+	}
+
+	tables := []*model.Table{table("t1"), table("t2"), table("t3")}
+	sessions := []*model.Session{
+		session("p1", "10.0.0.1:5000", 0, 2),
+		session("p2", "10.0.0.1:6000", 1, 2),
+		session("p2", "10.0.0.2:6000", 3, 5),
+		session("p1", "10.0.0.2:5000", 3, 4),
+		// the same NAT at different times
+		session("p3", "10.0.0.3:7000", 0, 1),
+		session("p4", "10.0.0.3:7001", 2, 3),
+	}
+
+	cases := Analyze(rules, tables, sessions)
+
+	var keys []string
+	for _, c := range cases {
+		keys = append(keys, c.Key)
+	}
+
+	expected := []string{
+		"perfect_play:p3",
+		"point_feeding:p2:p3",
+		"point_feeding:p4:p3",
+		"seated_together:p1:p2",
+		"seated_together:p1:p3",
+		"seated_together:p1:p4",
+		"seated_together:p2:p3",
+		"seated_together:p2:p4",
+		"seated_together:p3:p4",
+		"shared_remote:p1:p2",
+	}
+
+	if strings.Join(keys, " ") != strings.Join(expected, " ") {
+		t.Fatalf("unexpected cases %v", keys)
+	}
+
+	for _, c := range cases {
+		if c.State != model.UNDER_REVIEW || len(c.TableIds) != 3 {
+			t.Fatalf("unexpected case %+v", c)
+		}
+	}
+
+	if d := cases[len(cases)-1].Details; d != "sessions from 10.0.0.1, 10.0.0.2, online together 67% of time" {
+		t.Fatalf("unexpected details %q", d)
+	}
+
+	// tables added in batches give the same cases
+	a := New(rules)
+	for _, tb := range tables {
+		a.Add(tb)
+	}
+
+	if n := len(a.Cases(sessions)); n != len(expected) {
+		t.Fatalf("expected %d cases from batches, got %d", len(expected), n)
+	}
+
+	// nothing is suspicious below thresholds
+	rules.MinFeeds, rules.MinTogether, rules.MinDecisions = 4, 4, 4
+	if cases = Analyze(rules, tables, nil); len(cases) != 0 {
+		t.Fatalf("unexpected cases %d", len(cases))
+	}
+}
+
+func TestTricksSkipsInconsistentDeals(t *testing.T) {
+	tb := table("t1")
+	tb.Rounds[0].Deals[0].DealOrders[0].Signature = "c3"
+
+	if ts := tricks(tb); len(ts) != 0 {
+		t.Fatalf("expected deal with card not in hand to be skipped")
+	}
+}
+
+// session returns session of player open between given hours.
+func session(playerId, remote string, from, to int) *model.Session {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	s := &model.Session{PlayerId: playerId, Remote: remote}
+	s.CreatedAt = start.Add(time.Duration(from) * time.Hour)
+	s.ClosedAt = start.Add(time.Duration(to) * time.Hour)
+	return s
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"time"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/Handzo/gogame/gameservice/service/achievement"
+	"github.com/Handzo/gogame/gameservice/service/avatar"
+	"github.com/Handzo/gogame/gameservice/service/collusion"
+	"github.com/Handzo/gogame/gameservice/service/quest"
+	"github.com/Handzo/gogame/gameservice/service/tournament"
+)
+
+// Config holds game rules which may be tuned without code changes.
+type Config struct {
+	Levels  LevelCurve
+	Exp     ExpRules
+	Rating  RatingRules
+	Forfeit ForfeitRules
+	Avatars *avatar.Catalogue
+	// Achievements are evaluated when deals, rounds and games finish.
+	Achievements []*achievement.Achievement
+	// InvitationTTL is how long table invitation can be accepted.
+	InvitationTTL time.Duration
+	// SeatSwapTTL is how long seat swap request can be accepted.
+	SeatSwapTTL time.Duration
+	// RematchTimeout is how long players of finished table vote for rematch.
+	RematchTimeout time.Duration
+	// LeaderboardRebuild is interval of leaderboards rebuild from database.
+	LeaderboardRebuild time.Duration
+	// DailyRewards are nuts granted for consecutive days of claiming,
+	// the last value is granted for every further day.
+	DailyRewards DailyRewards
+	// Quests are rotated daily, QuestsPerDay of them are active at once.
+	Quests       []*quest.Quest
+	QuestsPerDay int
+	// Tournaments are scheduled from templates every TournamentSchedule.
+	Tournaments        []*tournament.Template
+	TournamentSchedule time.Duration
+	// ItemsExpiry is interval between sweeps of expired inventory items.
+	ItemsExpiry time.Duration
+	Janitor     JanitorRules
+	Collusion   CollusionRules
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		Levels: LevelCurve{
+			Base:     100,
+			Growth:   1.5,
+			MaxLevel: 100,
+			Rewards: map[uint32]model.Reward{
+				5:  {Nuts: 100},
+				10: {Nuts: 500},
+				20: {Nuts: 1000, Gold: 10},
+				50: {Nuts: 5000, Gold: 50},
+			},
+		},
+		Exp: ExpRules{
+			RoundWin:  10,
+			RoundLoss: 2,
+			GameWin:   50,
+			GameLoss:  10,
+			BetFactor: 0.1,
+		},
+		Rating: RatingRules{
+			K: 32,
+		},
+		Forfeit: ForfeitRules{
+			Nuts:   100,
+			Rating: 15,
+		},
+		Avatars:            avatar.DefaultCatalogue(),
+		Achievements:       achievement.Defaults(),
+		InvitationTTL:      2 * time.Minute,
+		SeatSwapTTL:        30 * time.Second,
+		RematchTimeout:     30 * time.Second,
+		LeaderboardRebuild: time.Hour,
+		DailyRewards:       DailyRewards{50, 75, 100, 150, 200, 300, 500},
+		Quests:             quest.Defaults(),
+		QuestsPerDay:       3,
+		Tournaments:        tournament.Defaults(),
+		TournamentSchedule: 10 * time.Minute,
+		ItemsExpiry:        time.Minute,
+		Janitor: JanitorRules{
+			Interval:     time.Minute,
+			WaitingIdle:  30 * time.Minute,
+			Disconnected: 5 * time.Minute,
+			Forfeit:      true,
+		},
+		Collusion: CollusionRules{
+			Interval: 24 * time.Hour,
+			Window:   7 * 24 * time.Hour,
+			Batch:    500,
+			Rules: collusion.Rules{
+				MinFeeds:     5,
+				FeedRate:     0.5,
+				MinTogether:  10,
+				TogetherRate: 0.8,
+				MinDecisions: 50,
+				PerfectRate:  0.95,
+				SharedRate:   0.5,
+			},
+		},
+	}
+}
@@ -2,14 +2,42 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 
+	authpb "github.com/Handzo/gogame/authservice/proto"
+	"github.com/Handzo/gogame/common/log"
 	"github.com/Handzo/gogame/gameservice/code"
 	pb "github.com/Handzo/gogame/gameservice/proto"
 	"github.com/Handzo/gogame/gameservice/repository"
+	"github.com/Handzo/gogame/gameservice/repository/model"
 	"github.com/Handzo/gogame/gameservice/service/pubsub"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
+const (
+	adminPrefix = "/AdminService/"
+	adminRole   = "admin"
+)
+
+// seatMethods act on player's seat, so only the seat controlling
+// session may call them.
+var seatMethods = map[string]bool{
+	"/GameService/BecomeParticipant": true,
+	"/GameService/Ready":             true,
+	"/GameService/MakeMove":          true,
+	"/GameService/LeaveTable":        true,
+	"/GameService/StandUp":           true,
+	"/GameService/MoveToSeat":        true,
+	"/GameService/RequestSeatSwap":   true,
+	"/GameService/AcceptSeatSwap":    true,
+	"/GameService/KickParticipant":   true,
+	"/GameService/LockSeat":          true,
+	"/GameService/CloseTable":        true,
+	"/GameService/Rematch":           true,
+}
+
 func AuthServerInterceptor(repo repository.GameRepository, pubsub *pubsub.PubSub) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
@@ -19,6 +47,11 @@ func AuthServerInterceptor(repo repository.GameRepository, pubsub *pubsub.PubSub
 	) (interface{}, error) {
 		remote := ctx.Value("remote").(string)
 
+		// admins are authorized by AdminServerInterceptor
+		if strings.HasPrefix(info.FullMethod, adminPrefix) {
+			return handler(ctx, req)
+		}
+
 		if info.FullMethod == "/GameService/OpenSession" {
 			res, err := handler(ctx, req)
 			if err == nil {
@@ -38,12 +71,124 @@ func AuthServerInterceptor(repo repository.GameRepository, pubsub *pubsub.PubSub
 			return nil, code.SessionNotFound
 		}
 
-		if info.FullMethod == "/GameService/CloseSession" {
-			go pubsub.Unbind(ctx, session.PlayerId)
+		// other devices of player only watch the table
+		if seatMethods[info.FullMethod] && !session.Controller {
+			return nil, code.NotSeatController
 		}
 
 		ctx = context.WithValue(ctx, "player_id", session.PlayerId)
+		ctx = context.WithValue(ctx, "seat_controller", session.Controller)
+		ctx = context.WithValue(ctx, "session_id", session.Id)
 
 		return handler(ctx, req)
 	}
 }
+
+// adminMutations change players, tables or balances, so they are
+// audited before they run.
+var adminMutations = map[string]bool{
+	"/AdminService/BanPlayer":         true,
+	"/AdminService/MutePlayer":        true,
+	"/AdminService/AdjustBalance":     true,
+	"/AdminService/ForceCloseTable":   true,
+	"/AdminService/BroadcastMessage":  true,
+	"/AdminService/ResolveReviewCase": true,
+}
+
+// AdminServerInterceptor admits calls of admin service made with token
+// of admin user in metadata and writes every call, rejected ones too,
+// to the audit log. Mutating calls are not made unless audited.
+// Calls of other services are passed through.
+func AdminServerInterceptor(authsvc authpb.AuthServiceClient, repo repository.GameRepository, logger log.Factory) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, adminPrefix) {
+			return handler(ctx, req)
+		}
+
+		action := auditAction(req)
+		action.Method = strings.TrimPrefix(info.FullMethod, adminPrefix)
+		action.Remote = ctx.Value("remote").(string)
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		tokens := md.Get("token")
+
+		var admin *authpb.ValidateResponse
+		var err error
+		if len(tokens) != 0 {
+			admin, err = authsvc.Validate(ctx, &authpb.ValidateRequest{Token: tokens[0]})
+		}
+
+		if len(tokens) == 0 || err != nil || admin.Role != adminRole {
+			if admin != nil {
+				action.AdminId, action.AdminName = admin.UserId, admin.Username
+			}
+
+			action.Error = code.AdminRequired.Error()
+			if err = repo.Insert(ctx, action); err != nil {
+				logger.For(ctx).Error(err)
+			}
+
+			logger.For(ctx).Info("Admin call rejected", log.String("method", info.FullMethod))
+			return nil, code.AdminRequired
+		}
+
+		ctx = context.WithValue(ctx, "admin_id", admin.UserId)
+		action.AdminId = admin.UserId
+		action.AdminName = admin.Username
+
+		if !adminMutations[info.FullMethod] {
+			res, err := handler(ctx, req)
+			if err != nil {
+				action.Error = err.Error()
+			}
+
+			if insertErr := repo.Insert(ctx, action); insertErr != nil {
+				logger.For(ctx).Error(insertErr)
+			}
+
+			return res, err
+		}
+
+		if err = repo.Insert(ctx, action); err != nil {
+			return nil, code.AuditFailed
+		}
+
+		res, err := handler(ctx, req)
+		if err != nil {
+			action.Error = err.Error()
+			if updateErr := repo.Update(ctx, action, "error"); updateErr != nil {
+				logger.For(ctx).Error(updateErr)
+			}
+		}
+
+		return res, err
+	}
+}
+
+// auditAction picks player, table and reason of admin request.
+func auditAction(req interface{}) *model.AdminAction {
+	action := &model.AdminAction{}
+
+	if r, ok := req.(interface{ GetPlayerId() string }); ok {
+		action.PlayerId = r.GetPlayerId()
+	}
+
+	if r, ok := req.(interface{ GetTableId() string }); ok {
+		action.TableId = r.GetTableId()
+	}
+
+	if r, ok := req.(interface{ GetReason() string }); ok {
+		action.Reason = r.GetReason()
+	}
+
+	if data, err := json.Marshal(req); err == nil {
+		action.Request = string(data)
+	}
+
+	return action
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Handzo/gogame/common/log"
+	"github.com/Handzo/gogame/gameservice/code"
+	pb "github.com/Handzo/gogame/gameservice/proto"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/Handzo/gogame/gameservice/service/pubsub"
+	"github.com/Handzo/gogame/rmq"
+)
+
+type Entitlement string
+
+var (
+	PRIVATE_TABLES  Entitlement = "private_tables"
+	EXTRA_TIME_BANK Entitlement = "extra_time_bank"
+)
+
+// expiryBatch is how many expired items are removed at once.
+const expiryBatch = 100
+
+// entitlementItems maps features to inventory items which unlock them
+var entitlementItems = map[Entitlement]string{
+	PRIVATE_TABLES:  model.VIP_ITEM,
+	EXTRA_TIME_BANK: model.VIP_ITEM,
+}
+
+func (g *gameService) GetInventory(ctx context.Context, req *pb.GetInventoryRequest) (*pb.GetInventoryResponse, error) {
+	items, err := g.repo.GetInventory(ctx, ctx.Value("player_id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.InventoryItem, len(items))
+	for i, item := range items {
+		res[i] = &pb.InventoryItem{
+			Id:          item.Id,
+			Title:       item.GoodItem.Title,
+			Description: item.GoodItem.Description,
+			Quantity:    item.Quantity,
+		}
+
+		if !item.ExpiresAt.IsZero() {
+			res[i].ExpiresAt = item.ExpiresAt.Unix()
+		}
+	}
+
+	return &pb.GetInventoryResponse{
+		Items: res,
+	}, nil
+}
+
+// HasEntitlement reports whether player owns an active item unlocking the feature.
+func (g *gameService) HasEntitlement(ctx context.Context, playerId string, entitlement Entitlement) (bool, error) {
+	title, ok := entitlementItems[entitlement]
+	if !ok {
+		return false, nil
+	}
+
+	item, err := g.repo.FindInventoryItem(ctx, playerId, title)
+	if err != nil {
+		return false, err
+	}
+
+	return item != nil, nil
+}
+
+func (g *gameService) requireEntitlement(ctx context.Context, playerId string, entitlement Entitlement) error {
+	ok, err := g.HasEntitlement(ctx, playerId, entitlement)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return code.EntitlementRequired
+	}
+
+	return nil
+}
+
+// expireItems removes items expired since the previous sweep and tells
+// their owners.
+func (g *gameService) expireItems(ctx context.Context, task *rmq.Task) error {
+	now := time.Now()
+	defer g.scheduleItemsExpiry(now)
+
+	for {
+		items, err := g.repo.ExpireInventoryItems(ctx, expiryBatch)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			g.notify(ctx, item.PlayerId, &pubsub.Event{
+				Event: "ItemExpired",
+				Payload: &pubsub.ItemExpired{
+					Item: inventoryItem(item),
+				},
+			})
+		}
+
+		if len(items) > 0 {
+			g.logger.For(ctx).Info("Inventory items expired", log.Int("items", len(items)))
+		}
+
+		if len(items) < expiryBatch {
+			return nil
+		}
+	}
+}
+
+// scheduleItemsExpiry adds expiry sweep at the next interval boundary.
+func (g *gameService) scheduleItemsExpiry(now time.Time) {
+	interval := g.config.ItemsExpiry
+	at := now.Truncate(interval).Add(interval)
+
+	g.worker.AddTask(rmq.NewTask(
+		EXPIRE_ITEMS,
+		"inventory",
+		rmq.WithExecTime(at),
+		rmq.WithId(EXPIRE_ITEMS+":"+at.UTC().Format(time.RFC3339)),
+	))
+}
+
+func inventoryItem(item *model.InventoryItem) pubsub.InventoryItem {
+	i := pubsub.InventoryItem{
+		Id:        item.Id,
+		Quantity:  item.Quantity,
+		ExpiresAt: item.ExpiresAt,
+	}
+
+	if item.GoodItem != nil {
+		i.Title = item.GoodItem.Title
+	}
+
+	return i
+}
+
+func inventoryItems(items []*model.InventoryItem) []pubsub.InventoryItem {
+	res := make([]pubsub.InventoryItem, len(items))
+	for i, item := range items {
+		res[i] = inventoryItem(item)
+	}
+
+	return res
+}
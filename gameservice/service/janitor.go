@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Handzo/gogame/common/log"
+	enginesig "github.com/Handzo/gogame/gameengine/service"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/Handzo/gogame/rmq"
+)
+
+// JanitorRules define when tables nobody plays at are closed.
+type JanitorRules struct {
+	// Interval between janitor runs
+	Interval time.Duration
+	// WaitingIdle is how long table may wait for the game to start
+	WaitingIdle time.Duration
+	// Disconnected is how long started game may stall with every
+	// participant disconnected
+	Disconnected time.Duration
+	// Forfeit resolves stalled game in favor of the leading team.
+	// Otherwise, and on a tie, the game is abandoned and bets are
+	// not settled.
+	Forfeit bool
+}
+
+// cleanTables closes idle waiting tables and resolves started games
+// everybody has disconnected from.
+func (g *gameService) cleanTables(ctx context.Context, task *rmq.Task) error {
+	now := time.Now()
+	defer g.scheduleJanitor(now)
+
+	rules := g.config.Janitor
+
+	waiting, err := g.repo.GetIdleTables(ctx, now.Add(-rules.WaitingIdle), model.WAITING)
+	if err != nil {
+		return err
+	}
+
+	for _, table := range waiting {
+		if err = g.abandonTable(ctx, table, model.IDLE, "idle"); err != nil {
+			g.logger.For(ctx).Error(err)
+		}
+	}
+
+	playing, err := g.repo.GetIdleTables(ctx, now.Add(-rules.Disconnected), model.IN_ROUND, model.BETWEEN_ROUNDS)
+	if err != nil {
+		return err
+	}
+
+	for _, table := range playing {
+		if !allDisconnected(table.Participants) {
+			continue
+		}
+
+		if err = g.resolveDisconnected(ctx, table); err != nil {
+			g.logger.For(ctx).Error(err)
+		}
+	}
+
+	return nil
+}
+
+// resolveDisconnected finishes game of disconnected table. The trailing
+// team forfeits when forfeits are enabled and the score is not tied.
+func (g *gameService) resolveDisconnected(ctx context.Context, table *model.Table) error {
+	losing := 0
+	if g.config.Janitor.Forfeit {
+		sig, err := enginesig.Parse(table.Signature)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case sig.Team1Total < sig.Team2Total:
+			losing = 1
+		case sig.Team2Total < sig.Team1Total:
+			losing = 2
+		}
+	}
+
+	if losing == 0 {
+		return g.abandonTable(ctx, table, model.DESERTED, "disconnected")
+	}
+
+	for _, p := range table.Participants {
+		if team(p.Order) != losing {
+			continue
+		}
+
+		if err := g.changeState(ctx, p, model.LEFT); err != nil {
+			return err
+		}
+	}
+
+	table.Result = model.FORFEIT
+	if err := g.updateTable(ctx, table, "result"); err != nil {
+		return err
+	}
+
+	g.logger.For(ctx).Info("Disconnected table forfeited", log.String("table", table.Id), log.Int("team", losing))
+
+	g.worker.AddTask(rmq.NewTask(FINISH_GAME, table.Id))
+	return nil
+}
+
+// abandonTable closes table without winner and notifies players of given
+// reason. Seats are freed unless the game has been started, so players
+// of abandoned game are kept. Abandoned tournament table advances the
+// tournament as lost by every entry seated at it.
+func (g *gameService) abandonTable(ctx context.Context, table *model.Table, result, reason string) error {
+	started := table.IsOpen()
+
+	table.EndTime = time.Now()
+	table.Result = result
+
+	// bets are never escrowed, so nothing is refunded and the log
+	// only records zero payouts of the game without winner
+	if started {
+		table.Events = append(table.Events, payoutEvents(table, 0)...)
+	}
+
+	if err := g.transit(ctx, table, model.ABANDONED, "end_time", "result"); err != nil {
+		return err
+	}
+
+	for _, p := range table.Participants {
+		if started || p.State == model.FREE {
+			continue
+		}
+
+		if err := g.changeState(ctx, p, model.FREE); err != nil {
+			return err
+		}
+	}
+
+	g.closeRoom(ctx, table.Id, reason)
+
+	g.logger.For(ctx).Info("Table closed", log.String("table", table.Id), log.String("reason", reason))
+
+	if table.TournamentId != "" {
+		return g.tournamentTableFinished(ctx, table, 0)
+	}
+
+	return nil
+}
+
+// scheduleJanitor adds cleaning task at the next interval boundary.
+func (g *gameService) scheduleJanitor(now time.Time) {
+	interval := g.config.Janitor.Interval
+	at := now.Truncate(interval).Add(interval)
+
+	g.worker.AddTask(rmq.NewTask(
+		CLEAN_TABLES,
+		"janitor",
+		rmq.WithExecTime(at),
+		rmq.WithId(CLEAN_TABLES+":"+at.UTC().Format(time.RFC3339)),
+	))
+}
+
+func allDisconnected(participants []*model.Participant) bool {
+	for _, p := range participants {
+		if p.State != model.DISCONNECT {
+			return false
+		}
+	}
+
+	return len(participants) != 0
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+)
+
+func TestAllDisconnected(t *testing.T) {
+	cases := []struct {
+		name  string
+		seats []*model.Participant
+		want  bool
+	}{
+		{"everybody disconnected", seats(model.DISCONNECT, model.DISCONNECT, model.DISCONNECT, model.DISCONNECT), true},
+		{"one player connected", seats(model.DISCONNECT, model.READY, model.DISCONNECT, model.DISCONNECT), false},
+		{"player left", seats(model.DISCONNECT, model.LEFT, model.DISCONNECT, model.DISCONNECT), false},
+		{"no participants", nil, false},
+	}
+
+	for _, c := range cases {
+		if got := allDisconnected(c.seats); got != c.want {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,348 @@
+package leaderboard
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Handzo/gogame/common/log"
+	"github.com/go-redis/redis"
+)
+
+type Metric string
+
+var (
+	RATING   Metric = "rating"
+	WINS     Metric = "wins"
+	WINNINGS Metric = "winnings"
+)
+
+type Window string
+
+var (
+	DAILY    Window = "daily"
+	WEEKLY   Window = "weekly"
+	ALL_TIME Window = "all_time"
+)
+
+var (
+	Metrics = []Metric{RATING, WINS, WINNINGS}
+	Windows = []Window{DAILY, WEEKLY, ALL_TIME}
+)
+
+// windowTTL keeps finished periods for a while after they end.
+var windowTTL = map[Window]time.Duration{
+	DAILY:  8 * 24 * time.Hour,
+	WEEKLY: 5 * 7 * 24 * time.Hour,
+}
+
+// Board is a key of leaderboard sorted set. Empty country means
+// global board, currency is used by winnings metric only.
+type Board struct {
+	Metric   Metric
+	Window   Window
+	Currency string
+	Country  string
+}
+
+// Key returns sorted set key of board for period containing t.
+func (b Board) Key(t time.Time) string {
+	parts := []string{"leaderboard", string(b.Metric), string(b.Window), period(b.Window, t)}
+	if b.Metric == WINNINGS {
+		parts = append(parts, b.Currency)
+	}
+	if b.Country != "" {
+		parts = append(parts, "country", b.Country)
+	}
+	return strings.Join(parts, ":")
+}
+
+func period(w Window, t time.Time) string {
+	t = t.UTC()
+	switch w {
+	case DAILY:
+		return t.Format("2006-01-02")
+	case WEEKLY:
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	}
+	return "all"
+}
+
+// Result is player's games result at tables with one currency.
+type Result struct {
+	PlayerId     string
+	Country      string
+	Currency     string
+	Rating       int
+	RatingChange int
+	Wins         int
+	Net          int64
+}
+
+type Score struct {
+	PlayerId string
+	Rank     int64
+	Score    float64
+}
+
+type Leaderboards struct {
+	redis  *redis.Client
+	logger log.Factory
+}
+
+func New(redis *redis.Client, logger log.Factory) *Leaderboards {
+	return &Leaderboards{
+		redis:  redis,
+		logger: logger,
+	}
+}
+
+// Record adds game results to every window of global and country boards.
+func (l *Leaderboards) Record(ctx context.Context, t time.Time, results ...Result) error {
+	pipe := l.redis.TxPipeline()
+
+	for _, r := range results {
+		for _, w := range Windows {
+			for _, country := range countries(r.Country) {
+				rating := Board{Metric: RATING, Window: w, Country: country}.Key(t)
+				wins := Board{Metric: WINS, Window: w, Country: country}.Key(t)
+				winnings := Board{Metric: WINNINGS, Window: w, Currency: r.Currency, Country: country}.Key(t)
+
+				// all time rating is absolute, windows rank rating gained
+				if w == ALL_TIME {
+					pipe.ZAdd(rating, redis.Z{Score: float64(r.Rating), Member: r.PlayerId})
+				} else {
+					pipe.ZIncrBy(rating, float64(r.RatingChange), r.PlayerId)
+				}
+
+				if r.Wins != 0 {
+					pipe.ZIncrBy(wins, float64(r.Wins), r.PlayerId)
+				}
+
+				pipe.ZIncrBy(winnings, float64(r.Net), r.PlayerId)
+
+				if ttl, ok := windowTTL[w]; ok {
+					pipe.Expire(rating, ttl)
+					pipe.Expire(wins, ttl)
+					pipe.Expire(winnings, ttl)
+				}
+			}
+		}
+	}
+
+	_, err := pipe.Exec()
+	if err != nil {
+		l.logger.For(ctx).Error(err)
+	}
+	return err
+}
+
+// Top returns players of board period containing t, ordered by score.
+func (l *Leaderboards) Top(ctx context.Context, board Board, t time.Time, offset, limit int64) ([]Score, error) {
+	zs, err := l.redis.ZRevRangeWithScores(board.Key(t), offset, offset+limit-1).Result()
+	if err != nil {
+		l.logger.For(ctx).Error(err)
+		return nil, err
+	}
+
+	scores := make([]Score, len(zs))
+	for i, z := range zs {
+		scores[i] = Score{
+			PlayerId: z.Member.(string),
+			Rank:     offset + int64(i) + 1,
+			Score:    z.Score,
+		}
+	}
+
+	return scores, nil
+}
+
+// Rank returns player's position at the board, nil if player has no score.
+func (l *Leaderboards) Rank(ctx context.Context, board Board, t time.Time, playerId string) (*Score, error) {
+	key := board.Key(t)
+
+	rank, err := l.redis.ZRevRank(key, playerId).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		l.logger.For(ctx).Error(err)
+		return nil, err
+	}
+
+	score, err := l.redis.ZScore(key, playerId).Result()
+	if err != nil && err != redis.Nil {
+		l.logger.For(ctx).Error(err)
+		return nil, err
+	}
+
+	return &Score{PlayerId: playerId, Rank: rank + 1, Score: score}, nil
+}
+
+// Ranked ranks given players only using their scores at the board.
+// Players without score are omitted.
+func (l *Leaderboards) Ranked(ctx context.Context, board Board, t time.Time, playerIds []string) ([]Score, error) {
+	key := board.Key(t)
+
+	pipe := l.redis.Pipeline()
+	cmds := make([]*redis.FloatCmd, len(playerIds))
+	for i, id := range playerIds {
+		cmds[i] = pipe.ZScore(key, id)
+	}
+
+	if _, err := pipe.Exec(); err != nil && err != redis.Nil {
+		l.logger.For(ctx).Error(err)
+		return nil, err
+	}
+
+	scores := make([]Score, 0, len(playerIds))
+	for i, cmd := range cmds {
+		score, err := cmd.Result()
+		if err != nil {
+			continue
+		}
+		scores = append(scores, Score{PlayerId: playerIds[i], Score: score})
+	}
+
+	sort.SliceStable(scores, func(i, j int) bool {
+		return scores[i].Score > scores[j].Score
+	})
+
+	for i := range scores {
+		scores[i].Rank = int64(i) + 1
+	}
+
+	return scores, nil
+}
+
+// Rebuild replaces boards of window period containing t with given
+// results aggregated per player and currency.
+func (l *Leaderboards) Rebuild(ctx context.Context, w Window, t time.Time, results []Result) error {
+	boards := make(map[string][]redis.Z)
+	add := func(key string, score float64, playerId string) {
+		boards[key] = append(boards[key], redis.Z{Score: score, Member: playerId})
+	}
+
+	// wins and rating are summed across currencies
+	type total struct {
+		country string
+		rating  float64
+		wins    float64
+	}
+	totals := make(map[string]*total)
+
+	for _, r := range results {
+		tl, ok := totals[r.PlayerId]
+		if !ok {
+			tl = &total{country: r.Country}
+			totals[r.PlayerId] = tl
+		}
+
+		if w == ALL_TIME {
+			tl.rating = float64(r.Rating)
+		} else {
+			tl.rating += float64(r.RatingChange)
+		}
+
+		tl.wins += float64(r.Wins)
+
+		for _, country := range countries(r.Country) {
+			add(Board{Metric: WINNINGS, Window: w, Currency: r.Currency, Country: country}.Key(t), float64(r.Net), r.PlayerId)
+		}
+	}
+
+	for id, tl := range totals {
+		for _, country := range countries(tl.country) {
+			add(Board{Metric: RATING, Window: w, Country: country}.Key(t), tl.rating, id)
+			if tl.wins != 0 {
+				add(Board{Metric: WINS, Window: w, Country: country}.Key(t), tl.wins, id)
+			}
+		}
+	}
+
+	// boards are filled under temporary keys and swapped in at once, boards
+	// of the period nobody has results at anymore are removed
+	stale, err := l.keys(periodPattern(w, t))
+	if err != nil {
+		l.logger.For(ctx).Error(err)
+		return err
+	}
+
+	fill := l.redis.Pipeline()
+	for key, zs := range boards {
+		fill.Del(rebuildKey(key))
+		fill.ZAdd(rebuildKey(key), zs...)
+	}
+
+	if _, err = fill.Exec(); err != nil {
+		l.logger.For(ctx).Error(err)
+		return err
+	}
+
+	pipe := l.redis.TxPipeline()
+	for _, key := range stale {
+		if _, ok := boards[key]; !ok {
+			pipe.Del(key)
+		}
+	}
+
+	for key := range boards {
+		pipe.Rename(rebuildKey(key), key)
+		if ttl, ok := windowTTL[w]; ok {
+			pipe.Expire(key, ttl)
+		}
+	}
+
+	if _, err = pipe.Exec(); err != nil {
+		l.logger.For(ctx).Error(err)
+	}
+	return err
+}
+
+// keys returns keys matching pattern.
+func (l *Leaderboards) keys(pattern string) ([]string, error) {
+	var keys []string
+	iter := l.redis.Scan(0, pattern, 100).Iterator()
+	for iter.Next() {
+		keys = append(keys, iter.Val())
+	}
+
+	return keys, iter.Err()
+}
+
+// periodPattern matches keys of every board of window period containing t.
+func periodPattern(w Window, t time.Time) string {
+	return "leaderboard:*:" + string(w) + ":" + period(w, t) + "*"
+}
+
+// rebuildKey is temporary key board is rebuilt under.
+func rebuildKey(key string) string {
+	return "rebuild:" + key
+}
+
+// WindowStart returns time when period of window containing t has started.
+func WindowStart(w Window, t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch w {
+	case DAILY:
+		return day
+	case WEEKLY:
+		// ISO weeks start on monday
+		shift := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -shift)
+	}
+
+	return time.Time{}
+}
+
+func countries(country string) []string {
+	if country == "" {
+		return []string{""}
+	}
+	return []string{"", country}
+}
@@ -0,0 +1,54 @@
+package leaderboard
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBoardKey(t *testing.T) {
+	now := time.Date(2020, 6, 3, 15, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		board Board
+		key   string
+	}{
+		{Board{Metric: RATING, Window: ALL_TIME}, "leaderboard:rating:all_time:all"},
+		{Board{Metric: WINS, Window: DAILY, Country: "RU"}, "leaderboard:wins:daily:2020-06-03:country:RU"},
+		{Board{Metric: WINNINGS, Window: WEEKLY, Currency: "nuts"}, "leaderboard:winnings:weekly:2020-W23:nuts"},
+	}
+
+	for _, c := range cases {
+		if key := c.board.Key(now); key != c.key {
+			t.Errorf("expected %q, got %q", c.key, key)
+		}
+	}
+}
+
+func TestPeriodPattern(t *testing.T) {
+	now := time.Date(2020, 6, 3, 15, 0, 0, 0, time.UTC)
+
+	if p := periodPattern(DAILY, now); p != "leaderboard:*:daily:2020-06-03*" {
+		t.Errorf("unexpected pattern %q", p)
+	}
+
+	if p := periodPattern(ALL_TIME, now); p != "leaderboard:*:all_time:all*" {
+		t.Errorf("unexpected pattern %q", p)
+	}
+}
+
+func TestWindowStart(t *testing.T) {
+	// wednesday
+	now := time.Date(2020, 6, 3, 15, 0, 0, 0, time.UTC)
+
+	if start := WindowStart(DAILY, now); !start.Equal(time.Date(2020, 6, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected daily start %v", start)
+	}
+
+	if start := WindowStart(WEEKLY, now); !start.Equal(time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected weekly start %v", start)
+	}
+
+	if start := WindowStart(ALL_TIME, now); !start.IsZero() {
+		t.Errorf("unexpected all time start %v", start)
+	}
+}
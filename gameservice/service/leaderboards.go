@@ -0,0 +1,303 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/Handzo/gogame/common/log"
+	"github.com/Handzo/gogame/gameservice/code"
+	pb "github.com/Handzo/gogame/gameservice/proto"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/Handzo/gogame/gameservice/service/leaderboard"
+	"github.com/Handzo/gogame/rmq"
+)
+
+const (
+	GLOBAL_SCOPE  = "global"
+	COUNTRY_SCOPE = "country"
+	FRIENDS_SCOPE = "friends"
+
+	defaultLeaderboardLimit = 20
+	maxLeaderboardLimit     = 100
+)
+
+// RatingRules define Elo rating changes of team game.
+type RatingRules struct {
+	K float64
+}
+
+// Changes returns rating changes of both teams given their average ratings.
+func (r RatingRules) Changes(team1, team2 float64, winner int) (int, int) {
+	expected := 1 / (1 + math.Pow(10, (team2-team1)/400))
+
+	score := 0.0
+	if winner == 1 {
+		score = 1
+	}
+
+	change := int(math.Round(r.K * (score - expected)))
+	return change, -change
+}
+
+// recordGameResult updates ratings of table players and adds their
+// results to leaderboards.
+func (g *gameService) recordGameResult(ctx context.Context, table *model.Table, winner int) {
+	logger := g.logger.For(ctx)
+
+	var sums, counts [3]float64
+	for _, p := range table.Participants {
+		if p.Player == nil {
+			continue
+		}
+		sums[team(p.Order)] += float64(p.Player.Rating)
+		counts[team(p.Order)]++
+	}
+
+	if counts[1] == 0 || counts[2] == 0 {
+		return
+	}
+
+	var changes [3]int
+	changes[1], changes[2] = g.config.Rating.Changes(sums[1]/counts[1], sums[2]/counts[2], winner)
+
+	for _, p := range table.Participants {
+		p.Won = team(p.Order) == winner
+		p.RatingChange = changes[team(p.Order)]
+	}
+
+	if err := g.repo.SaveGameResult(ctx, table); err != nil {
+		logger.Error(err)
+		return
+	}
+
+	results := make([]leaderboard.Result, 0, len(table.Participants))
+	for _, p := range table.Participants {
+		if p.Player == nil {
+			continue
+		}
+
+		result := leaderboard.Result{
+			PlayerId:     p.PlayerId,
+			Currency:     string(table.Currency),
+			Rating:       p.Player.Rating,
+			RatingChange: p.RatingChange,
+			Net:          -int64(table.Bet),
+		}
+
+		if p.Player.Profile != nil {
+			result.Country = p.Player.Profile.Country
+		}
+
+		if p.Won {
+			result.Wins = 1
+			result.Net = int64(table.Bet)
+		}
+
+		results = append(results, result)
+	}
+
+	if err := g.leaderboards.Record(ctx, table.EndTime, results...); err != nil {
+		logger.Error(err)
+	}
+}
+
+func (g *gameService) GetLeaderboard(ctx context.Context, req *pb.GetLeaderboardRequest) (*pb.GetLeaderboardResponse, error) {
+	playerId := ctx.Value("player_id").(string)
+
+	board := leaderboard.Board{
+		Metric:   leaderboard.Metric(req.Metric),
+		Window:   leaderboard.Window(req.Window),
+		Currency: req.Currency,
+	}
+
+	if !validBoard(board) {
+		return nil, code.InvalidLeaderboard
+	}
+
+	if board.Currency == "" {
+		board.Currency = string(model.NUTS)
+	}
+
+	limit := int64(req.Limit)
+	if limit == 0 {
+		limit = defaultLeaderboardLimit
+	}
+	if limit > maxLeaderboardLimit {
+		limit = maxLeaderboardLimit
+	}
+	offset := int64(req.Offset)
+
+	now := time.Now()
+
+	var scores []leaderboard.Score
+	var own *leaderboard.Score
+
+	switch req.Scope {
+	case GLOBAL_SCOPE, COUNTRY_SCOPE, "":
+		if req.Scope == COUNTRY_SCOPE {
+			player, err := g.repo.FindPlayer(ctx, playerId)
+			if err != nil {
+				return nil, err
+			}
+
+			if player == nil || player.Profile == nil || player.Profile.Country == "" {
+				return nil, code.CountryNotSet
+			}
+
+			board.Country = player.Profile.Country
+		}
+
+		var err error
+		if scores, err = g.leaderboards.Top(ctx, board, now, offset, limit); err != nil {
+			return nil, err
+		}
+
+		if own, err = g.leaderboards.Rank(ctx, board, now, playerId); err != nil {
+			return nil, err
+		}
+	case FRIENDS_SCOPE:
+		ids, err := g.repo.GetFriendIds(ctx, playerId)
+		if err != nil {
+			return nil, err
+		}
+
+		ranked, err := g.leaderboards.Ranked(ctx, board, now, append(ids, playerId))
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range ranked {
+			if ranked[i].PlayerId == playerId {
+				own = &ranked[i]
+			}
+		}
+
+		if offset < int64(len(ranked)) {
+			scores = ranked[offset:]
+			if int64(len(scores)) > limit {
+				scores = scores[:limit]
+			}
+		}
+	default:
+		return nil, code.InvalidLeaderboard
+	}
+
+	ids := make([]string, 0, len(scores)+1)
+	for _, s := range scores {
+		ids = append(ids, s.PlayerId)
+	}
+	if own != nil {
+		ids = append(ids, own.PlayerId)
+	}
+
+	players, err := g.repo.FindPlayers(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byId := make(map[string]*model.Player, len(players))
+	for _, p := range players {
+		byId[p.Id] = p
+	}
+
+	entries := make([]*pb.LeaderboardEntry, len(scores))
+	for i, s := range scores {
+		entries[i] = leaderboardEntry(s, byId[s.PlayerId])
+	}
+
+	response := &pb.GetLeaderboardResponse{
+		Entries: entries,
+	}
+
+	if own != nil {
+		response.Own = leaderboardEntry(*own, byId[own.PlayerId])
+	}
+
+	return response, nil
+}
+
+// rebuildLeaderboards recalculates current periods of all windows
+// from finished tables and schedules next rebuild.
+func (g *gameService) rebuildLeaderboards(ctx context.Context, task *rmq.Task) error {
+	defer g.scheduleLeaderboardsRebuild()
+
+	now := time.Now()
+
+	for _, w := range leaderboard.Windows {
+		rows, err := g.repo.GetLeaderboardRows(ctx, leaderboard.WindowStart(w, now))
+		if err != nil {
+			return err
+		}
+
+		results := make([]leaderboard.Result, len(rows))
+		for i, r := range rows {
+			results[i] = leaderboard.Result{
+				PlayerId:     r.PlayerId,
+				Country:      r.Country,
+				Currency:     string(r.Currency),
+				Rating:       r.Rating,
+				RatingChange: r.RatingChange,
+				Wins:         r.Wins,
+				Net:          r.Net,
+			}
+		}
+
+		if err = g.leaderboards.Rebuild(ctx, w, now, results); err != nil {
+			return err
+		}
+
+		g.logger.For(ctx).Info("Leaderboards rebuilt", log.String("window", string(w)), log.Int("players", len(rows)))
+	}
+
+	return nil
+}
+
+// scheduleLeaderboardsRebuild adds rebuild task at the next interval
+// boundary. Task id is derived from execution time, so instances
+// and restarts do not duplicate it.
+func (g *gameService) scheduleLeaderboardsRebuild() {
+	interval := g.config.LeaderboardRebuild
+	at := time.Now().Truncate(interval).Add(interval)
+
+	g.worker.AddTask(rmq.NewTask(
+		REBUILD_LEADERBOARDS,
+		"leaderboards",
+		rmq.WithExecTime(at),
+		rmq.WithId(REBUILD_LEADERBOARDS+":"+at.UTC().Format(time.RFC3339)),
+	))
+}
+
+func validBoard(board leaderboard.Board) bool {
+	metric, window := false, false
+	for _, m := range leaderboard.Metrics {
+		metric = metric || m == board.Metric
+	}
+	for _, w := range leaderboard.Windows {
+		window = window || w == board.Window
+	}
+
+	currency := board.Currency == "" ||
+		board.Currency == string(model.NUTS) ||
+		board.Currency == string(model.GOLD)
+
+	return metric && window && currency
+}
+
+func leaderboardEntry(score leaderboard.Score, player *model.Player) *pb.LeaderboardEntry {
+	entry := &pb.LeaderboardEntry{
+		Rank:     uint64(score.Rank),
+		PlayerId: score.PlayerId,
+		Score:    int64(score.Score),
+	}
+
+	if player != nil {
+		entry.Nickname = player.Nickname
+		entry.Avatar = player.Avatar
+		if player.Profile != nil {
+			entry.Country = player.Profile.Country
+		}
+	}
+
+	return entry
+}
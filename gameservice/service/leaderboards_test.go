@@ -0,0 +1,18 @@
+package service
+
+import "testing"
+
+func TestRatingChanges(t *testing.T) {
+	rules := RatingRules{K: 32}
+
+	if d1, d2 := rules.Changes(1000, 1000, 1); d1 != 16 || d2 != -16 {
+		t.Fatalf("equal teams: got %d %d", d1, d2)
+	}
+
+	// favourite gains less than underdog
+	fav, _ := rules.Changes(1400, 1000, 1)
+	_, dog := rules.Changes(1400, 1000, 2)
+	if fav <= 0 || dog <= fav {
+		t.Fatalf("unexpected changes favourite %d underdog %d", fav, dog)
+	}
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Handzo/gogame/gameservice/code"
+	pb "github.com/Handzo/gogame/gameservice/proto"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/Handzo/gogame/gameservice/service/pubsub"
+)
+
+// lobbyRoom is pubsub room of players browsing open tables.
+const lobbyRoom = "lobby"
+
+const (
+	defaultLobbyLimit = 20
+	maxLobbyLimit     = 100
+)
+
+func (g *gameService) SubscribeLobby(ctx context.Context, req *pb.SubscribeLobbyRequest) (*pb.SubscribeLobbyResponse, error) {
+	g.pubsub.AddToRoom(ctx, lobbyRoom, ctx.Value("player_id").(string))
+	return &pb.SubscribeLobbyResponse{}, nil
+}
+
+func (g *gameService) UnsubscribeLobby(ctx context.Context, req *pb.UnsubscribeLobbyRequest) (*pb.UnsubscribeLobbyResponse, error) {
+	g.pubsub.RemoveFromRoom(ctx, lobbyRoom, ctx.Value("player_id").(string))
+	return &pb.UnsubscribeLobbyResponse{}, nil
+}
+
+// lobbyTableCreated announces new public table to the lobby.
+func (g *gameService) lobbyTableCreated(ctx context.Context, tableId string) {
+	table, err := g.repo.FindTable(ctx, tableId)
+	if err != nil || table == nil || table.Private {
+		return
+	}
+
+	g.pubsub.Room(lobbyRoom).Publish(ctx, &pubsub.Event{
+		Event: "TableCreated",
+		Payload: &pubsub.TableCreated{
+			Table: lobbyTable(table),
+		},
+	})
+}
+
+// lobbyTableChanged announces seating or state change of public table.
+// Tables which are no longer waiting for players are removed from the lobby.
+func (g *gameService) lobbyTableChanged(ctx context.Context, tableId string) {
+	table, err := g.repo.FindTable(ctx, tableId)
+	if err != nil || table == nil || table.Private {
+		return
+	}
+
+	if !table.IsWaiting() {
+		g.pubsub.Room(lobbyRoom).Publish(ctx, &pubsub.Event{
+			Event: "TableRemoved",
+			Payload: &pubsub.TableRemoved{
+				TableId: table.Id,
+			},
+		})
+		return
+	}
+
+	g.pubsub.Room(lobbyRoom).Publish(ctx, &pubsub.Event{
+		Event: "TableUpdated",
+		Payload: &pubsub.TableUpdated{
+			Table: lobbyTable(table),
+		},
+	})
+}
+
+// tableFilter validates lobby listing filter of request.
+func tableFilter(req *pb.GetOpenTablesRequest) (*model.TableFilter, error) {
+	if req.Variant != "" && !knownVariant(model.Variant(req.Variant)) {
+		return nil, code.UnknownVariant
+	}
+
+	// tables are played for nuts or gold only
+	switch model.Currency(req.Currency) {
+	case "", model.NUTS, model.GOLD:
+	default:
+		return nil, code.InvalidTableFilter
+	}
+
+	if req.MaxBet != 0 && req.MinBet > req.MaxBet {
+		return nil, code.InvalidTableFilter
+	}
+
+	if req.FreeSeats > 4 {
+		return nil, code.InvalidTableFilter
+	}
+
+	return &model.TableFilter{
+		Currency:  model.Currency(req.Currency),
+		Variant:   model.Variant(req.Variant),
+		MinBet:    req.MinBet,
+		MaxBet:    req.MaxBet,
+		FreeSeats: int(req.FreeSeats),
+	}, nil
+}
+
+func knownVariant(v model.Variant) bool {
+	for _, known := range model.Variants {
+		if v == known {
+			return true
+		}
+	}
+
+	return false
+}
+
+func lobbyTable(t *model.Table) pubsub.LobbyTable {
+	info := pubsub.LobbyTable{
+		Id:       t.Id,
+		Currency: string(t.Currency),
+		Variant:  string(t.Variant),
+		Bet:      t.Bet,
+	}
+
+	for _, p := range t.Participants {
+		info.Participants = append(info.Participants, participantInfo(p))
+	}
+
+	return info
+}
+
+func lobbyTableInfo(t *model.Table) *pb.Table {
+	info := &pb.Table{
+		Id:       t.Id,
+		Bet:      t.Bet,
+		UnitType: string(t.Currency),
+		Variant:  string(t.Variant),
+	}
+
+	for _, p := range t.Participants {
+		participant := &pb.Participant{
+			Id:    p.Id,
+			Order: uint32(p.Order),
+			State: string(p.State),
+		}
+
+		if p.Player != nil {
+			participant.Player = &pb.Player{
+				Id:       p.Player.Id,
+				Nickname: p.Player.Nickname,
+			}
+		}
+
+		info.Participants = append(info.Participants, participant)
+	}
+
+	return info
+}
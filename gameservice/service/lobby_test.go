@@ -0,0 +1,40 @@
+package service
+
+import (
+	"testing"
+
+	pb "github.com/Handzo/gogame/gameservice/proto"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+)
+
+func TestTableFilter(t *testing.T) {
+	filter, err := tableFilter(&pb.GetOpenTablesRequest{
+		Currency:  "nuts",
+		Variant:   "classic",
+		MinBet:    10,
+		MaxBet:    100,
+		FreeSeats: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := model.TableFilter{Currency: model.NUTS, Variant: model.CLASSIC, MinBet: 10, MaxBet: 100, FreeSeats: 2}
+	if *filter != want {
+		t.Errorf("got %+v, want %+v", *filter, want)
+	}
+
+	invalid := []*pb.GetOpenTablesRequest{
+		{Variant: "speed"},
+		{Currency: "usd"},
+		{Currency: "coins"},
+		{MinBet: 100, MaxBet: 10},
+		{FreeSeats: 5},
+	}
+
+	for _, req := range invalid {
+		if _, err := tableFilter(req); err == nil {
+			t.Errorf("%+v: expected error", req)
+		}
+	}
+}
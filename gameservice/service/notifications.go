@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Handzo/gogame/common/log"
+	pb "github.com/Handzo/gogame/gameservice/proto"
+	"github.com/Handzo/gogame/gameservice/repository"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/Handzo/gogame/gameservice/service/pubsub"
+)
+
+const (
+	defaultNotificationsLimit = 20
+	maxNotificationsLimit     = 100
+)
+
+func (g *gameService) GetNotifications(ctx context.Context, req *pb.GetNotificationsRequest) (*pb.GetNotificationsResponse, error) {
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultNotificationsLimit
+	}
+	if limit > maxNotificationsLimit {
+		limit = maxNotificationsLimit
+	}
+
+	notifications, total, unread, err := g.repo.GetNotifications(ctx, ctx.Value("player_id").(string), req.UnreadOnly, int(req.Offset), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetNotificationsResponse{
+		Notifications: notificationInfos(notifications),
+		Total:         uint32(total),
+		Unread:        uint32(unread),
+	}, nil
+}
+
+// MarkRead marks given notifications read, or all of them when
+// no ids are given.
+func (g *gameService) MarkRead(ctx context.Context, req *pb.MarkReadRequest) (*pb.MarkReadResponse, error) {
+	unread, err := g.repo.MarkNotificationsRead(ctx, ctx.Value("player_id").(string), req.NotificationIds...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.MarkReadResponse{
+		Unread: uint32(unread),
+	}, nil
+}
+
+// notify pushes event to player. Events of offline player are kept
+// in the inbox and delivered with the next session.
+func (g *gameService) notify(ctx context.Context, playerId string, event *pubsub.Event) {
+	deliverEvent(ctx, g.repo, g.pubsub, g.logger, playerId, event)
+}
+
+// deliverEvent is notify for handlers outside of game service.
+func deliverEvent(ctx context.Context, repo repository.GameRepository, ps *pubsub.PubSub, logger log.Factory, playerId string, event *pubsub.Event) {
+	if ps.ToPlayer(ctx, playerId, event) {
+		return
+	}
+
+	payload, err := json.Marshal(event.Payload)
+	if err != nil {
+		logger.For(ctx).Error(err)
+		return
+	}
+
+	notification := &model.Notification{
+		PlayerId: playerId,
+		Event:    event.Event,
+		Payload:  string(payload),
+	}
+
+	if err = repo.Insert(ctx, notification); err != nil {
+		return
+	}
+
+	logger.For(ctx).Info("Notification kept in inbox", log.String("player_id", playerId), log.String("event", event.Event))
+}
+
+// unreadNotifications returns notifications delivered on session open.
+func (g *gameService) unreadNotifications(ctx context.Context, playerId string) ([]*pb.Notification, error) {
+	notifications, _, _, err := g.repo.GetNotifications(ctx, playerId, true, 0, maxNotificationsLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return notificationInfos(notifications), nil
+}
+
+func notificationInfos(notifications []*model.Notification) []*pb.Notification {
+	infos := make([]*pb.Notification, len(notifications))
+	for i, n := range notifications {
+		infos[i] = &pb.Notification{
+			Id:        n.Id,
+			Event:     n.Event,
+			Payload:   n.Payload,
+			Read:      n.IsRead(),
+			CreatedAt: n.CreatedAt.Unix(),
+		}
+	}
+
+	return infos
+}
@@ -0,0 +1,128 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// FakeProvider is a payment provider for development and tests.
+// Every verified receipt is paid, refunds are sent as webhooks.
+// Checkouts are kept in memory and saved to file if path is set.
+type FakeProvider struct {
+	mu        sync.Mutex
+	path      string
+	checkouts map[string]*Receipt
+}
+
+type fakeWebhook struct {
+	Id     string `json:"id"`
+	Status Status `json:"status"`
+}
+
+func NewFakeProvider(path string) (*FakeProvider, error) {
+	p := &FakeProvider{
+		path:      path,
+		checkouts: make(map[string]*Receipt),
+	}
+
+	if path == "" {
+		return p, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return p, nil
+		}
+		return nil, err
+	}
+
+	return p, json.Unmarshal(data, &p.checkouts)
+}
+
+func (p *FakeProvider) CreateCheckout(ctx context.Context, checkout *Checkout) (*Session, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	id := uuid.Must(uuid.NewV4()).String()
+	p.checkouts[id] = &Receipt{
+		OrderId:    checkout.OrderId,
+		ExternalId: id,
+		Status:     PENDING,
+	}
+
+	if err := p.save(); err != nil {
+		return nil, err
+	}
+
+	return &Session{
+		ExternalId: id,
+		Url:        fmt.Sprintf("fake://checkout/%s", id),
+	}, nil
+}
+
+func (p *FakeProvider) VerifyReceipt(ctx context.Context, receipt string) (*Receipt, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	r, ok := p.checkouts[receipt]
+	if !ok {
+		return nil, ErrCheckoutNotFound
+	}
+
+	if r.Status == PENDING {
+		r.Status = PAID
+		if err := p.save(); err != nil {
+			return nil, err
+		}
+	}
+
+	res := *r
+	return &res, nil
+}
+
+func (p *FakeProvider) HandleWebhook(ctx context.Context, payload []byte) (*Receipt, error) {
+	var hook fakeWebhook
+	if err := json.Unmarshal(payload, &hook); err != nil {
+		return nil, ErrInvalidWebhook
+	}
+
+	if hook.Status != PAID && hook.Status != REFUNDED {
+		return nil, ErrInvalidWebhook
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	r, ok := p.checkouts[hook.Id]
+	if !ok {
+		return nil, ErrCheckoutNotFound
+	}
+
+	r.Status = hook.Status
+	if err := p.save(); err != nil {
+		return nil, err
+	}
+
+	res := *r
+	return &res, nil
+}
+
+func (p *FakeProvider) save() error {
+	if p.path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(p.checkouts)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(p.path, data, 0644)
+}
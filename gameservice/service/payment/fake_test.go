@@ -0,0 +1,66 @@
+package payment
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFakeProviderPayAndRefund(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "payments")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "payments.json")
+
+	p, err := NewFakeProvider(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	session, err := p.CreateCheckout(ctx, &Checkout{OrderId: "order", Price: 99, Currency: "usd"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	receipt, err := p.VerifyReceipt(ctx, session.ExternalId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if receipt.OrderId != "order" || receipt.Status != PAID {
+		t.Errorf("unexpected receipt %+v", receipt)
+	}
+
+	// checkouts are restored from file
+	p, err = NewFakeProvider(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	receipt, err = p.HandleWebhook(ctx, []byte(`{"id":"`+session.ExternalId+`","status":"refunded"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if receipt.Status != REFUNDED {
+		t.Errorf("expected refunded receipt, got %s", receipt.Status)
+	}
+}
+
+func TestFakeProviderUnknownReceipt(t *testing.T) {
+	p, _ := NewFakeProvider("")
+
+	if _, err := p.VerifyReceipt(context.Background(), "unknown"); err != ErrCheckoutNotFound {
+		t.Errorf("expected ErrCheckoutNotFound, got %v", err)
+	}
+
+	if _, err := p.HandleWebhook(context.Background(), []byte(`{`)); err != ErrInvalidWebhook {
+		t.Errorf("expected ErrInvalidWebhook, got %v", err)
+	}
+}
@@ -0,0 +1,101 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+)
+
+// SignatureHeader carries signature of webhook payload.
+const SignatureHeader = "X-Payment-Signature"
+
+type Status string
+
+var (
+	PENDING  Status = "pending"
+	PAID     Status = "paid"
+	REFUNDED Status = "refunded"
+)
+
+var (
+	ErrCheckoutNotFound = errors.New("checkout not found")
+	ErrInvalidWebhook   = errors.New("invalid webhook payload")
+	ErrUnknownProvider  = errors.New("unknown payment provider")
+	ErrFakeProvider     = errors.New("fake payment provider is allowed in dev environment only")
+)
+
+// Config selects payment provider.
+type Config struct {
+	// Provider name, only "fake" is implemented
+	Provider string
+	// Env is deployment environment, fake provider requires "dev"
+	Env string
+	// Path is storage file of fake provider
+	Path string
+}
+
+// New creates provider selected by config.
+func New(config Config) (Provider, error) {
+	switch config.Provider {
+	case "fake":
+		if config.Env != "dev" {
+			return nil, ErrFakeProvider
+		}
+		return NewFakeProvider(config.Path)
+	}
+
+	return nil, ErrUnknownProvider
+}
+
+// Checkout describes order which player is going to pay for.
+type Checkout struct {
+	OrderId     string
+	Title       string
+	Description string
+	Price       uint32
+	Currency    string
+}
+
+// Session is created by provider for checkout. Player pays for
+// the order following Url, ExternalId identifies payment at provider.
+type Session struct {
+	ExternalId string
+	Url        string
+}
+
+// Receipt is a payment state reported by provider.
+type Receipt struct {
+	OrderId    string
+	ExternalId string
+	Status     Status
+}
+
+// Provider is a real money payment provider.
+type Provider interface {
+	// CreateCheckout registers order at provider and returns payment session.
+	CreateCheckout(context.Context, *Checkout) (*Session, error)
+	// VerifyReceipt checks receipt sent by client with provider.
+	VerifyReceipt(context.Context, string) (*Receipt, error)
+	// HandleWebhook parses provider notification about payment state change.
+	HandleWebhook(context.Context, []byte) (*Receipt, error)
+}
+
+// Sign returns signature of webhook payload, hex encoded HMAC-SHA256
+// keyed with webhook secret.
+func Sign(secret, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature reports whether signature matches payload. Nothing is
+// verified without secret.
+func VerifySignature(secret, payload []byte, signature string) bool {
+	if len(secret) == 0 || signature == "" {
+		return false
+	}
+
+	return hmac.Equal([]byte(Sign(secret, payload)), []byte(signature))
+}
@@ -0,0 +1,35 @@
+package payment
+
+import "testing"
+
+func TestVerifySignature(t *testing.T) {
+	secret := []byte("secret")
+	payload := []byte(`{"id":"checkout","status":"paid"}`)
+	signature := Sign(secret, payload)
+
+	if !VerifySignature(secret, payload, signature) {
+		t.Error("expected signature to be verified")
+	}
+
+	if VerifySignature(secret, []byte(`{"id":"other","status":"paid"}`), signature) {
+		t.Error("expected signature of other payload to be rejected")
+	}
+
+	if VerifySignature([]byte("other"), payload, signature) || VerifySignature(nil, payload, Sign(nil, payload)) {
+		t.Error("expected signature with other or no secret to be rejected")
+	}
+}
+
+func TestNewProvider(t *testing.T) {
+	if _, err := New(Config{Provider: "fake", Env: "production"}); err != ErrFakeProvider {
+		t.Errorf("expected ErrFakeProvider, got %v", err)
+	}
+
+	if _, err := New(Config{Provider: "unknown", Env: "dev"}); err != ErrUnknownProvider {
+		t.Errorf("expected ErrUnknownProvider, got %v", err)
+	}
+
+	if p, err := New(Config{Provider: "fake", Env: "dev"}); err != nil || p == nil {
+		t.Errorf("expected fake provider in dev, got %v", err)
+	}
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/Handzo/gogame/common/log"
+	"github.com/Handzo/gogame/gameservice/code"
+	"github.com/Handzo/gogame/gameservice/repository"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/Handzo/gogame/gameservice/service/payment"
+	"github.com/Handzo/gogame/gameservice/service/pubsub"
+	"github.com/opentracing/opentracing-go"
+)
+
+// maxWebhookSize limits payload of provider notification.
+const maxWebhookSize = 64 << 10
+
+// paymentHandler applies payment states reported by provider
+// to purchases, both from client receipts and provider webhooks.
+// Webhooks have to be signed with secret shared with provider.
+type paymentHandler struct {
+	provider payment.Provider
+	secret   []byte
+	repo     repository.GameRepository
+	pubsub   *pubsub.PubSub
+	tracer   opentracing.Tracer
+	logger   log.Factory
+}
+
+func newPaymentHandler(provider payment.Provider, secret string, repo repository.GameRepository, pubsub *pubsub.PubSub, tracer opentracing.Tracer, logger log.Factory) *paymentHandler {
+	return &paymentHandler{
+		provider: provider,
+		secret:   []byte(secret),
+		repo:     repo,
+		pubsub:   pubsub,
+		tracer:   tracer,
+		logger:   logger,
+	}
+}
+
+func (h *paymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	span, ctx, logger := h.logger.StartForWithTracer(r.Context(), h.tracer, "PaymentWebhook")
+	defer span.Finish()
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	signature := r.Header.Get(payment.SignatureHeader)
+	if signature == "" || len(h.secret) == 0 {
+		logger.Warn("unsigned payment webhook")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookSize))
+	if err != nil {
+		logger.Error(err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if !payment.VerifySignature(h.secret, body, signature) {
+		logger.Warn("invalid payment webhook signature")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	receipt, err := h.provider.HandleWebhook(ctx, body)
+	if err != nil {
+		logger.Warn("invalid payment webhook", log.Error(err))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	_, _, err = h.apply(ctx, receipt)
+	if err == code.InvalidPurchaseState {
+		// purchase has been already refunded, repeating webhook
+		// would not change it
+		logger.Warn("payment webhook for finished purchase", log.String("order_id", receipt.OrderId))
+	} else if err != nil {
+		logger.Error(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// apply completes or refunds purchase and notifies player about new balances.
+func (h *paymentHandler) apply(ctx context.Context, receipt *payment.Receipt) (*model.Purchase, *model.Player, error) {
+	logger := h.logger.For(ctx)
+
+	logger.Info("Apply payment receipt",
+		log.String("order_id", receipt.OrderId),
+		log.String("external_id", receipt.ExternalId),
+		log.String("status", string(receipt.Status)),
+	)
+
+	var (
+		purchase *model.Purchase
+		player   *model.Player
+		err      error
+		event    string
+	)
+
+	switch receipt.Status {
+	case payment.PAID:
+		purchase, player, err = h.repo.CompletePurchase(ctx, receipt.OrderId)
+		event = "PurchaseCompleted"
+	case payment.REFUNDED:
+		purchase, player, err = h.repo.RefundPurchase(ctx, receipt.OrderId)
+		event = "PurchaseRefunded"
+	default:
+		return nil, nil, code.PaymentNotCompleted
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deliverEvent(ctx, h.repo, h.pubsub, h.logger, purchase.PlayerId, &pubsub.Event{
+		Event: event,
+		Payload: &pubsub.Purchase{
+			PurchaseId: purchase.PurchaseId,
+			ProductId:  purchase.ProductId,
+			Nuts:       player.Nuts,
+			Gold:       player.Gold,
+			Inventory:  inventoryItems(player.Inventory),
+		},
+	})
+
+	return purchase, player, nil
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Handzo/gogame/gameservice/code"
+	pb "github.com/Handzo/gogame/gameservice/proto"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/Handzo/gogame/gameservice/service/avatar"
+)
+
+func (g *gameService) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest) (*pb.UpdateProfileResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	profile := &model.Profile{
+		FirstName: req.Profile.FirstName,
+		LastName:  req.Profile.LastName,
+		Age:       req.Profile.Age,
+		Gender:    model.Gender(req.Profile.Gender),
+		Country:   req.Profile.Country,
+		Language:  req.Profile.Language,
+	}
+
+	player, err := g.repo.UpdateProfile(ctx, ctx.Value("player_id").(string), profile)
+	if err != nil {
+		return nil, err
+	}
+
+	if player == nil {
+		return nil, code.PlayerNotFound
+	}
+
+	return &pb.UpdateProfileResponse{
+		Profile: profileInfo(player.Profile),
+	}, nil
+}
+
+func (g *gameService) SetAvatar(ctx context.Context, req *pb.SetAvatarRequest) (*pb.SetAvatarResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	player := &model.Player{}
+	player.Id = ctx.Value("player_id").(string)
+
+	if req.AvatarId != "" {
+		url, ok := g.config.Avatars.Url(req.AvatarId)
+		if !ok {
+			return nil, code.InvalidAvatar
+		}
+		player.Avatar = url
+	} else {
+		url, err := g.avatars.Save(ctx, player.Id, req.Image)
+		if err != nil {
+			if err == avatar.ErrImageTooLarge || err == avatar.ErrInvalidImage {
+				return nil, code.InvalidAvatar
+			}
+			g.logger.For(ctx).Error(err)
+			return nil, err
+		}
+		player.Avatar = url
+	}
+
+	if err := g.repo.Update(ctx, player, "avatar", "updated_at"); err != nil {
+		return nil, err
+	}
+
+	return &pb.SetAvatarResponse{
+		Avatar: player.Avatar,
+	}, nil
+}
+
+func (g *gameService) GetPlayerProfile(ctx context.Context, req *pb.GetPlayerProfileRequest) (*pb.GetPlayerProfileResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	player, err := g.repo.FindPlayer(ctx, req.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if player == nil {
+		return nil, code.PlayerNotFound
+	}
+
+	return &pb.GetPlayerProfileResponse{
+		Player: playerInfo(player, player.Id == ctx.Value("player_id").(string)),
+	}, nil
+}
+
+// playerInfo converts player to its public view, balances are
+// visible to the owner only.
+func playerInfo(player *model.Player, owner bool) *pb.Player {
+	info := &pb.Player{
+		Id:       player.Id,
+		Nickname: player.Nickname,
+		Level:    player.Level,
+		Exp:      player.Exp,
+		Avatar:   player.Avatar,
+		Profile:  profileInfo(player.Profile),
+	}
+
+	if owner {
+		info.Nuts = player.Nuts
+		info.Gold = player.Gold
+	}
+
+	return info
+}
+
+func profileInfo(profile *model.Profile) *pb.Profile {
+	if profile == nil {
+		return &pb.Profile{}
+	}
+
+	return &pb.Profile{
+		FirstName: profile.FirstName,
+		LastName:  profile.LastName,
+		Age:       profile.Age,
+		Gender:    string(profile.Gender),
+		Country:   profile.Country,
+		Language:  profile.Language,
+	}
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"math"
+
+	"github.com/Handzo/gogame/common/log"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/Handzo/gogame/gameservice/service/pubsub"
+)
+
+// LevelCurve defines experience required for each level.
+// Reaching level L requires Base * (L-1)^Growth experience in total.
+type LevelCurve struct {
+	Base     uint64
+	Growth   float64
+	MaxLevel uint32
+	// Rewards are credited to player's wallet when level is reached
+	Rewards map[uint32]model.Reward
+}
+
+// ExpRules defines experience awarded for game outcomes.
+// Stake bonus is added for both winners and losers.
+type ExpRules struct {
+	RoundWin  uint64
+	RoundLoss uint64
+	GameWin   uint64
+	GameLoss  uint64
+	BetFactor float64
+}
+
+func (c LevelCurve) ExpForLevel(level uint32) uint64 {
+	if level <= 1 {
+		return 0
+	}
+
+	return uint64(float64(c.Base) * math.Pow(float64(level-1), c.Growth))
+}
+
+func (c LevelCurve) Level(exp uint64) uint32 {
+	level := uint32(1)
+	for level < c.MaxLevel && c.ExpForLevel(level+1) <= exp {
+		level++
+	}
+
+	return level
+}
+
+// Reward sums rewards for levels reached after from up to and including to.
+func (c LevelCurve) Reward(from, to uint32) model.Reward {
+	reward := model.Reward{}
+	for l := from + 1; l <= to; l++ {
+		reward = reward.Add(c.Rewards[l])
+	}
+
+	return reward
+}
+
+func (r ExpRules) Exp(base uint64, bet uint32) uint64 {
+	return base + uint64(float64(bet)*r.BetFactor)
+}
+
+// team returns team of participant, orders 1 and 3 play
+// for the first team, orders 2 and 4 for the second one.
+func team(order int) int {
+	if order%2 == 1 {
+		return 1
+	}
+
+	return 2
+}
+
+// awardExp gives experience to table players depending on their team result.
+func (g *gameService) awardExp(ctx context.Context, table *model.Table, winner int, win, loss uint64) {
+	logger := g.logger.For(ctx)
+
+	for _, p := range table.Participants {
+		if p.PlayerId == "" {
+			continue
+		}
+
+		exp := loss
+		if team(p.Order) == winner {
+			exp = win
+		}
+
+		if err := g.addExp(ctx, p.PlayerId, g.config.Exp.Exp(exp, table.Bet)); err != nil {
+			logger.Error(err)
+		}
+	}
+}
+
+func (g *gameService) addExp(ctx context.Context, playerId string, exp uint64) error {
+	player, err := g.repo.AddExp(ctx, playerId, exp)
+	if err != nil {
+		return err
+	}
+
+	if g.config.Levels.Level(player.Exp) <= player.Level {
+		return nil
+	}
+
+	// level is read again under lock, concurrent award may have raised it
+	var from, level uint32
+	var reward model.Reward
+	player, err = g.repo.LevelUp(ctx, playerId, func(p *model.Player) model.Reward {
+		from, level = p.Level, g.config.Levels.Level(p.Exp)
+		if level <= from {
+			return model.Reward{}
+		}
+
+		p.Level = level
+		reward = g.config.Levels.Reward(from, level)
+		return reward
+	})
+	if err != nil {
+		return err
+	}
+
+	if level <= from {
+		return nil
+	}
+
+	g.logger.For(ctx).Info("Player level up",
+		log.String("player_id", playerId),
+		log.Uint32("from", from),
+		log.Uint32("to", level),
+	)
+
+	g.notify(ctx, playerId, &pubsub.Event{
+		Event: "LevelUp",
+		Payload: &pubsub.LevelUp{
+			Level:      player.Level,
+			Exp:        player.Exp,
+			NextExp:    g.config.Levels.ExpForLevel(player.Level + 1),
+			RewardNuts: reward.Nuts,
+			RewardGold: reward.Gold,
+			Nuts:       player.Nuts,
+			Gold:       player.Gold,
+		},
+	})
+
+	return nil
+}
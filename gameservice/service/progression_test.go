@@ -0,0 +1,65 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+)
+
+func TestLevelCurve(t *testing.T) {
+	curve := LevelCurve{Base: 100, Growth: 2, MaxLevel: 5}
+
+	cases := []struct {
+		exp   uint64
+		level uint32
+	}{
+		{0, 1},
+		{99, 1},
+		{100, 2},
+		{399, 2},
+		{400, 3},
+		{1600, 5},
+		{100000, 5},
+	}
+
+	for _, c := range cases {
+		if level := curve.Level(c.exp); level != c.level {
+			t.Errorf("exp %d: expected level %d, got %d", c.exp, c.level, level)
+		}
+	}
+}
+
+func TestLevelCurveReward(t *testing.T) {
+	curve := LevelCurve{
+		Rewards: map[uint32]model.Reward{
+			2: {Nuts: 10},
+			3: {Nuts: 20, Gold: 1},
+			5: {Gold: 5},
+		},
+	}
+
+	reward := curve.Reward(1, 3)
+	if reward.Nuts != 30 || reward.Gold != 1 {
+		t.Errorf("unexpected reward %+v", reward)
+	}
+
+	if reward = curve.Reward(3, 4); !reward.IsZero() {
+		t.Errorf("expected no reward, got %+v", reward)
+	}
+}
+
+func TestExpRules(t *testing.T) {
+	rules := ExpRules{BetFactor: 0.1}
+
+	if exp := rules.Exp(10, 100); exp != 20 {
+		t.Errorf("expected 20 exp, got %d", exp)
+	}
+}
+
+func TestTeam(t *testing.T) {
+	for order, expected := range map[int]int{1: 1, 2: 2, 3: 1, 4: 2} {
+		if team(order) != expected {
+			t.Errorf("order %d: expected team %d", order, expected)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package pubsub
+
+type SystemMessage struct {
+	Text   string `json:"text"`
+	SentAt int64  `json:"sent_at"`
+}
+
+// PlayerBanned is sent to player's connections before their sessions
+// are closed.
+type PlayerBanned struct {
+	BannedUntil int64  `json:"banned_until"`
+	Reason      string `json:"reason"`
+}
+
+type BalanceAdjusted struct {
+	Currency string `json:"currency"`
+	Amount   int64  `json:"amount"`
+	Reason   string `json:"reason"`
+	Nuts     uint64 `json:"nuts"`
+	Gold     uint64 `json:"gold"`
+}
@@ -11,6 +11,7 @@ type GameStarted struct {
 
 type GameFinished struct {
 	EndTime time.Time `json:"end_time"`
+	Version uint64    `json:"version"`
 }
 
 type RoundStarted struct {
@@ -34,8 +35,9 @@ type WaitForMove struct {
 }
 
 type PlayerMoved struct {
-	Card  string `json:"card"`
-	Order int    `json:"order"`
+	Card    string `json:"card"`
+	Order   int    `json:"order"`
+	Version uint64 `json:"version"`
 }
 
 type PlayerJoined struct {
@@ -57,6 +59,7 @@ type ParticipantStateChanged struct {
 
 type Table struct {
 	Id           string        `json:"id"`
+	Version      uint64        `json:"version"`
 	Trump        string        `json:"trump"`
 	Turn         int           `json:"turn"`
 	TableCards   string        `json:"table_cards"`
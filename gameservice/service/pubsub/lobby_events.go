@@ -0,0 +1,21 @@
+package pubsub
+
+type LobbyTable struct {
+	Id           string        `json:"id"`
+	Currency     string        `json:"currency"`
+	Variant      string        `json:"variant"`
+	Bet          uint32        `json:"bet"`
+	Participants []Participant `json:"participants"`
+}
+
+type TableCreated struct {
+	Table LobbyTable `json:"table"`
+}
+
+type TableUpdated struct {
+	Table LobbyTable `json:"table"`
+}
+
+type TableRemoved struct {
+	TableId string `json:"table_id"`
+}
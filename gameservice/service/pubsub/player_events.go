@@ -0,0 +1,31 @@
+package pubsub
+
+type LevelUp struct {
+	Level      uint32 `json:"level"`
+	Exp        uint64 `json:"exp"`
+	NextExp    uint64 `json:"next_exp"`
+	RewardNuts uint64 `json:"reward_nuts"`
+	RewardGold uint64 `json:"reward_gold"`
+	Nuts       uint64 `json:"nuts"`
+	Gold       uint64 `json:"gold"`
+}
+
+type AchievementUnlocked struct {
+	Id          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	RewardNuts  uint64 `json:"reward_nuts"`
+	RewardGold  uint64 `json:"reward_gold"`
+	Nuts        uint64 `json:"nuts"`
+	Gold        uint64 `json:"gold"`
+}
+
+type QuestCompleted struct {
+	Id          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	RewardNuts  uint64 `json:"reward_nuts"`
+	RewardGold  uint64 `json:"reward_gold"`
+	Nuts        uint64 `json:"nuts"`
+	Gold        uint64 `json:"gold"`
+}
@@ -17,7 +17,14 @@ type PubSub struct {
 	logger log.Factory
 }
 
+// playerKey is a set of remotes player is connected from.
 func playerKey(id string) string {
+	return fmt.Sprintf("player:%s:remotes", id)
+}
+
+// legacyPlayerKey held the only remote of player before multiple
+// devices were supported.
+func legacyPlayerKey(id string) string {
 	return fmt.Sprintf("player:%s", id)
 }
 
@@ -29,12 +36,18 @@ func New(redis *redis.Client, tracer opentracing.Tracer, logger log.Factory) *Pu
 	}
 }
 
+// Bind adds remote to remotes of player. Remote left under legacy key
+// by previous version is dropped, as its connection is gone.
 func (p *PubSub) Bind(ctx context.Context, remote, playerId string) error {
-	return p.redis.Set(playerKey(playerId), remote, 0).Err()
+	pipe := p.redis.TxPipeline()
+	pipe.Del(legacyPlayerKey(playerId))
+	pipe.SAdd(playerKey(playerId), remote)
+	_, err := pipe.Exec()
+	return err
 }
 
-func (p *PubSub) Unbind(ctx context.Context, playerId string) {
-	p.redis.Del(playerKey(playerId))
+func (p *PubSub) Unbind(ctx context.Context, playerId, remote string) {
+	p.redis.SRem(playerKey(playerId), remote)
 }
 
 func (p *PubSub) Publish(ctx context.Context, channel string, msg interface{}) {
@@ -69,14 +82,20 @@ func (p *PubSub) Room(id string) *room {
 	}
 }
 
-func (p *PubSub) ToPlayer(ctx context.Context, id string, msg interface{}) {
-	remote, err := p.redis.Get(playerKey(id)).Result()
-	if err != nil || remote == "" {
+// ToPlayer publishes message to every remote of player. It reports false
+// if the player is not connected to pubsub.
+func (p *PubSub) ToPlayer(ctx context.Context, id string, msg interface{}) bool {
+	remotes, err := p.redis.SMembers(playerKey(id)).Result()
+	if err != nil || len(remotes) == 0 {
 		// no such user connected to pubsub
-		return
+		return false
+	}
+
+	for _, remote := range remotes {
+		p.Publish(ctx, remote, msg)
 	}
 
-	p.Publish(ctx, remote, msg)
+	return true
 }
 
 func (p *PubSub) AddToRoom(ctx context.Context, roomId, player string) {
@@ -133,3 +152,9 @@ func (p *PubSub) startSpan(ctx context.Context, channel string) (context.Context
 
 	return ctx, span
 }
+
+// IsBound reports whether player is connected to pubsub.
+func (p *PubSub) IsBound(ctx context.Context, playerId string) bool {
+	n, err := p.redis.Exists(playerKey(playerId)).Result()
+	return err == nil && n != 0
+}
@@ -3,3 +3,16 @@ package pubsub
 type CloseSession struct {
 	SessionId string `json:"session_id"`
 }
+
+// SessionTakenOver is sent to the connection whose session has been
+// replaced by login from another connection.
+type SessionTakenOver struct {
+	SessionId    string `json:"session_id"`
+	NewSessionId string `json:"new_session_id"`
+	Device       string `json:"device"`
+}
+
+type SeatControlChanged struct {
+	SessionId string `json:"session_id"`
+	Device    string `json:"device"`
+}
@@ -0,0 +1,24 @@
+package pubsub
+
+import (
+	"time"
+)
+
+type Purchase struct {
+	PurchaseId string          `json:"purchase_id"`
+	ProductId  string          `json:"product_id"`
+	Nuts       uint64          `json:"nuts"`
+	Gold       uint64          `json:"gold"`
+	Inventory  []InventoryItem `json:"inventory"`
+}
+
+type ItemExpired struct {
+	Item InventoryItem `json:"item"`
+}
+
+type InventoryItem struct {
+	Id        string    `json:"id"`
+	Title     string    `json:"title"`
+	Quantity  uint32    `json:"quantity"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
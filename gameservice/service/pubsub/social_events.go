@@ -0,0 +1,29 @@
+package pubsub
+
+type FriendRequest struct {
+	Player Player `json:"player"`
+}
+
+type FriendAccepted struct {
+	Player Player `json:"player"`
+}
+
+type FriendPresence struct {
+	PlayerId string `json:"player_id"`
+	Nickname string `json:"nickname"`
+}
+
+type TableInvitation struct {
+	InvitationId string `json:"invitation_id"`
+	TableId      string `json:"table_id"`
+	Currency     string `json:"currency"`
+	Bet          uint32 `json:"bet"`
+	From         Player `json:"from"`
+	ExpiresAt    int64  `json:"expires_at"`
+}
+
+type InvitationAccepted struct {
+	InvitationId string `json:"invitation_id"`
+	TableId      string `json:"table_id"`
+	Player       Player `json:"player"`
+}
@@ -0,0 +1,63 @@
+package pubsub
+
+type ParticipantLeft struct {
+	TableId     string      `json:"table_id"`
+	Participant Participant `json:"participant"`
+	// player left started game and lost it
+	Forfeit bool `json:"forfeit"`
+}
+
+type ParticipantKicked struct {
+	TableId     string      `json:"table_id"`
+	Participant Participant `json:"participant"`
+}
+
+type KickedFromTable struct {
+	TableId string `json:"table_id"`
+}
+
+type SeatLocked struct {
+	TableId     string      `json:"table_id"`
+	Participant Participant `json:"participant"`
+}
+
+type TableClosed struct {
+	TableId string `json:"table_id"`
+	Reason  string `json:"reason"`
+}
+
+type SeatsChanged struct {
+	TableId      string        `json:"table_id"`
+	Participants []Participant `json:"participants"`
+}
+
+type SeatSwapRequested struct {
+	SwapId    string      `json:"swap_id"`
+	TableId   string      `json:"table_id"`
+	From      Participant `json:"from"`
+	To        Participant `json:"to"`
+	ExpiresAt int64       `json:"expires_at"`
+}
+
+type RematchOffered struct {
+	TableId   string `json:"table_id"`
+	ExpiresAt int64  `json:"expires_at"`
+}
+
+type RematchVoted struct {
+	TableId  string `json:"table_id"`
+	PlayerId string `json:"player_id"`
+	Votes    int    `json:"votes"`
+}
+
+type RematchStarted struct {
+	TableId      string        `json:"table_id"`
+	NewTableId   string        `json:"new_table_id"`
+	Participants []Participant `json:"participants"`
+}
+
+type RematchDeclined struct {
+	TableId string `json:"table_id"`
+	// player who declined, empty if vote has expired
+	PlayerId string `json:"player_id"`
+}
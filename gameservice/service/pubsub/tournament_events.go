@@ -0,0 +1,41 @@
+package pubsub
+
+type TournamentTableReady struct {
+	TournamentId string `json:"tournament_id"`
+	TableId      string `json:"table_id"`
+	Round        int    `json:"round"`
+}
+
+type TournamentFinished struct {
+	TournamentId string `json:"tournament_id"`
+	Title        string `json:"title"`
+	Place        int    `json:"place"`
+	Prize        uint64 `json:"prize"`
+	Currency     string `json:"currency"`
+}
+
+type TournamentCancelled struct {
+	TournamentId string `json:"tournament_id"`
+	Title        string `json:"title"`
+}
+
+// TournamentInvite is sent to partner of team entry, who accepts it
+// by registering back with the player.
+type TournamentInvite struct {
+	TournamentId string `json:"tournament_id"`
+	Title        string `json:"title"`
+	PlayerId     string `json:"player_id"`
+}
+
+type TournamentInviteAccepted struct {
+	TournamentId string `json:"tournament_id"`
+	Title        string `json:"title"`
+	PartnerId    string `json:"partner_id"`
+}
+
+// TournamentEntryDropped is sent when tournament starts before partner
+// has accepted team entry. Entry fee is refunded.
+type TournamentEntryDropped struct {
+	TournamentId string `json:"tournament_id"`
+	Title        string `json:"title"`
+}
@@ -0,0 +1,116 @@
+package quest
+
+import (
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/Handzo/gogame/gameengine/service/deck"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/Handzo/gogame/gameservice/service/achievement"
+)
+
+// Quest is completed when its condition has been met Goal times
+// while quest is active.
+type Quest struct {
+	Id          string
+	Title       string
+	Description string
+	Event       achievement.Event
+	Goal        int
+	Condition   achievement.Condition
+	Reward      model.Reward
+}
+
+// Defaults returns quests daily ones are picked from.
+func Defaults() []*Quest {
+	return []*Quest{
+		{
+			Id:          "hearts_rounds",
+			Title:       "Heartbreaker",
+			Description: "Win 3 rounds with hearts as trump",
+			Event:       achievement.ROUND,
+			Goal:        3,
+			Condition:   achievement.All(achievement.Won, achievement.Trump(deck.HEART)),
+			Reward:      model.Reward{Nuts: 150},
+		},
+		{
+			Id:          "clubs_rounds",
+			Title:       "Club member",
+			Description: "Win 3 rounds with clubs as trump",
+			Event:       achievement.ROUND,
+			Goal:        3,
+			Condition:   achievement.All(achievement.Won, achievement.Trump(deck.CLUB)),
+			Reward:      model.Reward{Nuts: 150},
+		},
+		{
+			Id:          "win_rounds",
+			Title:       "Round winner",
+			Description: "Win 5 rounds",
+			Event:       achievement.ROUND,
+			Goal:        5,
+			Condition:   achievement.Won,
+			Reward:      model.Reward{Nuts: 100},
+		},
+		{
+			Id:          "take_tricks",
+			Title:       "Trick taker",
+			Description: "Take 15 tricks",
+			Event:       achievement.DEAL,
+			Goal:        15,
+			Condition:   achievement.Always,
+			Reward:      model.Reward{Nuts: 100},
+		},
+		{
+			Id:          "play_games",
+			Title:       "Regular",
+			Description: "Play 3 games",
+			Event:       achievement.GAME,
+			Goal:        3,
+			Condition:   achievement.Always,
+			Reward:      model.Reward{Nuts: 150},
+		},
+		{
+			Id:          "win_game",
+			Title:       "Winner",
+			Description: "Win a game",
+			Event:       achievement.GAME,
+			Goal:        1,
+			Condition:   achievement.Won,
+			Reward:      model.Reward{Nuts: 200},
+		},
+	}
+}
+
+// Pick selects n quests for the day. Selection depends on the day
+// only, so every instance picks the same quests.
+func Pick(quests []*Quest, day time.Time, n int) []*Quest {
+	if n > len(quests) {
+		n = len(quests)
+	}
+
+	r := rand.New(rand.NewSource(day.UTC().Unix()))
+	perm := r.Perm(len(quests))[:n]
+	sort.Ints(perm)
+
+	picked := make([]*Quest, n)
+	for i, p := range perm {
+		picked[i] = quests[p]
+	}
+	return picked
+}
+
+// Find returns quest with given id.
+func Find(quests []*Quest, id string) *Quest {
+	for _, q := range quests {
+		if q.Id == id {
+			return q
+		}
+	}
+	return nil
+}
+
+// Advances reports whether outcome advances quest.
+func (q *Quest) Advances(outcome *achievement.Outcome) bool {
+	return q.Event == outcome.Event && q.Condition(outcome)
+}
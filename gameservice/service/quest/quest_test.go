@@ -0,0 +1,45 @@
+package quest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Handzo/gogame/gameservice/service/achievement"
+)
+
+func TestPick(t *testing.T) {
+	day := time.Date(2020, 6, 3, 0, 0, 0, 0, time.UTC)
+
+	first := Pick(Defaults(), day, 3)
+	second := Pick(Defaults(), day, 3)
+
+	if len(first) != 3 {
+		t.Fatalf("expected 3 quests, got %d", len(first))
+	}
+
+	for i := range first {
+		if first[i].Id != second[i].Id {
+			t.Fatalf("picks differ for the same day: %s %s", first[i].Id, second[i].Id)
+		}
+	}
+
+	if n := len(Pick(Defaults(), day, 100)); n != len(Defaults()) {
+		t.Fatalf("expected all quests, got %d", n)
+	}
+}
+
+func TestAdvances(t *testing.T) {
+	hearts := Find(Defaults(), "hearts_rounds")
+
+	if !hearts.Advances(&achievement.Outcome{Event: achievement.ROUND, Won: true, Trump: "2"}) {
+		t.Fatal("won round with hearts trump should advance quest")
+	}
+
+	if hearts.Advances(&achievement.Outcome{Event: achievement.ROUND, Won: true, Trump: "0"}) {
+		t.Fatal("round with clubs trump should not advance quest")
+	}
+
+	if hearts.Advances(&achievement.Outcome{Event: achievement.GAME, Won: true, Trump: "2"}) {
+		t.Fatal("game outcome should not advance round quest")
+	}
+}
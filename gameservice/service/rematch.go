@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Handzo/gogame/common/log"
+	"github.com/Handzo/gogame/gameservice/code"
+	pb "github.com/Handzo/gogame/gameservice/proto"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/Handzo/gogame/gameservice/service/pubsub"
+	"github.com/Handzo/gogame/rmq"
+)
+
+func (g *gameService) Rematch(ctx context.Context, req *pb.RematchRequest) (*pb.RematchResponse, error) {
+	playerId := ctx.Value("player_id").(string)
+
+	table, err := g.repo.FindTable(ctx, req.TableId)
+	if err != nil {
+		return nil, err
+	}
+
+	if table == nil {
+		return nil, code.TableNotFound
+	}
+
+	if seatOf(table, playerId) == nil {
+		return nil, code.NotParticipant
+	}
+
+	// tournament tables are paired by the bracket
+	if table.TournamentId != "" {
+		return nil, code.RematchNotFound
+	}
+
+	if !req.Accept {
+		if err = g.declineRematch(ctx, table.Id, playerId); err != nil {
+			return nil, err
+		}
+		return &pb.RematchResponse{}, nil
+	}
+
+	rematch, err := g.repo.VoteRematch(ctx, table.Id, playerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if rematch == nil {
+		return nil, code.RematchNotFound
+	}
+
+	g.pubsub.Room(table.Id).Publish(ctx, &pubsub.Event{
+		Event: "RematchVoted",
+		Payload: &pubsub.RematchVoted{
+			TableId:  table.Id,
+			PlayerId: playerId,
+			Votes:    len(rematch.Votes),
+		},
+	})
+
+	if len(rematch.Votes) == len(table.Participants) {
+		if err = g.startRematch(ctx, table, rematch); err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.RematchResponse{}, nil
+}
+
+// offerRematch starts rematch vote for players of finished table.
+// Tables somebody has left and tournament tables are not offered
+// for rematch.
+func (g *gameService) offerRematch(ctx context.Context, table *model.Table) error {
+	if table.TournamentId != "" {
+		return nil
+	}
+
+	for _, p := range table.Participants {
+		if p.PlayerId == "" || p.State == model.LEFT {
+			return nil
+		}
+	}
+
+	rematch := &model.Rematch{
+		TableId:   table.Id,
+		State:     model.VOTING,
+		ExpiresAt: time.Now().Add(g.config.RematchTimeout),
+	}
+
+	if err := g.repo.Insert(ctx, rematch); err != nil {
+		return err
+	}
+
+	g.worker.AddTask(rmq.NewTask(EXPIRE_REMATCH, table.Id, rmq.WithExecTime(rematch.ExpiresAt)))
+
+	g.pubsub.Room(table.Id).Publish(ctx, &pubsub.Event{
+		Event: "RematchOffered",
+		Payload: &pubsub.RematchOffered{
+			TableId:   table.Id,
+			ExpiresAt: rematch.ExpiresAt.Unix(),
+		},
+	})
+
+	return nil
+}
+
+// startRematch creates new table with the same stake and seating
+// and moves players of finished table into its room.
+func (g *gameService) startRematch(ctx context.Context, table *model.Table, rematch *model.Rematch) error {
+	next := rematchTable(table)
+
+	// the last vote may race with expiry
+	started, err := g.repo.StartRematch(ctx, table.Id, next)
+	if err != nil || !started {
+		return err
+	}
+
+	rematch.NewTableId = next.Id
+
+	seats := make([]*model.Participant, 0, len(next.Participants))
+	for _, p := range next.Participants {
+		if p.PlayerId != "" {
+			seats = append(seats, p)
+		}
+	}
+
+	g.logSeatsTaken(ctx, next.Id, seats...)
+
+	participants := make([]pubsub.Participant, len(next.Participants))
+	for i, p := range next.Participants {
+		participants[i] = participantInfo(p)
+	}
+
+	g.pubsub.Room(table.Id).Publish(ctx, &pubsub.Event{
+		Event: "RematchStarted",
+		Payload: &pubsub.RematchStarted{
+			TableId:      table.Id,
+			NewTableId:   next.Id,
+			Participants: participants,
+		},
+	})
+
+	for _, p := range seats {
+		g.pubsub.RemoveFromRoom(ctx, table.Id, p.PlayerId)
+		g.pubsub.AddToRoom(ctx, next.Id, p.PlayerId)
+	}
+
+	g.lobbyTableCreated(ctx, next.Id)
+
+	g.logger.For(ctx).Info("Rematch started", log.String("table", table.Id), log.String("new_table", next.Id))
+
+	return nil
+}
+
+func (g *gameService) declineRematch(ctx context.Context, tableId, playerId string) error {
+	declined, err := g.repo.CloseRematch(ctx, tableId, model.DECLINED)
+	if err != nil {
+		return err
+	}
+
+	if !declined {
+		return code.RematchNotFound
+	}
+
+	g.pubsub.Room(tableId).Publish(ctx, &pubsub.Event{
+		Event: "RematchDeclined",
+		Payload: &pubsub.RematchDeclined{
+			TableId:  tableId,
+			PlayerId: playerId,
+		},
+	})
+
+	return nil
+}
+
+func (g *gameService) expireRematch(ctx context.Context, task *rmq.Task) error {
+	err := g.declineRematch(ctx, task.Topic, "")
+
+	// rematch has already been started or declined
+	if err == code.RematchNotFound {
+		return nil
+	}
+
+	return err
+}
+
+// rematchTable returns table of the next game with the same stake and
+// seating. Creator who is no longer seated is replaced by the first
+// participant.
+func rematchTable(table *model.Table) *model.Table {
+	next := &model.Table{
+		Bet:       table.Bet,
+		Currency:  table.Currency,
+		Variant:   table.Variant,
+		Private:   table.Private,
+		CreatorId: table.CreatorId,
+	}
+
+	if seatOf(table, next.CreatorId) == nil {
+		next.CreatorId = table.Participants[0].PlayerId
+	}
+
+	for order := 1; order <= 4; order++ {
+		p := &model.Participant{Order: order, State: model.FREE}
+		if prev := participantWithOrder(table, order); prev != nil && prev.PlayerId != "" {
+			p.PlayerId, p.Player, p.State = prev.PlayerId, prev.Player, model.BUSY
+		}
+		next.Participants = append(next.Participants, p)
+	}
+
+	return next
+}
+
+func participantWithOrder(table *model.Table, order int) *model.Participant {
+	for _, p := range table.Participants {
+		if p.Order == order {
+			return p
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+)
+
+func TestRematchTable(t *testing.T) {
+	table := &model.Table{
+		Bet:       100,
+		Currency:  model.GOLD,
+		Variant:   model.CLASSIC,
+		Private:   true,
+		CreatorId: "gone",
+	}
+	table.Id = "t1"
+
+	// participants are not guaranteed to be loaded sorted
+	for _, order := range []int{3, 1, 4, 2} {
+		p := &model.Participant{Order: order, PlayerId: "p" + string(rune('0'+order))}
+		if order == 4 {
+			p.PlayerId, p.State = "", model.FREE
+		}
+		table.Participants = append(table.Participants, p)
+	}
+
+	next := rematchTable(table)
+
+	if next.Bet != table.Bet || next.Currency != table.Currency || next.Variant != table.Variant || !next.Private {
+		t.Fatalf("expected the same stake, got %+v", next)
+	}
+
+	if next.CreatorId != "p3" {
+		t.Fatalf("expected first participant to replace creator, got %q", next.CreatorId)
+	}
+
+	if len(next.Participants) != 4 {
+		t.Fatalf("expected 4 participants, got %d", len(next.Participants))
+	}
+
+	for i, p := range next.Participants {
+		if p.Order != i+1 {
+			t.Fatalf("expected order %d, got %d", i+1, p.Order)
+		}
+
+		expected, state := "p"+string(rune('1'+i)), model.BUSY
+		if p.Order == 4 {
+			expected, state = "", model.FREE
+		}
+
+		if p.PlayerId != expected || p.State != state {
+			t.Fatalf("seat %d: expected %q %s, got %q %s", p.Order, expected, state, p.PlayerId, p.State)
+		}
+	}
+
+	table.CreatorId = "p2"
+	if next = rematchTable(table); next.CreatorId != "p2" {
+		t.Fatalf("expected seated creator to be kept, got %q", next.CreatorId)
+	}
+}
@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Handzo/gogame/common/log"
+	"github.com/Handzo/gogame/gameservice/code"
+	pb "github.com/Handzo/gogame/gameservice/proto"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/Handzo/gogame/gameservice/service/achievement"
+	"github.com/Handzo/gogame/gameservice/service/pubsub"
+	"github.com/Handzo/gogame/gameservice/service/quest"
+	"github.com/Handzo/gogame/rmq"
+)
+
+const (
+	day = 24 * time.Hour
+	// questsRefresh is how long loaded active quests are reused
+	// for game outcomes
+	questsRefresh = time.Minute
+)
+
+// questCache keeps active quests, so outcomes of every deal, round
+// and game do not load them again.
+type questCache struct {
+	mu       sync.Mutex
+	loadedAt time.Time
+	quests   []*model.DailyQuest
+}
+
+type DailyRewards []uint64
+
+// For returns nuts granted for given day of streak.
+func (r DailyRewards) For(streak uint32) uint64 {
+	if len(r) == 0 || streak == 0 {
+		return 0
+	}
+
+	if int(streak) > len(r) {
+		return r[len(r)-1]
+	}
+
+	return r[streak-1]
+}
+
+// nextStreak returns streak player gets by claiming reward now. Streak
+// continues if previous reward has been claimed yesterday and restarts
+// otherwise. False is returned if reward has already been claimed today.
+func nextStreak(lastRewardAt time.Time, streak uint32, now time.Time) (uint32, bool) {
+	today := now.UTC().Truncate(day)
+
+	if lastRewardAt.IsZero() {
+		return 1, true
+	}
+
+	last := lastRewardAt.UTC().Truncate(day)
+	switch {
+	case !last.Before(today):
+		return streak, false
+	case last.Add(day).Equal(today):
+		return streak + 1, true
+	default:
+		return 1, true
+	}
+}
+
+func (g *gameService) ClaimDailyReward(ctx context.Context, req *pb.ClaimDailyRewardRequest) (*pb.ClaimDailyRewardResponse, error) {
+	playerId := ctx.Value("player_id").(string)
+
+	var nuts uint64
+	player, err := g.repo.ClaimDailyReward(ctx, playerId, func(p *model.Player) (model.Reward, error) {
+		now := time.Now()
+
+		streak, ok := nextStreak(p.LastRewardAt, p.Streak, now)
+		if !ok {
+			return model.Reward{}, code.RewardAlreadyClaimed
+		}
+
+		p.Streak = streak
+		p.LastRewardAt = now
+		nuts = g.config.DailyRewards.For(streak)
+
+		return model.Reward{Nuts: nuts}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	g.logger.For(ctx).Info("Daily reward claimed", log.String("player_id", playerId), log.Int("streak", int(player.Streak)))
+
+	return &pb.ClaimDailyRewardResponse{
+		Streak:     player.Streak,
+		RewardNuts: nuts,
+		Nuts:       player.Nuts,
+		Gold:       player.Gold,
+	}, nil
+}
+
+func (g *gameService) GetQuests(ctx context.Context, req *pb.GetQuestsRequest) (*pb.GetQuestsResponse, error) {
+	quests, err := g.playerQuests(ctx, ctx.Value("player_id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetQuestsResponse{
+		Quests: quests,
+	}, nil
+}
+
+// pendingRewards returns rewards player may still get today.
+func (g *gameService) pendingRewards(ctx context.Context, player *model.Player) (*pb.PendingRewards, error) {
+	quests, err := g.playerQuests(ctx, player.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := &pb.PendingRewards{}
+	for _, q := range quests {
+		if q.CompletedAt == 0 {
+			pending.Quests = append(pending.Quests, q)
+		}
+	}
+
+	if streak, ok := nextStreak(player.LastRewardAt, player.Streak, time.Now()); ok {
+		pending.DailyReward = true
+		pending.Streak = streak
+		pending.DailyRewardNuts = g.config.DailyRewards.For(streak)
+	}
+
+	return pending, nil
+}
+
+// playerQuests returns active quests with player's progress.
+func (g *gameService) playerQuests(ctx context.Context, playerId string) ([]*pb.Quest, error) {
+	active, err := g.repo.GetActiveQuests(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(active))
+	for i, q := range active {
+		ids[i] = q.Id
+	}
+
+	progress, err := g.repo.GetQuestProgress(ctx, playerId, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byId := make(map[string]*model.QuestProgress, len(progress))
+	for _, p := range progress {
+		byId[p.DailyQuestId] = p
+	}
+
+	quests := make([]*pb.Quest, 0, len(active))
+	for _, dq := range active {
+		q := quest.Find(g.config.Quests, dq.QuestId)
+		if q == nil {
+			continue
+		}
+
+		info := &pb.Quest{
+			Id:          dq.Id,
+			Title:       q.Title,
+			Description: q.Description,
+			Goal:        uint32(q.Goal),
+			ExpiresAt:   dq.ExpiresAt.Unix(),
+			RewardNuts:  q.Reward.Nuts,
+			RewardGold:  q.Reward.Gold,
+		}
+
+		if p, ok := byId[dq.Id]; ok {
+			info.Progress = uint32(p.Progress)
+			if p.IsCompleted() {
+				info.CompletedAt = p.CompletedAt.Unix()
+			}
+		}
+
+		quests = append(quests, info)
+	}
+
+	return quests, nil
+}
+
+// trackOutcome advances player's achievements and quests.
+func (g *gameService) trackOutcome(ctx context.Context, playerId string, outcome *achievement.Outcome) {
+	g.advanceAchievements(ctx, playerId, outcome)
+	g.advanceQuests(ctx, playerId, outcome)
+}
+
+// activeQuests returns quests of the day, loading them at most once
+// per questsRefresh.
+func (g *gameService) activeQuests(ctx context.Context) ([]*model.DailyQuest, error) {
+	c := &g.quests
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(c.loadedAt) < questsRefresh && now.UTC().Truncate(day).Equal(c.loadedAt.UTC().Truncate(day)) {
+		return c.quests, nil
+	}
+
+	quests, err := g.repo.GetActiveQuests(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	c.quests, c.loadedAt = quests, now
+	return quests, nil
+}
+
+// advanceQuests evaluates outcome against active quests and pushes
+// quests completed by it.
+func (g *gameService) advanceQuests(ctx context.Context, playerId string, outcome *achievement.Outcome) {
+	active, err := g.activeQuests(ctx)
+	if err != nil {
+		return
+	}
+
+	for _, dq := range active {
+		q := quest.Find(g.config.Quests, dq.QuestId)
+		if q == nil || !q.Advances(outcome) {
+			continue
+		}
+
+		_, player, err := g.repo.AdvanceQuest(ctx, playerId, dq.Id, q.Goal, q.Reward)
+		if err != nil || player == nil {
+			continue
+		}
+
+		g.logger.For(ctx).Info("Quest completed", log.String("player_id", playerId), log.String("quest", q.Id))
+
+		g.notify(ctx, playerId, &pubsub.Event{
+			Event: "QuestCompleted",
+			Payload: &pubsub.QuestCompleted{
+				Id:          dq.Id,
+				Title:       q.Title,
+				Description: q.Description,
+				RewardNuts:  q.Reward.Nuts,
+				RewardGold:  q.Reward.Gold,
+				Nuts:        player.Nuts,
+				Gold:        player.Gold,
+			},
+		})
+	}
+}
+
+// startQuests creates quests of the day the task is scheduled for
+// and schedules their expiry along with quests of the next day.
+func (g *gameService) startQuests(ctx context.Context, task *rmq.Task) error {
+	start := time.Now().UTC().Truncate(day)
+	defer g.scheduleQuests(start.Add(day))
+
+	picked := quest.Pick(g.config.Quests, start, g.config.QuestsPerDay)
+	quests := make([]*model.DailyQuest, len(picked))
+	for i, q := range picked {
+		quests[i] = &model.DailyQuest{
+			QuestId:   q.Id,
+			StartsAt:  start,
+			ExpiresAt: start.Add(day),
+		}
+	}
+
+	created, err := g.repo.CreateDailyQuests(ctx, quests)
+	if err != nil {
+		return err
+	}
+
+	for _, q := range created {
+		g.worker.AddTask(rmq.NewTask(
+			EXPIRE_QUEST,
+			q.Id,
+			rmq.WithExecTime(q.ExpiresAt),
+			rmq.WithId(EXPIRE_QUEST+":"+q.Id),
+		))
+	}
+
+	g.logger.For(ctx).Info("Daily quests started", log.Int("quests", len(created)))
+
+	return nil
+}
+
+func (g *gameService) expireQuest(ctx context.Context, task *rmq.Task) error {
+	g.logger.For(ctx).Info("Expire daily quest", log.String("quest", task.Topic))
+
+	return g.repo.ExpireDailyQuest(ctx, task.Topic)
+}
+
+// scheduleQuests adds task starting quests of given day. Task id is
+// derived from the day, so instances and restarts do not duplicate it.
+func (g *gameService) scheduleQuests(at time.Time) {
+	g.worker.AddTask(rmq.NewTask(
+		START_QUESTS,
+		"quests",
+		rmq.WithExecTime(at),
+		rmq.WithId(START_QUESTS+":"+at.UTC().Format("2006-01-02")),
+	))
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextStreak(t *testing.T) {
+	now := time.Date(2020, 6, 3, 10, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name   string
+		last   time.Time
+		streak uint32
+		want   uint32
+		ok     bool
+	}{
+		{"first claim", time.Time{}, 0, 1, true},
+		{"claimed today", now.Add(-time.Hour), 4, 4, false},
+		{"claimed yesterday", now.Add(-20 * time.Hour), 4, 5, true},
+		{"claimed late yesterday", time.Date(2020, 6, 2, 23, 59, 0, 0, time.UTC), 2, 3, true},
+		{"missed a day", now.Add(-48 * time.Hour), 4, 1, true},
+	}
+
+	for _, c := range cases {
+		streak, ok := nextStreak(c.last, c.streak, now)
+		if streak != c.want || ok != c.ok {
+			t.Errorf("%s: got %d %v, want %d %v", c.name, streak, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestDailyRewardsFor(t *testing.T) {
+	rewards := DailyRewards{50, 100, 200}
+
+	for streak, want := range map[uint32]uint64{0: 0, 1: 50, 3: 200, 10: 200} {
+		if got := rewards.For(streak); got != want {
+			t.Errorf("streak %d: got %d, want %d", streak, got, want)
+		}
+	}
+}
@@ -0,0 +1,532 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Handzo/gogame/common/log"
+	"github.com/Handzo/gogame/gameservice/code"
+	pb "github.com/Handzo/gogame/gameservice/proto"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/Handzo/gogame/gameservice/service/pubsub"
+	"github.com/Handzo/gogame/rmq"
+)
+
+// ForfeitRules are penalties of player who leaves started game.
+type ForfeitRules struct {
+	// Nuts taken from player, balance does not go below zero
+	Nuts uint64
+	// Rating lost in addition to regular rating change
+	Rating int
+}
+
+func (g *gameService) LeaveTable(ctx context.Context, req *pb.LeaveTableRequest) (*pb.LeaveTableResponse, error) {
+	playerId := ctx.Value("player_id").(string)
+
+	table, err := g.findActiveTable(ctx, req.TableId)
+	if err != nil {
+		return nil, err
+	}
+
+	if p := seatOf(table, playerId); p != nil {
+		if err = g.leaveSeat(ctx, table, p, "PlayerLeft"); err != nil {
+			return nil, err
+		}
+	}
+
+	g.pubsub.RemoveFromRoom(ctx, table.Id, playerId)
+
+	g.logger.For(ctx).Info("Player left table", log.String("player_id", playerId), log.String("table", table.Id))
+
+	return &pb.LeaveTableResponse{}, nil
+}
+
+func (g *gameService) StandUp(ctx context.Context, req *pb.StandUpRequest) (*pb.StandUpResponse, error) {
+	playerId := ctx.Value("player_id").(string)
+
+	table, err := g.findActiveTable(ctx, req.TableId)
+	if err != nil {
+		return nil, err
+	}
+
+	p := seatOf(table, playerId)
+	if p == nil {
+		return nil, code.NotParticipant
+	}
+
+	// player keeps watching the table from the room
+	if err = g.leaveSeat(ctx, table, p, "PlayerStoodUp"); err != nil {
+		return nil, err
+	}
+
+	return &pb.StandUpResponse{}, nil
+}
+
+func (g *gameService) KickParticipant(ctx context.Context, req *pb.KickParticipantRequest) (*pb.KickParticipantResponse, error) {
+	table, err := g.findManagedTable(ctx, req.TableId)
+	if err != nil {
+		return nil, err
+	}
+
+	// locked and free seats have nobody to kick
+	p := participantById(table, req.ParticipantId)
+	if p == nil || p.PlayerId == "" {
+		return nil, code.ParticipantNotFound
+	}
+
+	if p.PlayerId == table.CreatorId {
+		return nil, code.CannotKickSelf
+	}
+
+	playerId := p.PlayerId
+	if err = g.changeState(ctx, p, model.FREE); err != nil {
+		return nil, err
+	}
+
+	g.pubsub.Room(table.Id).Publish(ctx, &pubsub.Event{
+		Event: "ParticipantKicked",
+		Payload: &pubsub.ParticipantKicked{
+			TableId:     table.Id,
+			Participant: participantInfo(p),
+		},
+	})
+
+	if err = g.cancelStart(ctx, table); err != nil {
+		return nil, err
+	}
+
+	g.pubsub.RemoveFromRoom(ctx, table.Id, playerId)
+	g.notify(ctx, playerId, &pubsub.Event{
+		Event: "KickedFromTable",
+		Payload: &pubsub.KickedFromTable{
+			TableId: table.Id,
+		},
+	})
+
+	g.lobbyTableChanged(ctx, table.Id)
+
+	g.logger.For(ctx).Info("Participant kicked", log.String("player_id", playerId), log.String("table", table.Id))
+
+	return &pb.KickParticipantResponse{}, nil
+}
+
+func (g *gameService) LockSeat(ctx context.Context, req *pb.LockSeatRequest) (*pb.LockSeatResponse, error) {
+	table, err := g.findManagedTable(ctx, req.TableId)
+	if err != nil {
+		return nil, err
+	}
+
+	p := participantById(table, req.ParticipantId)
+	if p == nil {
+		return nil, code.ParticipantNotFound
+	}
+
+	state, event := model.LOCKED, "SeatLocked"
+	if !req.Locked {
+		state, event = model.FREE, "SeatUnlocked"
+	}
+
+	if err = g.changeState(ctx, p, state); err != nil {
+		return nil, err
+	}
+
+	g.pubsub.Room(table.Id).Publish(ctx, &pubsub.Event{
+		Event: event,
+		Payload: &pubsub.SeatLocked{
+			TableId:     table.Id,
+			Participant: participantInfo(p),
+		},
+	})
+
+	g.lobbyTableChanged(ctx, table.Id)
+
+	return &pb.LockSeatResponse{}, nil
+}
+
+func (g *gameService) CloseTable(ctx context.Context, req *pb.CloseTableRequest) (*pb.CloseTableResponse, error) {
+	table, err := g.findManagedTable(ctx, req.TableId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = g.abandonTable(ctx, table, model.CLOSED, "closed_by_creator"); err != nil {
+		return nil, err
+	}
+
+	return &pb.CloseTableResponse{}, nil
+}
+
+func (g *gameService) MoveToSeat(ctx context.Context, req *pb.MoveToSeatRequest) (*pb.MoveToSeatResponse, error) {
+	table, err := g.findWaitingTable(ctx, req.TableId)
+	if err != nil {
+		return nil, err
+	}
+
+	from := seatOf(table, ctx.Value("player_id").(string))
+	if from == nil {
+		return nil, code.NotParticipant
+	}
+
+	to := participantById(table, req.ParticipantId)
+	if to == nil {
+		return nil, code.ParticipantNotFound
+	}
+
+	if to.Id == from.Id {
+		return nil, code.SameSeat
+	}
+
+	if to.State != model.FREE {
+		return nil, code.ParticipantStateIsNotFree
+	}
+
+	to.PlayerId, to.Player, to.State = from.PlayerId, from.Player, model.BUSY
+	from.PlayerId, from.Player, from.State = "", nil, model.FREE
+
+	if err = g.changeSeating(ctx, table, from, to); err != nil {
+		return nil, err
+	}
+
+	return &pb.MoveToSeatResponse{}, nil
+}
+
+func (g *gameService) RequestSeatSwap(ctx context.Context, req *pb.RequestSeatSwapRequest) (*pb.RequestSeatSwapResponse, error) {
+	table, err := g.findWaitingTable(ctx, req.TableId)
+	if err != nil {
+		return nil, err
+	}
+
+	from := seatOf(table, ctx.Value("player_id").(string))
+	if from == nil {
+		return nil, code.NotParticipant
+	}
+
+	to := participantById(table, req.ParticipantId)
+	if to == nil {
+		return nil, code.ParticipantNotFound
+	}
+
+	if to.Id == from.Id {
+		return nil, code.SameSeat
+	}
+
+	if to.PlayerId == "" {
+		return nil, code.SeatIsFree
+	}
+
+	swap := &model.SeatSwap{
+		TableId:     table.Id,
+		FromId:      from.Id,
+		ToId:        to.Id,
+		RequesterId: from.PlayerId,
+		TargetId:    to.PlayerId,
+		ExpiresAt:   time.Now().Add(g.config.SeatSwapTTL),
+	}
+
+	if err = g.repo.Insert(ctx, swap); err != nil {
+		return nil, err
+	}
+
+	g.pubsub.ToPlayer(ctx, to.PlayerId, &pubsub.Event{
+		Event: "SeatSwapRequested",
+		Payload: &pubsub.SeatSwapRequested{
+			SwapId:    swap.Id,
+			TableId:   table.Id,
+			From:      participantInfo(from),
+			To:        participantInfo(to),
+			ExpiresAt: swap.ExpiresAt.Unix(),
+		},
+	})
+
+	return &pb.RequestSeatSwapResponse{
+		SwapId:    swap.Id,
+		ExpiresAt: swap.ExpiresAt.Unix(),
+	}, nil
+}
+
+func (g *gameService) AcceptSeatSwap(ctx context.Context, req *pb.AcceptSeatSwapRequest) (*pb.AcceptSeatSwapResponse, error) {
+	swap, err := g.repo.AcceptSeatSwap(ctx, req.SwapId, ctx.Value("player_id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	if swap == nil {
+		return nil, code.SeatSwapNotFound
+	}
+
+	table, err := g.findWaitingTable(ctx, swap.TableId)
+	if err != nil {
+		return nil, err
+	}
+
+	from := participantById(table, swap.FromId)
+	to := participantById(table, swap.ToId)
+
+	// players have changed seats since the request
+	if from == nil || to == nil || from.PlayerId != swap.RequesterId || to.PlayerId != swap.TargetId {
+		return nil, code.SeatSwapNotFound
+	}
+
+	from.PlayerId, to.PlayerId = to.PlayerId, from.PlayerId
+	from.Player, to.Player = to.Player, from.Player
+	from.State, to.State = model.BUSY, model.BUSY
+
+	if err = g.changeSeating(ctx, table, from, to); err != nil {
+		return nil, err
+	}
+
+	return &pb.AcceptSeatSwapResponse{}, nil
+}
+
+// changeSeating saves changed seats, resets ready flags and publishes
+// full seating of the table.
+func (g *gameService) changeSeating(ctx context.Context, table *model.Table, seats ...*model.Participant) error {
+	if err := g.repo.ChangeSeating(ctx, table.Id, seats...); err != nil {
+		return err
+	}
+
+	for _, p := range seats {
+		g.logTableEvent(ctx, table.Id, &model.TableEvent{
+			Type:  model.SEAT_LEFT,
+			Order: p.Order,
+		})
+	}
+	g.logSeatsTaken(ctx, table.Id, seats...)
+
+	if err := g.cancelStart(ctx, table); err != nil {
+		return err
+	}
+
+	participants := make([]pubsub.Participant, len(table.Participants))
+	for i, p := range table.Participants {
+		if p.State == model.READY {
+			p.State = model.BUSY
+		}
+		participants[i] = participantInfo(p)
+	}
+
+	g.pubsub.Room(table.Id).Publish(ctx, &pubsub.Event{
+		Event: "SeatsChanged",
+		Payload: &pubsub.SeatsChanged{
+			TableId:      table.Id,
+			Participants: participants,
+		},
+	})
+
+	g.lobbyTableChanged(ctx, table.Id)
+
+	return nil
+}
+
+// closeRoom publishes TableClosed and removes everyone from the room.
+func (g *gameService) closeRoom(ctx context.Context, tableId, reason string) {
+	g.pubsub.Room(tableId).Publish(ctx, &pubsub.Event{
+		Event: "TableClosed",
+		Payload: &pubsub.TableClosed{
+			TableId: tableId,
+			Reason:  reason,
+		},
+	})
+
+	players, err := g.pubsub.GetPlayers(tableId)
+	if err != nil {
+		return
+	}
+
+	for _, playerId := range players {
+		g.pubsub.RemoveFromRoom(ctx, tableId, playerId)
+	}
+}
+
+// leaveSeat frees participant's seat. Leaving started game is a forfeit.
+func (g *gameService) leaveSeat(ctx context.Context, table *model.Table, p *model.Participant, event string) error {
+	forfeit := table.IsOpen()
+
+	state := model.FREE
+	if forfeit {
+		state = model.LEFT
+	}
+
+	playerId := p.PlayerId
+	if err := g.changeState(ctx, p, state); err != nil {
+		return err
+	}
+
+	g.pubsub.Room(table.Id).Publish(ctx, &pubsub.Event{
+		Event: event,
+		Payload: &pubsub.ParticipantLeft{
+			TableId:     table.Id,
+			Participant: participantInfo(p),
+			Forfeit:     forfeit,
+		},
+	})
+
+	if !forfeit {
+		if err := g.cancelStart(ctx, table); err != nil {
+			return err
+		}
+
+		g.lobbyTableChanged(ctx, table.Id)
+		return nil
+	}
+
+	if err := g.repo.PenalizePlayer(ctx, playerId, g.config.Forfeit.Nuts, g.config.Forfeit.Rating); err != nil {
+		return err
+	}
+
+	g.logger.For(ctx).Info("Player forfeited game", log.String("player_id", playerId), log.String("table", table.Id))
+
+	// the first forfeit finishes the game
+	if table.Result != "" {
+		return nil
+	}
+
+	table.Result = model.FORFEIT
+	if err := g.updateTable(ctx, table, "result"); err != nil {
+		return err
+	}
+
+	g.worker.AddTask(rmq.NewTask(FINISH_GAME, table.Id, rmq.WithDelay(time.Second)))
+	return nil
+}
+
+// cancelStart returns table to waiting if seating has changed
+// after all participants got ready.
+func (g *gameService) cancelStart(ctx context.Context, table *model.Table) error {
+	if table.State != model.STARTING {
+		return nil
+	}
+
+	return g.transit(ctx, table, model.WAITING)
+}
+
+// changeState moves participant to another state. Player is removed
+// from the seat when it becomes free.
+func (g *gameService) changeState(ctx context.Context, p *model.Participant, state model.ParticipantState) error {
+	if !p.State.CanBecome(state) {
+		return code.InvalidStateTransition
+	}
+
+	playerId := p.PlayerId
+
+	p.State = state
+	if state == model.FREE {
+		p.PlayerId = ""
+		p.Player = nil
+	}
+
+	if err := g.repo.UpdateParticipant(ctx, p, "player_id", "state"); err != nil {
+		return err
+	}
+
+	if playerId != "" && (state == model.FREE || state == model.LEFT) {
+		g.logTableEvent(ctx, p.TableId, &model.TableEvent{
+			Type:     model.SEAT_LEFT,
+			Order:    p.Order,
+			PlayerId: playerId,
+		})
+	}
+
+	return nil
+}
+
+// findActiveTable returns table which has not been finished yet.
+func (g *gameService) findActiveTable(ctx context.Context, tableId string) (*model.Table, error) {
+	table, err := g.repo.FindTable(ctx, tableId)
+	if err != nil {
+		return nil, err
+	}
+
+	if table == nil {
+		return nil, code.TableNotFound
+	}
+
+	if table.IsClosed() {
+		return nil, code.TableClosed
+	}
+
+	return table, nil
+}
+
+// findWaitingTable returns table which has not been started yet.
+func (g *gameService) findWaitingTable(ctx context.Context, tableId string) (*model.Table, error) {
+	table, err := g.findActiveTable(ctx, tableId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !table.IsWaiting() {
+		return nil, code.TableAlreadyStarted
+	}
+
+	return table, nil
+}
+
+// findManagedTable returns not started table created by current player.
+func (g *gameService) findManagedTable(ctx context.Context, tableId string) (*model.Table, error) {
+	table, err := g.findWaitingTable(ctx, tableId)
+	if err != nil {
+		return nil, err
+	}
+
+	if table.CreatorId != ctx.Value("player_id").(string) {
+		return nil, code.NotTableCreator
+	}
+
+	return table, nil
+}
+
+// forfeitingTeam returns team of players who left the game,
+// zero if nobody or players of both teams have left.
+func forfeitingTeam(participants []*model.Participant) int {
+	forfeited := 0
+	for _, p := range participants {
+		if p.State != model.LEFT {
+			continue
+		}
+
+		if forfeited != 0 && forfeited != team(p.Order) {
+			return 0
+		}
+		forfeited = team(p.Order)
+	}
+
+	return forfeited
+}
+
+func seatOf(table *model.Table, playerId string) *model.Participant {
+	for _, p := range table.Participants {
+		if p.PlayerId == playerId {
+			return p
+		}
+	}
+
+	return nil
+}
+
+func participantById(table *model.Table, id string) *model.Participant {
+	for _, p := range table.Participants {
+		if p.Id == id {
+			return p
+		}
+	}
+
+	return nil
+}
+
+func participantInfo(p *model.Participant) pubsub.Participant {
+	info := pubsub.Participant{
+		Id:    p.Id,
+		Order: p.Order,
+		State: string(p.State),
+	}
+
+	if p.Player != nil {
+		info.Player = pubsub.Player{
+			Id:       p.Player.Id,
+			Nickname: p.Player.Nickname,
+		}
+	}
+
+	return info
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+)
+
+// seats returns participants in given states seated in order.
+func seats(states ...model.ParticipantState) []*model.Participant {
+	ps := make([]*model.Participant, len(states))
+	for i, s := range states {
+		ps[i] = &model.Participant{Order: i + 1, State: s}
+	}
+	return ps
+}
+
+func TestForfeitingTeam(t *testing.T) {
+	cases := []struct {
+		name  string
+		seats []*model.Participant
+		want  int
+	}{
+		{"nobody left", seats(model.READY, model.READY, model.READY, model.READY), 0},
+		{"first team", seats(model.READY, model.READY, model.LEFT, model.READY), 1},
+		{"second team", seats(model.READY, model.LEFT, model.READY, model.DISCONNECT), 2},
+		{"both players of a team", seats(model.READY, model.LEFT, model.READY, model.LEFT), 2},
+		{"both teams", seats(model.LEFT, model.LEFT, model.READY, model.READY), 0},
+	}
+
+	for _, c := range cases {
+		if got := forfeitingTeam(c.seats); got != c.want {
+			t.Errorf("%s: got %d, want %d", c.name, got, c.want)
+		}
+	}
+}
+
+func TestParticipantTransitions(t *testing.T) {
+	if !model.FREE.CanBecome(model.LOCKED) || !model.LOCKED.CanBecome(model.FREE) {
+		t.Fatal("free seat should be lockable and unlockable")
+	}
+
+	if model.BUSY.CanBecome(model.LOCKED) {
+		t.Fatal("occupied seat should not be locked")
+	}
+
+	if model.FREE.CanBecome(model.FREE) || model.LEFT.CanBecome(model.READY) {
+		t.Fatal("unexpected transition allowed")
+	}
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"net"
+	"net/http"
 
 	authpb "github.com/Handzo/gogame/authservice/proto"
 	"github.com/Handzo/gogame/common/interceptor"
@@ -11,7 +12,11 @@ import (
 	pb "github.com/Handzo/gogame/gameservice/proto"
 	"github.com/Handzo/gogame/gameservice/repository"
 	"github.com/Handzo/gogame/gameservice/repository/postgres"
+	"github.com/Handzo/gogame/gameservice/service/avatar"
+	"github.com/Handzo/gogame/gameservice/service/leaderboard"
+	"github.com/Handzo/gogame/gameservice/service/payment"
 	"github.com/Handzo/gogame/gameservice/service/pubsub"
+	"github.com/Handzo/gogame/rmq"
 	"github.com/go-redis/redis"
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
 	"github.com/opentracing/opentracing-go"
@@ -21,14 +26,18 @@ import (
 
 type Server struct {
 	host       string
+	httpHost   string
 	service    pb.GameServiceServer
+	admin      pb.AdminServiceServer
+	payments   http.Handler
+	avatars    *avatar.DiskStorage
 	tracer     opentracing.Tracer
 	logger     log.Factory
 	repo       repository.GameRepository
 	grpcServer *grpc.Server
 }
 
-func NewServer(host string, authsvc authpb.AuthServiceClient, enginesvc enginepb.GameEngineClient, tracer opentracing.Tracer, metricsFactory metrics.Factory, logger log.Factory) *Server {
+func NewServer(host, httpHost string, config *Config, provider payment.Provider, webhookSecret string, avatars *avatar.DiskStorage, authsvc authpb.AuthServiceClient, enginesvc enginepb.GameEngineClient, tracer opentracing.Tracer, metricsFactory metrics.Factory, logger log.Factory) *Server {
 	var rdb *redis.Client
 	{
 		rdb = redis.NewClient(&redis.Options{
@@ -57,15 +66,24 @@ func NewServer(host string, authsvc authpb.AuthServiceClient, enginesvc enginepb
 
 	serveropts := []grpc.UnaryServerInterceptor{
 		interceptor.RequireMetadataKeyServerInterceptor("remote"),
+		AdminServerInterceptor(authsvc, repo, logger),
 		AuthServerInterceptor(repo, pubsub),
 		otgrpc.OpenTracingServerInterceptor(tracer, otgrpc.LogPayloads()),
 	}
 
 	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(interceptor.ChainUnaryServer(serveropts...)))
 
+	worker := NewWorkManager(rmq.NewWorker(), tracer, logger)
+	payments := newPaymentHandler(provider, webhookSecret, repo, pubsub, tracer, logger)
+	gamesvc := NewGameService(config, authsvc, enginesvc, repo, pubsub, payments, avatars, leaderboard.New(rdb, logger), worker, tracer, metricsFactory, logger)
+
 	return &Server{
 		host:       host,
-		service:    NewGameService(authsvc, enginesvc, repo, pubsub, tracer, metricsFactory, logger),
+		httpHost:   httpHost,
+		service:    gamesvc,
+		admin:      NewAdminService(gamesvc),
+		payments:   payments,
+		avatars:    avatars,
 		tracer:     tracer,
 		logger:     logger,
 		repo:       repo,
@@ -79,7 +97,17 @@ func (s *Server) Run() error {
 		s.logger.Bg().Fatalf("failed to dial: %v", err)
 	}
 
+	// payment provider notifications and uploaded avatars
+	go func() {
+		mux := http.NewServeMux()
+		mux.Handle("/payments/webhook", s.payments)
+		mux.Handle("/avatars/players/", s.avatars.Handler())
+		s.logger.Bg().Infof("Starting http server %s ...", s.httpHost)
+		s.logger.Bg().Fatal(http.ListenAndServe(s.httpHost, mux))
+	}()
+
 	pb.RegisterGameServiceServer(s.grpcServer, s.service)
+	pb.RegisterAdminServiceServer(s.grpcServer, s.admin)
 	s.logger.Bg().Infof("Starting service %s ...", s.host)
 	return s.grpcServer.Serve(lis)
 }
@@ -13,6 +13,9 @@ import (
 	pb "github.com/Handzo/gogame/gameservice/proto"
 	"github.com/Handzo/gogame/gameservice/repository"
 	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/Handzo/gogame/gameservice/service/avatar"
+	"github.com/Handzo/gogame/gameservice/service/leaderboard"
+	"github.com/Handzo/gogame/gameservice/service/payment"
 	"github.com/Handzo/gogame/gameservice/service/pubsub"
 	"github.com/Handzo/gogame/rmq"
 	"github.com/opentracing/opentracing-go"
@@ -20,52 +23,93 @@ import (
 )
 
 type gameService struct {
-	authsvc   authpb.AuthServiceClient
-	enginesvc enginepb.GameEngineClient
-	tracer    opentracing.Tracer
-	logger    log.Factory
-	repo      repository.GameRepository
-	pubsub    *pubsub.PubSub
-	worker    *WorkManager
+	config       *Config
+	authsvc      authpb.AuthServiceClient
+	enginesvc    enginepb.GameEngineClient
+	tracer       opentracing.Tracer
+	logger       log.Factory
+	repo         repository.GameRepository
+	pubsub       *pubsub.PubSub
+	payments     *paymentHandler
+	avatars      avatar.Storage
+	worker       *WorkManager
+	leaderboards *leaderboard.Leaderboards
+	quests       questCache
 }
 
 const (
-	START_GAME   string = "START_GAME"
-	FINISH_GAME  string = "FINISH_GAME"
-	START_ROUND  string = "START_ROUND"
-	FINISH_ROUND string = "FINISH_ROUND"
-	START_DEAL   string = "START_DEAL"
-	FINISH_DEAL  string = "FINISH_DEAL"
-	NEXT_MOVE    string = "NEXT_MOVE"
+	START_GAME           string = "START_GAME"
+	FINISH_GAME          string = "FINISH_GAME"
+	START_ROUND          string = "START_ROUND"
+	FINISH_ROUND         string = "FINISH_ROUND"
+	START_DEAL           string = "START_DEAL"
+	FINISH_DEAL          string = "FINISH_DEAL"
+	NEXT_MOVE            string = "NEXT_MOVE"
+	EXPIRE_ITEMS         string = "EXPIRE_ITEMS"
+	REBUILD_LEADERBOARDS string = "REBUILD_LEADERBOARDS"
+	START_QUESTS         string = "START_QUESTS"
+	EXPIRE_QUEST         string = "EXPIRE_QUEST"
+	EXPIRE_REMATCH       string = "EXPIRE_REMATCH"
+	SCHEDULE_TOURNAMENTS string = "SCHEDULE_TOURNAMENTS"
+	START_TOURNAMENT     string = "START_TOURNAMENT"
+	TOURNAMENT_ROUND     string = "TOURNAMENT_ROUND"
+	CLEAN_TABLES         string = "CLEAN_TABLES"
+	ANALYZE_COLLUSION    string = "ANALYZE_COLLUSION"
 )
 
 func NewGameService(
+	config *Config,
 	authsvc authpb.AuthServiceClient,
 	enginesvc enginepb.GameEngineClient,
 	repo repository.GameRepository,
 	pubsub *pubsub.PubSub,
+	payments *paymentHandler,
+	avatars avatar.Storage,
+	leaderboards *leaderboard.Leaderboards,
+	worker *WorkManager,
 	tracer opentracing.Tracer,
 	metricsFactory metrics.Factory,
 	logger log.Factory) pb.GameServiceServer {
 	gamesvc := &gameService{
-		authsvc:   authsvc,
-		enginesvc: enginesvc,
-		tracer:    tracer,
-		logger:    logger,
-		repo:      repo,
-		pubsub:    pubsub,
-		worker:    NewWorkManager(rmq.NewWorker(), tracer, logger),
-	}
-
-	gamesvc.worker.Register(START_GAME, gamesvc.startGame)     // set start time
-	gamesvc.worker.Register(FINISH_GAME, gamesvc.finishGame)   // set start time
-	gamesvc.worker.Register(START_ROUND, gamesvc.startRound)   // generate new signature
-	gamesvc.worker.Register(FINISH_ROUND, gamesvc.finishRound) // generate new signature
-	gamesvc.worker.Register(START_DEAL, gamesvc.startDeal)     // create new deal
-	gamesvc.worker.Register(FINISH_DEAL, gamesvc.finishDeal)   // close current deal, start new deal/round or close table
-	gamesvc.worker.Register(NEXT_MOVE, gamesvc.nextMove)       // send which player's turn to move
+		config:       config,
+		authsvc:      authsvc,
+		enginesvc:    enginesvc,
+		tracer:       tracer,
+		logger:       logger,
+		repo:         repo,
+		pubsub:       pubsub,
+		payments:     payments,
+		avatars:      avatars,
+		worker:       worker,
+		leaderboards: leaderboards,
+	}
+
+	gamesvc.worker.Register(START_GAME, gamesvc.startGame)                     // set start time
+	gamesvc.worker.Register(FINISH_GAME, gamesvc.finishGame)                   // set start time
+	gamesvc.worker.Register(START_ROUND, gamesvc.startRound)                   // generate new signature
+	gamesvc.worker.Register(FINISH_ROUND, gamesvc.finishRound)                 // generate new signature
+	gamesvc.worker.Register(START_DEAL, gamesvc.startDeal)                     // create new deal
+	gamesvc.worker.Register(FINISH_DEAL, gamesvc.finishDeal)                   // close current deal, start new deal/round or close table
+	gamesvc.worker.Register(NEXT_MOVE, gamesvc.nextMove)                       // send which player's turn to move
+	gamesvc.worker.Register(EXPIRE_ITEMS, gamesvc.expireItems)                 // remove expired inventory items
+	gamesvc.worker.Register(REBUILD_LEADERBOARDS, gamesvc.rebuildLeaderboards) // recalculate leaderboards from database
+	gamesvc.worker.Register(START_QUESTS, gamesvc.startQuests)                 // pick quests of the day
+	gamesvc.worker.Register(EXPIRE_QUEST, gamesvc.expireQuest)                 // close quest of the past day
+	gamesvc.worker.Register(EXPIRE_REMATCH, gamesvc.expireRematch)             // decline rematch nobody has started
+	gamesvc.worker.Register(SCHEDULE_TOURNAMENTS, gamesvc.planTournaments)     // create upcoming tournaments from templates
+	gamesvc.worker.Register(START_TOURNAMENT, gamesvc.startTournament)         // close registration and play the first round
+	gamesvc.worker.Register(TOURNAMENT_ROUND, gamesvc.nextTournamentRound)     // advance entries, play next round or pay prizes
+	gamesvc.worker.Register(CLEAN_TABLES, gamesvc.cleanTables)                 // close idle and disconnected tables
+	gamesvc.worker.Register(ANALYZE_COLLUSION, gamesvc.analyzeCollusion)       // queue suspicious patterns of recent games for review
 	go gamesvc.worker.Start()
 
+	gamesvc.scheduleLeaderboardsRebuild()
+	gamesvc.scheduleItemsExpiry(time.Now())
+	gamesvc.scheduleQuests(time.Now().UTC().Truncate(day))
+	gamesvc.scheduleTournaments(time.Now())
+	gamesvc.scheduleJanitor(time.Now())
+	gamesvc.scheduleCollusion(time.Now())
+
 	return gamesvc
 }
 
@@ -93,51 +137,67 @@ func (g *gameService) OpenSession(ctx context.Context, req *pb.OpenSessionReques
 		return nil, err
 	}
 
-	// close all opened player's sessions
-	for _, s := range player.Sessions {
-		g.closeSession(ctx, s)
+	if player.IsBanned() {
+		return nil, code.PlayerBanned
 	}
 
+	remote := ctx.Value("remote").(string)
+
 	// creat new session for current remote
 	session := &model.Session{
-		Remote:   ctx.Value("remote").(string),
+		Remote:   remote,
+		Device:   req.Device,
 		PlayerId: player.Id,
 	}
 
-	if err = g.repo.CreateSession(ctx, session); err != nil {
+	replaced := replacedSessions(player.Sessions, session, req.MultiDevice)
+
+	// replaced sessions hand seat over to the new one, so player
+	// does not disconnect from the table
+	if err = g.repo.TakeOverSessions(ctx, session, replaced...); err != nil {
 		return nil, err
 	}
 
+	for _, s := range replaced {
+		g.takeOver(ctx, s, session)
+	}
+
+	// pending move is sent once however many sessions were replaced
+	if len(replaced) != 0 && session.Controller {
+		g.resendPendingMove(ctx, session)
+	}
+
 	table, err := g.repo.FindTableWithPlayer(ctx, player.Id)
 	if err != nil {
 		return nil, err
 	}
 
-	profile := player.Profile
+	// rewards are shown again with the next session
+	pending, err := g.pendingRewards(ctx, player)
+	if err != nil {
+		g.logger.For(ctx).Error(err)
+	}
+
+	// inbox is kept until the next session
+	notifications, err := g.unreadNotifications(ctx, player.Id)
+	if err != nil {
+		g.logger.For(ctx).Error(err)
+	}
 
 	response := &pb.OpenSessionResponse{
-		SessionId: session.Id,
-		Player: &pb.Player{
-			Id:       player.Id,
-			Nickname: player.Nickname,
-			Nuts:     player.Nuts,
-			Gold:     player.Gold,
-			Avatar:   player.Avatar,
-			Profile: &pb.Profile{
-				FirstName: profile.FirstName,
-				LastName:  profile.LastName,
-				Age:       profile.Age,
-				Gender:    string(profile.Gender),
-				Country:   profile.Country,
-				Language:  profile.Language,
-			},
-		},
+		SessionId:      session.Id,
+		Player:         playerInfo(player, true),
+		PendingRewards: pending,
+		Notifications:  notifications,
+		SeatController: session.Controller,
 	}
 
 	if table != nil {
 		response.TableId = table.Id
 	}
 
+	g.notifyFriends(ctx, player, "FriendOnline")
+
 	return response, nil
 }
 
@@ -150,6 +210,12 @@ func (g *gameService) CloseSession(ctx context.Context, req *pb.CloseSessionRequ
 
 	if session != nil {
 		g.closeSession(ctx, session)
+
+		player := &model.Player{}
+		player.Id = session.PlayerId
+		if err := g.repo.Select(ctx, player, "id", "nickname"); err == nil {
+			g.notifyFriends(ctx, player, "FriendOffline")
+		}
 	}
 
 	return &pb.CloseSessionResponse{
@@ -157,23 +223,36 @@ func (g *gameService) CloseSession(ctx context.Context, req *pb.CloseSessionRequ
 	}, nil
 }
 
+// closeSession closes session of one of player's devices. Player leaves
+// tables when the last session is closed, otherwise seat control passes
+// to another device.
 func (g *gameService) closeSession(ctx context.Context, session *model.Session) error {
+	controller := session.Controller
+
 	session.ClosedAt = time.Now()
-	if err := g.repo.Update(ctx, session, "closed_at"); err != nil {
+	session.Controller = false
+	if err := g.repo.Update(ctx, session, "closed_at", "controller"); err != nil {
 		return err
 	}
 
-	// TODO: remove from room, publish 'player leaved' after
-	remote := ctx.Value("remote").(string)
+	g.pubsub.Unbind(ctx, session.PlayerId, session.Remote)
 
-	if playerId := ctx.Value("player_id"); playerId != nil {
-		err := g.beforeSessionClosed(ctx, playerId.(string))
-		if err != nil {
+	remaining, err := g.repo.GetOpenSessions(ctx, session.PlayerId)
+	if err != nil {
+		return err
+	}
+
+	if len(remaining) == 0 {
+		if err = g.beforeSessionClosed(ctx, session.PlayerId); err != nil {
+			return err
+		}
+	} else if controller && !hasController(remaining) {
+		if err = g.passSeatControl(ctx, remaining[0]); err != nil {
 			return err
 		}
 	}
 
-	g.pubsub.Publish(ctx, remote, &pubsub.Event{
+	g.pubsub.Publish(ctx, session.Remote, &pubsub.Event{
 		Event: "CloseSession",
 		Payload: &pubsub.CloseSession{
 			SessionId: session.Id,
@@ -219,7 +298,132 @@ func (g *gameService) GetProducts(ctx context.Context, req *pb.GetProductsReques
 }
 
 func (g *gameService) PurchaseProduct(ctx context.Context, req *pb.PurchaseProductRequest) (*pb.PurchaseProductResponse, error) {
-	return &pb.PurchaseProductResponse{}, nil
+	if req.PurchaseId == "" {
+		return nil, code.InvalidPurchaseId
+	}
+
+	purchase := &model.Purchase{
+		PurchaseId: req.PurchaseId,
+		PlayerId:   ctx.Value("player_id").(string),
+		ProductId:  req.ProductId,
+	}
+
+	player, err := g.repo.PurchaseProduct(ctx, purchase)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.PurchaseProductResponse{
+		PurchaseId: purchase.PurchaseId,
+		Nuts:       player.Nuts,
+		Gold:       player.Gold,
+	}
+
+	if vip := player.Item(model.VIP_ITEM); vip != nil {
+		response.VipUntil = vip.ExpiresAt.Unix()
+	}
+
+	return response, nil
+}
+
+func (g *gameService) CreateCheckout(ctx context.Context, req *pb.CreateCheckoutRequest) (*pb.CreateCheckoutResponse, error) {
+	logger := g.logger.For(ctx)
+
+	if req.PurchaseId == "" {
+		return nil, code.InvalidPurchaseId
+	}
+
+	purchase := &model.Purchase{
+		PurchaseId: req.PurchaseId,
+		PlayerId:   ctx.Value("player_id").(string),
+		ProductId:  req.ProductId,
+	}
+
+	if err := g.repo.CreatePendingPurchase(ctx, purchase); err != nil {
+		return nil, err
+	}
+
+	if purchase.State != model.PENDING {
+		return nil, code.InvalidPurchaseState
+	}
+
+	// retried request gets checkout created by the first one
+	if purchase.ExternalId != "" {
+		return &pb.CreateCheckoutResponse{
+			PurchaseId:  purchase.PurchaseId,
+			CheckoutUrl: purchase.CheckoutUrl,
+		}, nil
+	}
+
+	product, err := g.repo.GetProduct(ctx, purchase.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := g.payments.provider.CreateCheckout(ctx, &payment.Checkout{
+		OrderId:     purchase.Id,
+		Title:       product.Title,
+		Description: product.Description,
+		Price:       purchase.Price,
+		Currency:    string(purchase.Currency),
+	})
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	purchase.ExternalId = session.ExternalId
+	purchase.CheckoutUrl = session.Url
+	if err = g.repo.SetPurchaseCheckout(ctx, purchase); err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateCheckoutResponse{
+		PurchaseId:  purchase.PurchaseId,
+		CheckoutUrl: purchase.CheckoutUrl,
+	}, nil
+}
+
+func (g *gameService) VerifyReceipt(ctx context.Context, req *pb.VerifyReceiptRequest) (*pb.VerifyReceiptResponse, error) {
+	purchase, err := g.repo.FindPurchase(ctx, ctx.Value("player_id").(string), req.PurchaseId)
+	if err != nil {
+		return nil, err
+	}
+
+	if purchase == nil {
+		return nil, code.PurchaseNotFound
+	}
+
+	receipt, err := g.payments.provider.VerifyReceipt(ctx, req.Receipt)
+	if err != nil {
+		g.logger.For(ctx).Warn("receipt verification failed", log.Error(err))
+		return nil, code.InvalidReceipt
+	}
+
+	if receipt.OrderId != purchase.Id {
+		return nil, code.InvalidReceipt
+	}
+
+	purchase, player, err := g.payments.apply(ctx, receipt)
+	if err != nil {
+		return nil, err
+	}
+
+	if purchase.State != model.COMPLETED {
+		return nil, code.PaymentNotCompleted
+	}
+
+	response := &pb.VerifyReceiptResponse{
+		PurchaseId: purchase.PurchaseId,
+		Nuts:       player.Nuts,
+		Gold:       player.Gold,
+	}
+
+	if vip := player.Item(model.VIP_ITEM); vip != nil {
+		response.VipUntil = vip.ExpiresAt.Unix()
+	}
+
+	return response, nil
 }
 
 func (g *gameService) beforeSessionClosed(ctx context.Context, playerId string) error {
@@ -237,10 +441,23 @@ func (g *gameService) beforeSessionClosed(ctx context.Context, playerId string)
 	}
 
 	for _, p := range participants {
+		// player who left started game keeps forfeiting it
+		if p.State == model.LEFT {
+			continue
+		}
+
+		// game can not start without the player
+		if err := g.cancelStartFor(ctx, p); err != nil {
+			return err
+		}
+
+		state := model.DISCONNECT
 		if !p.Table.IsOpen() {
-			p.State = model.FREE
-		} else {
-			p.State = model.DISCONNECT
+			state = model.FREE
+		}
+
+		if p.State == state {
+			continue
 		}
 
 		room := g.pubsub.Room(p.TableId)
@@ -252,12 +469,7 @@ func (g *gameService) beforeSessionClosed(ctx context.Context, playerId string)
 			PlayerId:      p.PlayerId,
 		})
 
-		if p.State == model.FREE {
-			p.PlayerId = ""
-		}
-
-		err := g.repo.Update(ctx, p, "player_id", "state")
-		if err != nil {
+		if err := g.changeState(ctx, p, state); err != nil {
 			return err
 		}
 
@@ -271,6 +483,42 @@ func (g *gameService) beforeSessionClosed(ctx context.Context, playerId string)
 		})
 
 		g.pubsub.RemoveFromRoom(ctx, p.TableId, playerId)
+
+		if p.State == model.FREE {
+			g.lobbyTableChanged(ctx, p.TableId)
+		}
+	}
+
+	g.pubsub.RemoveFromRoom(ctx, lobbyRoom, playerId)
+
+	return nil
+}
+
+// cancelStartFor returns starting table of participant to waiting. If
+// the table has been changed meanwhile, it is reloaded, so participant
+// is handled by the state the game has actually got to.
+func (g *gameService) cancelStartFor(ctx context.Context, p *model.Participant) error {
+	if p.Table.State != model.STARTING {
+		return nil
+	}
+
+	err := g.transit(ctx, p.Table, model.WAITING)
+	if err != code.TableVersionConflict {
+		return err
+	}
+
+	table, err := g.repo.FindTable(ctx, p.TableId)
+	if err != nil {
+		return err
+	}
+
+	if table == nil {
+		return code.TableNotFound
+	}
+
+	p.Table = table
+	if table.State == model.STARTING {
+		return g.transit(ctx, table, model.WAITING)
 	}
 
 	return nil
@@ -278,11 +526,31 @@ func (g *gameService) beforeSessionClosed(ctx context.Context, playerId string)
 
 func (g *gameService) CreateTable(ctx context.Context, req *pb.CreateTableRequest) (*pb.CreateTableResponse, error) {
 	g.logger.Bg().Info("create table")
-	table, err := g.repo.CreateTable(ctx, ctx.Value("player_id").(string), req.Currency, req.Bet)
+	playerId := ctx.Value("player_id").(string)
+
+	// private tables are hidden from lobby and available for VIP players
+	if req.Private {
+		if err := g.requireEntitlement(ctx, playerId, PRIVATE_TABLES); err != nil {
+			return nil, err
+		}
+	}
+
+	variant := model.Variant(req.Variant)
+	if variant == "" {
+		variant = model.CLASSIC
+	}
+
+	if !knownVariant(variant) {
+		return nil, code.UnknownVariant
+	}
+
+	table, err := g.repo.CreateTable(ctx, playerId, req.Currency, string(variant), req.Bet, req.Private)
 	if err != nil {
 		return nil, err
 	}
 
+	g.lobbyTableCreated(ctx, table.Id)
+
 	return &pb.CreateTableResponse{
 		TableId: table.Id,
 		Bet:     table.Bet,
@@ -291,7 +559,21 @@ func (g *gameService) CreateTable(ctx context.Context, req *pb.CreateTableReques
 
 func (g *gameService) GetOpenTables(ctx context.Context, req *pb.GetOpenTablesRequest) (*pb.GetOpenTablesResponse, error) {
 	g.logger.Bg().Info("get open tables")
-	tables, err := g.repo.GetOpenTables(ctx)
+
+	filter, err := tableFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultLobbyLimit
+	}
+	if limit > maxLobbyLimit {
+		limit = maxLobbyLimit
+	}
+
+	tables, total, err := g.repo.GetOpenTables(ctx, filter, int(req.Offset), limit)
 	if err != nil {
 		return nil, err
 	}
@@ -299,14 +581,12 @@ func (g *gameService) GetOpenTables(ctx context.Context, req *pb.GetOpenTablesRe
 	ts := make([]*pb.Table, len(tables))
 
 	for i, t := range tables {
-		ts[i] = &pb.Table{
-			Id:  t.Id,
-			Bet: t.Bet,
-		}
+		ts[i] = lobbyTableInfo(t)
 	}
 
 	return &pb.GetOpenTablesResponse{
 		Tables: ts,
+		Total:  uint32(total),
 	}, nil
 }
 
@@ -316,7 +596,7 @@ func (g *gameService) BecomeParticipant(ctx context.Context, req *pb.BecomeParti
 	participant := &model.Participant{}
 	participant.Id = req.ParticipantId
 	// find table in pg
-	if err := g.repo.Select(ctx, participant, "id", "state", "table_id"); err != nil {
+	if err := g.repo.Select(ctx, participant, "id", "state", "table_id", "order"); err != nil {
 		return nil, err
 	}
 
@@ -362,10 +642,12 @@ func (g *gameService) BecomeParticipant(ctx context.Context, req *pb.BecomeParti
 	participant.State = model.BUSY
 
 	logger.Info("set player as participant", log.String("player_id", playerId), log.String("participant_id", participant.Id))
-	if err := g.repo.Update(ctx, participant, "player_id", "state"); err != nil {
+	if err := g.repo.UpdateParticipant(ctx, participant, "player_id", "state"); err != nil {
 		return nil, err
 	}
 
+	g.logSeatsTaken(ctx, table.Id, participant)
+
 	g.pubsub.Room(table.Id).Publish(ctx, pubsub.ParticipantStateChanged{
 		Event: "ParticipantStateChanged",
 		Participant: pubsub.Participant{
@@ -378,6 +660,8 @@ func (g *gameService) BecomeParticipant(ctx context.Context, req *pb.BecomeParti
 		},
 	})
 
+	g.lobbyTableChanged(ctx, table.Id)
+
 	g.logger.For(ctx).Info("Player became a participant", log.String("player_id", playerId))
 
 	return &pb.BecomeParticipantResponse{}, nil
@@ -418,15 +702,18 @@ func (g *gameService) JoinTable(ctx context.Context, req *pb.JoinTableRequest) (
 		},
 	})
 
+	// watching devices of player do not change the seat
+	controller, _ := ctx.Value("seat_controller").(bool)
+
 	for _, p := range table.Participants {
-		if p.PlayerId == playerId {
+		if p.PlayerId == playerId && controller {
 			if p.State == model.BUSY {
 				return nil, code.PlayerAlreadyJoined
 			}
 
 			p.State = model.READY
 
-			if err := g.repo.Update(ctx, p, "state"); err != nil {
+			if err := g.repo.UpdateParticipant(ctx, p, "state"); err != nil {
 				return nil, err
 			}
 
@@ -448,6 +735,7 @@ func (g *gameService) JoinTable(ctx context.Context, req *pb.JoinTableRequest) (
 
 	tableData := &pb.Table{
 		Id:           table.Id,
+		Version:      table.Version,
 		Participants: make([]*pb.Participant, 4),
 	}
 
@@ -503,7 +791,7 @@ func (g *gameService) Ready(ctx context.Context, req *pb.ReadyRequest) (*pb.Read
 	participant := &model.Participant{}
 	participant.Id = req.ParticipantId
 
-	if err := g.repo.Select(ctx, participant, "state", "table_id", "order"); err != nil {
+	if err := g.repo.Select(ctx, participant, "state", "table_id", "order", "player_id"); err != nil {
 		return nil, err
 	}
 
@@ -511,11 +799,16 @@ func (g *gameService) Ready(ctx context.Context, req *pb.ReadyRequest) (*pb.Read
 		return nil, code.ParticipantReady
 	}
 
-	participant.State = model.READY
-	if err := g.repo.Update(ctx, participant, "state"); err != nil {
+	if err := g.changeState(ctx, participant, model.READY); err != nil {
 		return nil, err
 	}
 
+	g.logTableEvent(ctx, participant.TableId, &model.TableEvent{
+		Type:     model.PLAYER_READY,
+		Order:    participant.Order,
+		PlayerId: participant.PlayerId,
+	})
+
 	g.pubsub.Room(participant.TableId).Publish(ctx, pubsub.ParticipantStateChanged{
 		Event: "ParticipantStateChanged",
 		Participant: pubsub.Participant{
@@ -533,25 +826,45 @@ func (g *gameService) Ready(ctx context.Context, req *pb.ReadyRequest) (*pb.Read
 	g.logger.For(ctx).Info(count)
 
 	if count == 4 {
-		startGameTask := rmq.NewTask(START_GAME, participant.TableId, rmq.WithDelay(time.Second))
-		g.worker.AddTask(startGameTask)
+		table := &model.Table{}
+		table.Id = participant.TableId
+		if err = g.repo.Select(ctx, table, "id", "state", "version"); err != nil {
+			return nil, err
+		}
+
+		// game is started once, by the last participant getting ready
+		if g.transit(ctx, table, model.STARTING) == nil {
+			g.worker.AddTask(rmq.NewTask(START_GAME, table.Id, rmq.WithDelay(time.Second)))
+		}
 	}
 
 	return &pb.ReadyResponse{}, nil
 }
 
+// MakeMove retries moves which lost the race for table to another update,
+// so the move is validated again against the new table signature.
 func (g *gameService) MakeMove(ctx context.Context, req *pb.MakeMoveRequest) (*pb.MakeMoveResponse, error) {
+	for attempt := 1; ; attempt++ {
+		res, err := g.makeMove(ctx, req)
+		if err != code.TableVersionConflict || attempt == moveAttempts {
+			return res, err
+		}
+
+		g.logger.For(ctx).Info("Retrying move", log.String("table", req.TableId), log.Int("attempt", attempt))
+	}
+}
+
+func (g *gameService) makeMove(ctx context.Context, req *pb.MakeMoveRequest) (*pb.MakeMoveResponse, error) {
 	playerId := ctx.Value("player_id")
 
 	table := &model.Table{}
 	table.Id = req.TableId
-	if err := g.repo.Select(ctx, table, "start_time", "end_time", "signature"); err != nil {
+	if err := g.repo.Select(ctx, table, "state", "signature", "version"); err != nil {
 		return nil, err
 	}
 
-	// table has not beed started
-	if !table.IsOpen() {
-		return nil, code.TableNotStarted
+	if err := requireState(table, model.IN_ROUND); err != nil {
+		return nil, err
 	}
 
 	dealOrder, err := g.repo.FindCurrentDealOrderForTable(ctx, req.TableId)
@@ -578,8 +891,15 @@ func (g *gameService) MakeMove(ctx context.Context, req *pb.MakeMoveRequest) (*p
 	}
 
 	table.Signature = res.Signature
+	table.Events = append(table.Events, &model.TableEvent{
+		Type:      model.MOVE,
+		Order:     participant.Order,
+		PlayerId:  participant.PlayerId,
+		Card:      req.Card,
+		Signature: table.Signature,
+	})
 
-	if err = g.repo.Update(ctx, table, "signature"); err != nil {
+	if err = g.updateTable(ctx, table, "signature"); err != nil {
 		return nil, err
 	}
 
@@ -592,8 +912,9 @@ func (g *gameService) MakeMove(ctx context.Context, req *pb.MakeMoveRequest) (*p
 	g.pubsub.Room(table.Id).Publish(ctx, &pubsub.Event{
 		Event: "PlayerMoved",
 		Payload: &pubsub.PlayerMoved{
-			Card:  req.Card,
-			Order: participant.Order,
+			Card:    req.Card,
+			Order:   participant.Order,
+			Version: table.Version,
 		},
 	})
 
@@ -615,21 +936,19 @@ func (g *gameService) startGame(ctx context.Context, task *rmq.Task) error {
 	logger := g.logger.For(ctx)
 	logger.Info("Starting new game for table", log.String("table", task.Topic))
 
-	// TODO: check if table already started
 	table := &model.Table{}
 	table.Id = task.Topic
 
-	if err := g.repo.Select(ctx, table, "start_time"); err != nil {
+	if err := g.repo.Select(ctx, table, "id", "state", "version"); err != nil {
 		return err
 	}
 
-	if table.IsOpen() {
-		return code.TableAlreadyStarted
-	}
-
 	table.StartTime = time.Now()
-	err := g.repo.Update(ctx, table, "start_time")
-	if err != nil {
+	table.Events = append(table.Events, &model.TableEvent{
+		Type: model.GAME_STARTED,
+	})
+
+	if err := g.transit(ctx, table, model.BETWEEN_ROUNDS, "start_time"); err != nil {
 		return err
 	}
 
@@ -638,7 +957,8 @@ func (g *gameService) startGame(ctx context.Context, task *rmq.Task) error {
 		Event: "GameStarted",
 		Payload: &pubsub.GameStarted{
 			Table: pubsub.Table{
-				Id: table.Id,
+				Id:      table.Id,
+				Version: table.Version,
 			},
 			StartTime: table.StartTime,
 		},
@@ -658,9 +978,12 @@ func (g *gameService) startRound(ctx context.Context, task *rmq.Task) error {
 		return err
 	}
 
-	// table has not beed started
-	if !table.IsOpen() {
-		return code.TableNotStarted
+	if table == nil {
+		return code.TableNotFound
+	}
+
+	if err = requireState(table, model.BETWEEN_ROUNDS); err != nil {
+		return err
 	}
 
 	logger.Info("Send request to game engine for new round signature")
@@ -674,8 +997,12 @@ func (g *gameService) startRound(ctx context.Context, task *rmq.Task) error {
 
 	logger.Info("Saving signature to table")
 	table.Signature = res.Signature
-	err = g.repo.Update(ctx, table, "signature")
-	if err != nil {
+	table.Events = append(table.Events, &model.TableEvent{
+		Type:      model.CARDS_DEALT,
+		Signature: table.Signature,
+	})
+
+	if err = g.transit(ctx, table, model.IN_ROUND, "signature"); err != nil {
 		return err
 	}
 
@@ -699,6 +1026,7 @@ func (g *gameService) startRound(ctx context.Context, task *rmq.Task) error {
 
 	tableData := pubsub.Table{
 		Id:           table.Id,
+		Version:      table.Version,
 		Trump:        sig.Trump,
 		ClubPlayer:   sig.ClubPlayer + 1,
 		Dealer:       sig.Dealer + 1,
@@ -756,13 +1084,12 @@ func (g *gameService) startDeal(ctx context.Context, task *rmq.Task) error {
 
 	table := &model.Table{}
 	table.Id = task.Topic
-	if err := g.repo.Select(ctx, table, "start_time", "end_time", "signature"); err != nil {
+	if err := g.repo.Select(ctx, table, "state", "signature"); err != nil {
 		return err
 	}
 
-	// table has not beed started
-	if !table.IsOpen() {
-		return code.TableNotStarted
+	if err := requireState(table, model.IN_ROUND); err != nil {
+		return err
 	}
 
 	logger.Info("Get currrent round")
@@ -793,13 +1120,12 @@ func (g *gameService) nextMove(ctx context.Context, task *rmq.Task) error {
 
 	table := &model.Table{}
 	table.Id = task.Topic
-	if err := g.repo.Select(ctx, table, "start_time", "end_time", "signature"); err != nil {
+	if err := g.repo.Select(ctx, table, "state", "signature"); err != nil {
 		return err
 	}
 
-	// table has not beed started
-	if !table.IsOpen() {
-		return code.TableNotStarted
+	if err := requireState(table, model.IN_ROUND); err != nil {
+		return err
 	}
 
 	logger.Info("Get current deal order")
@@ -853,7 +1179,12 @@ func (g *gameService) finishDeal(ctx context.Context, task *rmq.Task) error {
 
 	table := &model.Table{}
 	table.Id = task.Topic
-	if err := g.repo.Select(ctx, table, "start_time", "end_time", "signature"); err != nil {
+	if err := g.repo.Select(ctx, table, "state", "signature", "version"); err != nil {
+		return err
+	}
+
+	// table may have been finished by forfeit
+	if err := requireState(table, model.IN_ROUND); err != nil {
 		return err
 	}
 
@@ -872,11 +1203,22 @@ func (g *gameService) finishDeal(ctx context.Context, task *rmq.Task) error {
 		return err
 	}
 
+	g.dealAchievements(ctx, table)
+
+	// player who took the trick leads next one
+	g.logTableEvent(ctx, table.Id, &model.TableEvent{
+		Type:      model.TRICK_WON,
+		Order:     sig.Turn + 1,
+		Team:      team(sig.Turn + 1),
+		Signature: table.Signature,
+	})
+
 	g.pubsub.Room(table.Id).Publish(ctx, &pubsub.Event{
 		Event: "DealFinished",
 		Payload: &pubsub.DealFinished{
 			Table: pubsub.Table{
 				Id:         table.Id,
+				Version:    table.Version,
 				Turn:       sig.Turn + 1,
 				Team1Score: sig.Team1Scores,
 				Team2Score: sig.Team2Scores,
@@ -897,12 +1239,12 @@ func (g *gameService) finishDeal(ctx context.Context, task *rmq.Task) error {
 func (g *gameService) finishRound(ctx context.Context, task *rmq.Task) error {
 	table := &model.Table{}
 	table.Id = task.Topic
-	if err := g.repo.Select(ctx, table, "end_time", "start_time", "signature"); err != nil {
+	if err := g.repo.Select(ctx, table, "id", "state", "signature", "version"); err != nil {
 		return err
 	}
 
-	if !table.IsOpen() {
-		return code.TableNotStarted
+	if err := g.transit(ctx, table, model.BETWEEN_ROUNDS); err != nil {
+		return err
 	}
 
 	round, err := g.repo.FindCurrentRoundForTable(ctx, table.Id)
@@ -924,11 +1266,41 @@ func (g *gameService) finishRound(ctx context.Context, task *rmq.Task) error {
 		return err
 	}
 
+	// round signature keeps totals from the round start
+	start, err := enginesig.Parse(round.Signature)
+	if err != nil {
+		return err
+	}
+
+	winner := 0
+	switch {
+	case sig.Team1Total > start.Team1Total:
+		winner = 1
+	case sig.Team2Total > start.Team2Total:
+		winner = 2
+	}
+
+	players, err := g.repo.FindTable(ctx, table.Id)
+	if err != nil {
+		return err
+	}
+
+	g.awardExp(ctx, players, winner, g.config.Exp.RoundWin, g.config.Exp.RoundLoss)
+	g.recordRoundStats(ctx, players, round, winner)
+	g.roundAchievements(ctx, players, round, winner)
+
+	g.logTableEvent(ctx, table.Id, &model.TableEvent{
+		Type:      model.ROUND_FINISHED,
+		Team:      winner,
+		Signature: table.Signature,
+	})
+
 	g.pubsub.Room(task.Topic).Publish(ctx, &pubsub.Event{
 		Event: "RoundFinished",
 		Payload: &pubsub.RoundFinished{
 			Table: pubsub.Table{
 				Id:         table.Id,
+				Version:    table.Version,
 				Team1Total: sig.Team1Total,
 				Team2Total: sig.Team2Total,
 			},
@@ -946,30 +1318,57 @@ func (g *gameService) finishRound(ctx context.Context, task *rmq.Task) error {
 }
 
 func (g *gameService) finishGame(ctx context.Context, task *rmq.Task) error {
-	table := &model.Table{}
-	table.Id = task.Topic
-	if err := g.repo.Select(ctx, table, "end_time"); err != nil {
+	table, err := g.repo.FindTable(ctx, task.Topic)
+	if err != nil {
 		return err
 	}
 
-	if !table.EndTime.IsZero() {
-		return code.TableClosed
+	if table == nil {
+		return code.TableNotFound
+	}
+
+	sig, err := enginesig.Parse(table.Signature)
+	if err != nil {
+		return err
+	}
+
+	winner := 1
+	if sig.Team2Total > sig.Team1Total {
+		winner = 2
+	}
+
+	// team of player who left loses regardless of score
+	if table.Result == model.FORFEIT {
+		if forfeited := forfeitingTeam(table.Participants); forfeited != 0 {
+			winner = 3 - forfeited
+		}
 	}
 
 	// close table
 	table.EndTime = time.Now()
-	if err := g.repo.Update(ctx, table, "end_time"); err != nil {
+	table.Events = append(table.Events, payoutEvents(table, winner)...)
+	if err := g.transit(ctx, table, model.GAME_FINISHED, "end_time"); err != nil {
 		return err
 	}
 
+	g.awardExp(ctx, table, winner, g.config.Exp.GameWin, g.config.Exp.GameLoss)
+	g.recordGameResult(ctx, table, winner)
+	g.recordGameStats(ctx, table, winner)
+	g.gameAchievements(ctx, table, winner)
+
 	g.pubsub.Room(table.Id).Publish(ctx, &pubsub.Event{
 		Event: "GameFinished",
 		Payload: &pubsub.GameFinished{
 			EndTime: table.EndTime,
+			Version: table.Version,
 		},
 	})
 
-	return nil
+	if table.TournamentId != "" {
+		return g.tournamentTableFinished(ctx, table, winner)
+	}
+
+	return g.offerRematch(ctx, table)
 }
 
 func copyParticipants(participants []pubsub.Participant) []pubsub.Participant {
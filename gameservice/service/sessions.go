@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Handzo/gogame/common/log"
+	"github.com/Handzo/gogame/gameservice/code"
+	pb "github.com/Handzo/gogame/gameservice/proto"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/Handzo/gogame/gameservice/service/pubsub"
+)
+
+func (g *gameService) ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	sessions, err := g.repo.GetOpenSessions(ctx, ctx.Value("player_id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	current := ctx.Value("session_id").(string)
+
+	infos := make([]*pb.Session, len(sessions))
+	for i, s := range sessions {
+		infos[i] = &pb.Session{
+			Id:         s.Id,
+			Device:     s.Device,
+			CreatedAt:  s.CreatedAt.Unix(),
+			Controller: s.Controller,
+			Current:    s.Id == current,
+		}
+	}
+
+	return &pb.ListSessionsResponse{
+		Sessions: infos,
+	}, nil
+}
+
+// TerminateSession closes session of another device of the player.
+func (g *gameService) TerminateSession(ctx context.Context, req *pb.TerminateSessionRequest) (*pb.TerminateSessionResponse, error) {
+	playerId := ctx.Value("player_id").(string)
+
+	sessions, err := g.repo.GetOpenSessions(ctx, playerId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range sessions {
+		if s.Id != req.SessionId {
+			continue
+		}
+
+		if err = g.closeSession(ctx, s); err != nil {
+			return nil, err
+		}
+
+		g.logger.For(ctx).Info("Session terminated", log.String("player_id", playerId), log.String("session", s.Id))
+
+		return &pb.TerminateSessionResponse{}, nil
+	}
+
+	return nil, code.SessionNotFound
+}
+
+// TakeSeatControl makes current device the one taking seat actions.
+func (g *gameService) TakeSeatControl(ctx context.Context, req *pb.TakeSeatControlRequest) (*pb.TakeSeatControlResponse, error) {
+	sessions, err := g.repo.GetOpenSessions(ctx, ctx.Value("player_id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	current := ctx.Value("session_id").(string)
+	for _, s := range sessions {
+		if s.Id == current {
+			if err = g.passSeatControl(ctx, s); err != nil {
+				return nil, err
+			}
+
+			return &pb.TakeSeatControlResponse{}, nil
+		}
+	}
+
+	return nil, code.SessionNotFound
+}
+
+// passSeatControl makes session the seat controller and notifies
+// every device of the player.
+func (g *gameService) passSeatControl(ctx context.Context, session *model.Session) error {
+	set, err := g.repo.SetSeatController(ctx, session.PlayerId, session.Id)
+	if err != nil {
+		return err
+	}
+
+	if !set {
+		return code.SessionNotFound
+	}
+
+	session.Controller = true
+
+	g.pubsub.ToPlayer(ctx, session.PlayerId, &pubsub.Event{
+		Event: "SeatControlChanged",
+		Payload: &pubsub.SeatControlChanged{
+			SessionId: session.Id,
+			Device:    session.Device,
+		},
+	})
+
+	return nil
+}
+
+// replacedSessions returns open sessions new session takes over.
+// Sessions of other devices are kept in multi device mode and new
+// session controls the seat unless one of them does.
+func replacedSessions(open []*model.Session, session *model.Session, multiDevice bool) []*model.Session {
+	replaced := []*model.Session{}
+	controlled := false
+	for _, s := range open {
+		if !multiDevice || s.Remote == session.Remote {
+			replaced = append(replaced, s)
+		} else if s.Controller {
+			controlled = true
+		}
+	}
+
+	session.Controller = !controlled
+	return replaced
+}
+
+// takeOver moves connection of replaced session to the new one, so
+// player stays at the table.
+func (g *gameService) takeOver(ctx context.Context, replaced, session *model.Session) {
+	if replaced.Remote != session.Remote {
+		g.pubsub.Unbind(ctx, replaced.PlayerId, replaced.Remote)
+		g.pubsub.Publish(ctx, replaced.Remote, &pubsub.Event{
+			Event: "SessionTakenOver",
+			Payload: &pubsub.SessionTakenOver{
+				SessionId:    replaced.Id,
+				NewSessionId: session.Id,
+				Device:       session.Device,
+			},
+		})
+	}
+
+	g.logger.For(ctx).Info("Session taken over",
+		log.String("player_id", session.PlayerId),
+		log.String("session", replaced.Id),
+		log.String("new_session", session.Id),
+	)
+}
+
+// resendPendingMove sends WaitForMove to session's connection if it is
+// player's turn to move.
+func (g *gameService) resendPendingMove(ctx context.Context, session *model.Session) {
+	table, err := g.repo.FindTableWithPlayer(ctx, session.PlayerId)
+	if err != nil || table == nil || table.State != model.IN_ROUND {
+		return
+	}
+
+	dealOrder, err := g.repo.FindCurrentDealOrderForTable(ctx, table.Id)
+	if err != nil {
+		return
+	}
+
+	participant := &model.Participant{}
+	participant.Id = dealOrder.ParticipantId
+	if err = g.repo.Select(ctx, participant, "id", "order", "player_id"); err != nil {
+		return
+	}
+
+	if participant.PlayerId != session.PlayerId {
+		return
+	}
+
+	g.pubsub.Publish(ctx, session.Remote, &pubsub.Event{
+		Event: "WaitForMove",
+		Payload: &pubsub.WaitForMove{
+			TableId: table.Id,
+			Participant: pubsub.Participant{
+				Id:    participant.Id,
+				Order: participant.Order,
+			},
+		},
+	})
+}
+
+func hasController(sessions []*model.Session) bool {
+	for _, s := range sessions {
+		if s.Controller {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+)
+
+func TestReplacedSessions(t *testing.T) {
+	open := func() []*model.Session {
+		return []*model.Session{
+			{Remote: "phone", Controller: true},
+			{Remote: "laptop"},
+			{Remote: "tablet"},
+		}
+	}
+
+	cases := []struct {
+		name        string
+		remote      string
+		multiDevice bool
+		replaced    int
+		controller  bool
+	}{
+		{"single device replaces every session", "tablet", false, 3, true},
+		{"reconnect replaces session of the same remote", "laptop", true, 1, false},
+		{"reconnect of controller keeps control", "phone", true, 1, true},
+		{"new device watches", "tv", true, 0, false},
+	}
+
+	for _, c := range cases {
+		session := &model.Session{Remote: c.remote}
+		replaced := replacedSessions(open(), session, c.multiDevice)
+
+		if len(replaced) != c.replaced || session.Controller != c.controller {
+			t.Errorf("%s: got %d replaced and controller %v, want %d and %v",
+				c.name, len(replaced), session.Controller, c.replaced, c.controller)
+		}
+	}
+
+	// the only device takes control
+	session := &model.Session{Remote: "phone"}
+	if replaced := replacedSessions(nil, session, true); len(replaced) != 0 || !session.Controller {
+		t.Error("first session should control the seat")
+	}
+}
@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Handzo/gogame/gameservice/code"
+	pb "github.com/Handzo/gogame/gameservice/proto"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/Handzo/gogame/gameservice/service/pubsub"
+)
+
+func (g *gameService) GetFriends(ctx context.Context, req *pb.GetFriendsRequest) (*pb.GetFriendsResponse, error) {
+	playerId := ctx.Value("player_id").(string)
+
+	friendships, err := g.repo.GetFriendships(ctx, playerId)
+	if err != nil {
+		return nil, err
+	}
+
+	friends := make([]*pb.Friend, len(friendships))
+	for i, f := range friendships {
+		friends[i] = g.friendInfo(ctx, playerId, f)
+	}
+
+	return &pb.GetFriendsResponse{
+		Friends: friends,
+	}, nil
+}
+
+func (g *gameService) AddFriend(ctx context.Context, req *pb.AddFriendRequest) (*pb.AddFriendResponse, error) {
+	player, friend, err := g.findPair(ctx, req.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if player.IsMuted() {
+		return nil, code.PlayerMuted
+	}
+
+	friendship, err := g.repo.RequestFriendship(ctx, player.Id, friend.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// mutual requests make players friends
+	event := "FriendRequest"
+	var payload interface{} = &pubsub.FriendRequest{
+		Player: pubsub.Player{Id: player.Id, Nickname: player.Nickname},
+	}
+
+	if friendship.State == model.ACCEPTED {
+		event = "FriendAccepted"
+		payload = &pubsub.FriendAccepted{
+			Player: pubsub.Player{Id: player.Id, Nickname: player.Nickname},
+		}
+	}
+
+	g.notify(ctx, friend.Id, &pubsub.Event{
+		Event:   event,
+		Payload: payload,
+	})
+
+	friendship.Friend = friend
+
+	return &pb.AddFriendResponse{
+		Friend: g.friendInfo(ctx, player.Id, friendship),
+	}, nil
+}
+
+func (g *gameService) AcceptFriend(ctx context.Context, req *pb.AcceptFriendRequest) (*pb.AcceptFriendResponse, error) {
+	player, friend, err := g.findPair(ctx, req.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	friendship, err := g.repo.AcceptFriendship(ctx, player.Id, friend.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	g.notify(ctx, friend.Id, &pubsub.Event{
+		Event: "FriendAccepted",
+		Payload: &pubsub.FriendAccepted{
+			Player: pubsub.Player{Id: player.Id, Nickname: player.Nickname},
+		},
+	})
+
+	friendship.Friend = friend
+
+	return &pb.AcceptFriendResponse{
+		Friend: g.friendInfo(ctx, player.Id, friendship),
+	}, nil
+}
+
+func (g *gameService) RemoveFriend(ctx context.Context, req *pb.RemoveFriendRequest) (*pb.RemoveFriendResponse, error) {
+	player, friend, err := g.findPair(ctx, req.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = g.repo.RemoveFriendship(ctx, player.Id, friend.Id); err != nil {
+		return nil, err
+	}
+
+	return &pb.RemoveFriendResponse{}, nil
+}
+
+func (g *gameService) BlockPlayer(ctx context.Context, req *pb.BlockPlayerRequest) (*pb.BlockPlayerResponse, error) {
+	player, friend, err := g.findPair(ctx, req.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = g.repo.BlockPlayer(ctx, player.Id, friend.Id); err != nil {
+		return nil, err
+	}
+
+	return &pb.BlockPlayerResponse{}, nil
+}
+
+func (g *gameService) InviteToTable(ctx context.Context, req *pb.InviteToTableRequest) (*pb.InviteToTableResponse, error) {
+	player, friend, err := g.findPair(ctx, req.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if player.IsMuted() {
+		return nil, code.PlayerMuted
+	}
+
+	friendship, err := g.repo.FindFriendship(ctx, player.Id, friend.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if friendship == nil || friendship.State != model.ACCEPTED {
+		return nil, code.NotFriends
+	}
+
+	table, err := g.repo.FindTable(ctx, req.TableId)
+	if err != nil {
+		return nil, err
+	}
+
+	if table == nil {
+		return nil, code.TableNotFound
+	}
+
+	if table.IsClosed() {
+		return nil, code.TableClosed
+	}
+
+	if !table.HasEmptyPlaces() {
+		return nil, code.NoEmptyPlaces
+	}
+
+	invitation := &model.Invitation{
+		TableId:   table.Id,
+		FromId:    player.Id,
+		ToId:      friend.Id,
+		ExpiresAt: time.Now().Add(g.config.InvitationTTL),
+	}
+
+	if err = g.repo.Insert(ctx, invitation); err != nil {
+		return nil, err
+	}
+
+	g.notify(ctx, friend.Id, &pubsub.Event{
+		Event: "TableInvitation",
+		Payload: &pubsub.TableInvitation{
+			InvitationId: invitation.Id,
+			TableId:      table.Id,
+			Currency:     string(table.Currency),
+			Bet:          table.Bet,
+			From:         pubsub.Player{Id: player.Id, Nickname: player.Nickname},
+			ExpiresAt:    invitation.ExpiresAt.Unix(),
+		},
+	})
+
+	return &pb.InviteToTableResponse{
+		InvitationId: invitation.Id,
+		ExpiresAt:    invitation.ExpiresAt.Unix(),
+	}, nil
+}
+
+func (g *gameService) AcceptInvitation(ctx context.Context, req *pb.AcceptInvitationRequest) (*pb.AcceptInvitationResponse, error) {
+	playerId := ctx.Value("player_id").(string)
+
+	invitation, err := g.repo.AcceptInvitation(ctx, req.InvitationId, playerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if invitation == nil {
+		return nil, code.InvitationNotFound
+	}
+
+	res, err := g.JoinTable(ctx, &pb.JoinTableRequest{TableId: invitation.TableId})
+	if err != nil {
+		return nil, err
+	}
+
+	player := &model.Player{}
+	player.Id = playerId
+
+	if err = g.repo.Select(ctx, player, "id", "nickname"); err != nil {
+		return nil, err
+	}
+
+	g.notify(ctx, invitation.FromId, &pubsub.Event{
+		Event: "InvitationAccepted",
+		Payload: &pubsub.InvitationAccepted{
+			InvitationId: invitation.Id,
+			TableId:      invitation.TableId,
+			Player:       pubsub.Player{Id: player.Id, Nickname: player.Nickname},
+		},
+	})
+
+	return &pb.AcceptInvitationResponse{
+		Table: res.Table,
+	}, nil
+}
+
+// notifyFriends pushes presence event (FriendOnline or FriendOffline)
+// to player's online friends.
+func (g *gameService) notifyFriends(ctx context.Context, player *model.Player, event string) {
+	ids, err := g.repo.GetFriendIds(ctx, player.Id)
+	if err != nil {
+		return
+	}
+
+	for _, id := range ids {
+		g.pubsub.ToPlayer(ctx, id, &pubsub.Event{
+			Event: event,
+			Payload: &pubsub.FriendPresence{
+				PlayerId: player.Id,
+				Nickname: player.Nickname,
+			},
+		})
+	}
+}
+
+// findPair selects current player and the player with otherId.
+// Restrictions of current player are selected along.
+func (g *gameService) findPair(ctx context.Context, otherId string) (*model.Player, *model.Player, error) {
+	playerId := ctx.Value("player_id").(string)
+	if otherId == playerId {
+		return nil, nil, code.CannotBefriendSelf
+	}
+
+	if otherId == "" {
+		return nil, nil, code.PlayerNotFound
+	}
+
+	other, err := g.repo.FindPlayer(ctx, otherId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if other == nil {
+		return nil, nil, code.PlayerNotFound
+	}
+
+	player := &model.Player{}
+	player.Id = playerId
+
+	if err = g.repo.Select(ctx, player, "id", "nickname", "muted_until"); err != nil {
+		return nil, nil, err
+	}
+
+	return player, other, nil
+}
+
+// friendInfo converts relation to the view of given player. Presence
+// is shown for accepted friends only.
+func (g *gameService) friendInfo(ctx context.Context, playerId string, f *model.Friendship) *pb.Friend {
+	var other *model.Player
+	var state string
+
+	if f.PlayerId == playerId {
+		other = f.Friend
+		switch f.State {
+		case model.ACCEPTED:
+			state = "friend"
+		case model.REQUESTED:
+			state = "outgoing"
+		case model.BLOCKED:
+			state = "blocked"
+		}
+	} else {
+		other = f.Player
+		state = "incoming"
+	}
+
+	friend := &pb.Friend{
+		State: state,
+	}
+
+	if other == nil {
+		return friend
+	}
+
+	friend.PlayerId = other.Id
+	friend.Nickname = other.Nickname
+	friend.Avatar = other.Avatar
+
+	if f.State == model.ACCEPTED {
+		friend.Online = g.isOnline(ctx, other)
+	}
+
+	return friend
+}
+
+// isOnline checks player has open session and is bound to pubsub.
+// Player must be selected with open sessions.
+func (g *gameService) isOnline(ctx context.Context, player *model.Player) bool {
+	return len(player.Sessions) != 0 && g.pubsub.IsBound(ctx, player.Id)
+}
@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	enginesig "github.com/Handzo/gogame/gameengine/service"
+	"github.com/Handzo/gogame/gameengine/service/deck"
+	"github.com/Handzo/gogame/gameservice/code"
+	pb "github.com/Handzo/gogame/gameservice/proto"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+)
+
+const (
+	defaultHistoryLimit = 20
+	maxHistoryLimit     = 100
+)
+
+var clubJack = func() string {
+	card := deck.NewCard(deck.JACK, deck.CLUB)
+	return card.GetSignature()
+}()
+
+// trumpSuits maps signature trump to suit name.
+var trumpSuits = map[string]string{
+	suitSignature(deck.CLUB):    "club",
+	suitSignature(deck.SPADE):   "spade",
+	suitSignature(deck.HEART):   "heart",
+	suitSignature(deck.DIAMOND): "diamond",
+}
+
+func suitSignature(s deck.Suit) string {
+	return fmt.Sprintf("%x", int(s))
+}
+
+// cardPoints sums points of cards in signature.
+func cardPoints(cards string) int {
+	points := 0
+	for _, c := range deck.New(deck.Unshuffled, deck.FromSignature(cards)).Cards {
+		switch c.Face() {
+		case deck.ACE:
+			points += 11
+		case deck.TEN:
+			points += 10
+		case deck.KING:
+			points += 4
+		case deck.QUEEN:
+			points += 3
+		case deck.JACK:
+			points += 2
+		}
+	}
+	return points
+}
+
+// clubJackOrder returns order of participant whose hand holds club
+// jack, 0 if no hand has it.
+func clubJackOrder(hands []string) int {
+	for i, hand := range hands {
+		for j := 0; j+1 < len(hand); j += 2 {
+			if hand[j:j+2] == clubJack {
+				return i + 1
+			}
+		}
+	}
+	return 0
+}
+
+// roundStats returns aggregates increment of participant for finished round.
+func roundStats(round *model.Round, p *model.Participant) *model.PlayerStats {
+	stats := &model.PlayerStats{
+		PlayerId:     p.PlayerId,
+		RoundsPlayed: 1,
+		CardPoints:   int64(round.Team1Points),
+	}
+
+	if team(p.Order) == 2 {
+		stats.CardPoints = int64(round.Team2Points)
+	}
+
+	if team(p.Order) == round.Winner {
+		stats.RoundsWon = 1
+	}
+
+	if round.ClubJackOrder == p.Order {
+		stats.ClubJacks = 1
+	}
+
+	switch trumpSuits[round.Trump] {
+	case "club":
+		stats.TrumpClubs = 1
+	case "spade":
+		stats.TrumpSpades = 1
+	case "heart":
+		stats.TrumpHearts = 1
+	case "diamond":
+		stats.TrumpDiamonds = 1
+	}
+
+	return stats
+}
+
+// recordRoundStats stores round result and updates players' aggregates.
+// Round keeps signature from its start, table the finished one.
+func (g *gameService) recordRoundStats(ctx context.Context, table *model.Table, round *model.Round, winner int) {
+	logger := g.logger.For(ctx)
+
+	start, err := enginesig.Parse(round.Signature)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+
+	finish, err := enginesig.Parse(table.Signature)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+
+	round.Trump = start.Trump
+	round.ClubJackOrder = clubJackOrder(start.PlayerCards)
+	round.Team1Points = cardPoints(finish.Team1Cards)
+	round.Team2Points = cardPoints(finish.Team2Cards)
+	round.Winner = winner
+
+	if err = g.repo.Update(ctx, round, "trump", "club_jack_order", "team1_points", "team2_points", "winner"); err != nil {
+		return
+	}
+
+	stats := make([]*model.PlayerStats, 0, len(table.Participants))
+	for _, p := range table.Participants {
+		if p.PlayerId != "" {
+			stats = append(stats, roundStats(round, p))
+		}
+	}
+
+	g.repo.IncrementPlayerStats(ctx, stats...)
+}
+
+// recordGameStats updates games counters of table players.
+func (g *gameService) recordGameStats(ctx context.Context, table *model.Table, winner int) {
+	stats := make([]*model.PlayerStats, 0, len(table.Participants))
+	for _, p := range table.Participants {
+		if p.PlayerId == "" {
+			continue
+		}
+
+		s := &model.PlayerStats{
+			PlayerId:    p.PlayerId,
+			GamesPlayed: 1,
+		}
+		if team(p.Order) == winner {
+			s.GamesWon = 1
+		}
+		stats = append(stats, s)
+	}
+
+	g.repo.IncrementPlayerStats(ctx, stats...)
+}
+
+func (g *gameService) GetMatchHistory(ctx context.Context, req *pb.GetMatchHistoryRequest) (*pb.GetMatchHistoryResponse, error) {
+	playerId := ctx.Value("player_id").(string)
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultHistoryLimit
+	}
+	if limit > maxHistoryLimit {
+		limit = maxHistoryLimit
+	}
+
+	tables, total, err := g.repo.GetMatchHistory(ctx, playerId, int(req.Offset), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]*pb.Match, 0, len(tables))
+	for _, t := range tables {
+		matches = append(matches, matchInfo(t, playerId))
+	}
+
+	return &pb.GetMatchHistoryResponse{
+		Matches: matches,
+		Total:   uint32(total),
+	}, nil
+}
+
+func (g *gameService) GetPlayerStats(ctx context.Context, req *pb.GetPlayerStatsRequest) (*pb.GetPlayerStatsResponse, error) {
+	playerId := req.PlayerId
+	if playerId == "" {
+		playerId = ctx.Value("player_id").(string)
+	} else {
+		player, err := g.repo.FindPlayer(ctx, playerId)
+		if err != nil {
+			return nil, err
+		}
+
+		if player == nil {
+			return nil, code.PlayerNotFound
+		}
+	}
+
+	stats, err := g.repo.FindPlayerStats(ctx, playerId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.PlayerStats{
+		GamesPlayed:  uint32(stats.GamesPlayed),
+		GamesWon:     uint32(stats.GamesWon),
+		RoundsPlayed: uint32(stats.RoundsPlayed),
+		RoundsWon:    uint32(stats.RoundsWon),
+		Trumps: map[string]uint32{
+			"club":    uint32(stats.TrumpClubs),
+			"spade":   uint32(stats.TrumpSpades),
+			"heart":   uint32(stats.TrumpHearts),
+			"diamond": uint32(stats.TrumpDiamonds),
+		},
+	}
+
+	if stats.RoundsPlayed != 0 {
+		rounds := float64(stats.RoundsPlayed)
+		res.RoundWinRate = float64(stats.RoundsWon) / rounds
+		res.AvgCardPoints = float64(stats.CardPoints) / rounds
+		res.ClubJackRate = float64(stats.ClubJacks) / rounds
+	}
+
+	return &pb.GetPlayerStatsResponse{
+		Stats: res,
+	}, nil
+}
+
+// matchInfo converts finished table to the view of given player.
+func matchInfo(table *model.Table, playerId string) *pb.Match {
+	match := &pb.Match{
+		TableId:   table.Id,
+		StartTime: table.StartTime.Unix(),
+		EndTime:   table.EndTime.Unix(),
+		Currency:  string(table.Currency),
+		Bet:       table.Bet,
+		Result:    "lost",
+	}
+
+	var own *model.Participant
+	for _, p := range table.Participants {
+		if p.PlayerId == playerId {
+			own = p
+		}
+	}
+
+	if own == nil {
+		return match
+	}
+
+	if own.Won {
+		match.Result = "won"
+	}
+	match.RatingChange = int32(own.RatingChange)
+
+	if sig, err := enginesig.Parse(table.Signature); err == nil {
+		match.TeamTotal, match.OpponentsTotal = uint32(sig.Team1Total), uint32(sig.Team2Total)
+		if team(own.Order) == 2 {
+			match.TeamTotal, match.OpponentsTotal = match.OpponentsTotal, match.TeamTotal
+		}
+	}
+
+	for _, p := range table.Participants {
+		if p == own || p.Player == nil {
+			continue
+		}
+
+		player := &pb.Player{
+			Id:       p.Player.Id,
+			Nickname: p.Player.Nickname,
+			Avatar:   p.Player.Avatar,
+		}
+
+		if team(p.Order) == team(own.Order) {
+			match.Partner = player
+		} else {
+			match.Opponents = append(match.Opponents, player)
+		}
+	}
+
+	return match
+}
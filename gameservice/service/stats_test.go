@@ -0,0 +1,49 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+)
+
+func TestCardPoints(t *testing.T) {
+	// ace, ten, king, queen, jack and seven of clubs
+	if points := cardPoints("c080b0a09050"); points != 30 {
+		t.Fatalf("expected 30 points, got %d", points)
+	}
+
+	if points := cardPoints(""); points != 0 {
+		t.Fatalf("expected no points, got %d", points)
+	}
+}
+
+func TestClubJackOrder(t *testing.T) {
+	hands := []string{"c0", "8190", "", "a1"}
+	if order := clubJackOrder(hands); order != 2 {
+		t.Fatalf("expected order 2, got %d", order)
+	}
+
+	if order := clubJackOrder([]string{"c0", "a1"}); order != 0 {
+		t.Fatalf("expected no holder, got %d", order)
+	}
+}
+
+func TestRoundStats(t *testing.T) {
+	round := &model.Round{
+		Trump:         "2",
+		ClubJackOrder: 3,
+		Team1Points:   80,
+		Team2Points:   40,
+		Winner:        1,
+	}
+
+	stats := roundStats(round, &model.Participant{PlayerId: "p", Order: 3})
+	if stats.RoundsWon != 1 || stats.CardPoints != 80 || stats.ClubJacks != 1 || stats.TrumpHearts != 1 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+
+	stats = roundStats(round, &model.Participant{PlayerId: "p", Order: 2})
+	if stats.RoundsWon != 0 || stats.CardPoints != 40 || stats.ClubJacks != 0 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Handzo/gogame/gameservice/code"
+	pb "github.com/Handzo/gogame/gameservice/proto"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+)
+
+// GetTableEvents returns log of closed table. Logs of running tables are
+// hidden since dealt signatures reveal cards of every player.
+func (g *gameService) GetTableEvents(ctx context.Context, req *pb.GetTableEventsRequest) (*pb.GetTableEventsResponse, error) {
+	table, err := g.repo.FindTable(ctx, req.TableId)
+	if err != nil {
+		return nil, err
+	}
+
+	if table == nil {
+		return nil, code.TableNotFound
+	}
+
+	if !table.IsClosed() {
+		return nil, code.TableNotClosed
+	}
+
+	events, err := g.repo.GetTableEvents(ctx, table.Id, int(req.AfterSeq))
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*pb.TableEvent, len(events))
+	for i, e := range events {
+		infos[i] = &pb.TableEvent{
+			Seq:       uint32(e.Seq),
+			Type:      string(e.Type),
+			Order:     uint32(e.Order),
+			PlayerId:  e.PlayerId,
+			Card:      e.Card,
+			Team:      uint32(e.Team),
+			Amount:    e.Amount,
+			Signature: e.Signature,
+			CreatedAt: e.CreatedAt.Unix(),
+		}
+	}
+
+	return &pb.GetTableEventsResponse{
+		Events: infos,
+	}, nil
+}
+
+// logTableEvent appends event to the log of table. Failures are logged
+// by repository and do not stop the game. Events of moves, deals and
+// payouts are appended with table update instead, see model.Table.Events.
+func (g *gameService) logTableEvent(ctx context.Context, tableId string, event *model.TableEvent) {
+	event.TableId = tableId
+	g.repo.AppendTableEvent(ctx, event)
+}
+
+// logSeatsTaken appends seats taken by players to the log of table.
+func (g *gameService) logSeatsTaken(ctx context.Context, tableId string, seats ...*model.Participant) {
+	for _, p := range seats {
+		if p.PlayerId == "" {
+			continue
+		}
+
+		g.logTableEvent(ctx, tableId, &model.TableEvent{
+			Type:     model.SEAT_TAKEN,
+			Order:    p.Order,
+			PlayerId: p.PlayerId,
+		})
+	}
+}
+
+// payoutEvents returns net payout of every player of closed table.
+// Tables closed without winner pay nothing.
+func payoutEvents(table *model.Table, winner int) []*model.TableEvent {
+	events := []*model.TableEvent{}
+	for _, p := range table.Participants {
+		if p.PlayerId == "" {
+			continue
+		}
+
+		var amount int64
+		switch {
+		case winner == 0:
+		case team(p.Order) == winner:
+			amount = int64(table.Bet)
+		default:
+			amount = -int64(table.Bet)
+		}
+
+		events = append(events, &model.TableEvent{
+			Type:      model.PAYOUT,
+			Order:     p.Order,
+			PlayerId:  p.PlayerId,
+			Team:      winner,
+			Amount:    amount,
+			Signature: table.Signature,
+		})
+	}
+
+	return events
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+)
+
+func TestPayoutEvents(t *testing.T) {
+	table := &model.Table{Bet: 50, Participants: seats(model.READY, model.READY, model.FREE, model.READY)}
+	for _, p := range table.Participants {
+		if p.State != model.FREE {
+			p.PlayerId = "p" + string(rune('0'+p.Order))
+		}
+	}
+
+	events := payoutEvents(table, 2)
+	if len(events) != 3 {
+		t.Fatalf("expected payouts of seated players only, got %d", len(events))
+	}
+
+	for _, e := range events {
+		want := int64(-50)
+		if team(e.Order) == 2 {
+			want = 50
+		}
+
+		if e.Type != model.PAYOUT || e.Amount != want || e.Team != 2 {
+			t.Errorf("seat %d: unexpected payout %+v", e.Order, e)
+		}
+	}
+
+	for _, e := range payoutEvents(table, 0) {
+		if e.Amount != 0 {
+			t.Errorf("seat %d: expected nothing paid without winner, got %d", e.Order, e.Amount)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Handzo/gogame/common/log"
+	"github.com/Handzo/gogame/gameservice/code"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+)
+
+// moveAttempts limits how many times a move conflicting with another
+// table update is tried.
+const moveAttempts = 3
+
+// transit moves table to another state saving given columns along. Moves
+// not allowed by table state machine fail with code.InvalidTableState,
+// and ones racing with another table update with code.TableVersionConflict.
+func (g *gameService) transit(ctx context.Context, table *model.Table, state model.TableState, columns ...string) error {
+	from := table.State
+	if !from.CanBecome(state) {
+		return code.InvalidTableState
+	}
+
+	moved, err := g.repo.TransitTable(ctx, table, state, columns...)
+	if err != nil {
+		return err
+	}
+
+	if !moved {
+		return code.TableVersionConflict
+	}
+
+	g.logger.For(ctx).Info("Table state changed",
+		log.String("table", table.Id),
+		log.String("from", string(from)),
+		log.String("to", string(state)),
+	)
+
+	// started and closed tables leave the lobby
+	if waiting(from) && !waiting(state) {
+		g.lobbyTableChanged(ctx, table.Id)
+	}
+
+	return nil
+}
+
+func waiting(s model.TableState) bool {
+	return s == model.WAITING || s == model.STARTING
+}
+
+// updateTable saves given columns of table unless it has been changed
+// since it was loaded.
+func (g *gameService) updateTable(ctx context.Context, table *model.Table, columns ...string) error {
+	updated, err := g.repo.UpdateTable(ctx, table, columns...)
+	if err != nil {
+		return err
+	}
+
+	if !updated {
+		return code.TableVersionConflict
+	}
+
+	return nil
+}
+
+// requireState checks table is in one of given states.
+func requireState(table *model.Table, states ...model.TableState) error {
+	for _, s := range states {
+		if table.State == s {
+			return nil
+		}
+	}
+
+	return code.InvalidTableState
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+)
+
+func TestTableTransitions(t *testing.T) {
+	cases := []struct {
+		from, to model.TableState
+		want     bool
+	}{
+		{model.WAITING, model.STARTING, true},
+		{model.STARTING, model.WAITING, true},
+		{model.STARTING, model.BETWEEN_ROUNDS, true},
+		{model.BETWEEN_ROUNDS, model.IN_ROUND, true},
+		{model.IN_ROUND, model.BETWEEN_ROUNDS, true},
+		{model.IN_ROUND, model.GAME_FINISHED, true},
+		{model.WAITING, model.IN_ROUND, false},
+		{model.WAITING, model.GAME_FINISHED, false},
+		{model.IN_ROUND, model.IN_ROUND, false},
+		{model.GAME_FINISHED, model.WAITING, false},
+		{model.ABANDONED, model.WAITING, false},
+	}
+
+	for _, c := range cases {
+		if got := c.from.CanBecome(c.to); got != c.want {
+			t.Errorf("%s -> %s: got %v, want %v", c.from, c.to, got, c.want)
+		}
+	}
+}
+
+func TestRequireState(t *testing.T) {
+	table := &model.Table{State: model.IN_ROUND}
+
+	if err := requireState(table, model.IN_ROUND); err != nil {
+		t.Errorf("in round table: got %v", err)
+	}
+
+	if err := requireState(table, model.WAITING, model.STARTING); err == nil {
+		t.Error("in round table should not pass waiting check")
+	}
+}
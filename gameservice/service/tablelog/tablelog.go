@@ -0,0 +1,114 @@
+// Package tablelog rebuilds table state from its event log.
+package tablelog
+
+import (
+	"errors"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+)
+
+var (
+	ErrSequenceGap  = errors.New("table log has a gap in sequence")
+	ErrInvalidOrder = errors.New("table event has invalid order")
+	ErrInvalidTeam  = errors.New("table event has invalid team")
+	ErrUnknownEvent = errors.New("unknown table event type")
+)
+
+// Move is a card played at the table.
+type Move struct {
+	Round int
+	Order int
+	Card  string
+}
+
+// State is the table rebuilt from the log. Seats and flags are indexed
+// by participant order minus one, team counters by team number.
+type State struct {
+	Seq       int
+	Seats     [4]string
+	Ready     [4]bool
+	Started   bool
+	Finished  bool
+	Round     int
+	Moves     []Move
+	Tricks    [3]int
+	Rounds    [3]int
+	Winner    int
+	Payouts   map[string]int64
+	Signature string
+}
+
+// Replay applies events in order to empty table.
+func Replay(events []*model.TableEvent) (*State, error) {
+	s := &State{
+		Payouts: map[string]int64{},
+	}
+
+	for _, e := range events {
+		if err := s.Apply(e); err != nil {
+			return s, err
+		}
+	}
+
+	return s, nil
+}
+
+// Apply advances state by the next event of the log.
+func (s *State) Apply(e *model.TableEvent) error {
+	if e.Seq != s.Seq+1 {
+		return ErrSequenceGap
+	}
+
+	if e.Order < 0 || e.Order > 4 || e.Order == 0 && needsOrder(e.Type) {
+		return ErrInvalidOrder
+	}
+
+	if e.Team < 0 || e.Team > 2 {
+		return ErrInvalidTeam
+	}
+
+	switch e.Type {
+	case model.SEAT_TAKEN:
+		s.Seats[e.Order-1] = e.PlayerId
+	case model.SEAT_LEFT:
+		s.Seats[e.Order-1] = ""
+		s.Ready[e.Order-1] = false
+	case model.PLAYER_READY:
+		s.Ready[e.Order-1] = true
+	case model.GAME_STARTED:
+		s.Started = true
+	case model.CARDS_DEALT:
+		s.Round++
+		s.Tricks = [3]int{}
+	case model.MOVE:
+		s.Moves = append(s.Moves, Move{Round: s.Round, Order: e.Order, Card: e.Card})
+	case model.TRICK_WON:
+		s.Tricks[e.Team]++
+	case model.ROUND_FINISHED:
+		s.Rounds[e.Team]++
+	case model.PAYOUT:
+		s.Finished = true
+		s.Winner = e.Team
+		if e.PlayerId != "" {
+			s.Payouts[e.PlayerId] += e.Amount
+		}
+	default:
+		return ErrUnknownEvent
+	}
+
+	s.Seq = e.Seq
+	if e.Signature != "" {
+		s.Signature = e.Signature
+	}
+
+	return nil
+}
+
+func needsOrder(t model.TableEventType) bool {
+	switch t {
+	case model.SEAT_TAKEN, model.SEAT_LEFT, model.PLAYER_READY, model.MOVE, model.TRICK_WON:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,88 @@
+package tablelog
+
+import (
+	"testing"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+)
+
+func log(events ...*model.TableEvent) []*model.TableEvent {
+	for i, e := range events {
+		e.Seq = i + 1
+	}
+	return events
+}
+
+func TestReplay(t *testing.T) {
+	events := log(
+		&model.TableEvent{Type: model.SEAT_TAKEN, Order: 1, PlayerId: "a"},
+		&model.TableEvent{Type: model.SEAT_TAKEN, Order: 2, PlayerId: "b"},
+		&model.TableEvent{Type: model.PLAYER_READY, Order: 1, PlayerId: "a"},
+		&model.TableEvent{Type: model.SEAT_LEFT, Order: 2, PlayerId: "b"},
+		&model.TableEvent{Type: model.SEAT_TAKEN, Order: 2, PlayerId: "c"},
+		&model.TableEvent{Type: model.GAME_STARTED},
+		&model.TableEvent{Type: model.CARDS_DEALT, Signature: "dealt"},
+		&model.TableEvent{Type: model.MOVE, Order: 1, PlayerId: "a", Card: "AS", Signature: "moved"},
+		&model.TableEvent{Type: model.TRICK_WON, Order: 1, Team: 1},
+		&model.TableEvent{Type: model.ROUND_FINISHED, Team: 1},
+		&model.TableEvent{Type: model.PAYOUT, Order: 1, PlayerId: "a", Team: 1, Amount: 100},
+		&model.TableEvent{Type: model.PAYOUT, Order: 2, PlayerId: "c", Team: 1, Amount: -100},
+	)
+
+	s, err := Replay(events)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s.Seats[0] != "a" || s.Seats[1] != "c" || !s.Ready[0] || s.Ready[1] {
+		t.Fatalf("unexpected seating: %v %v", s.Seats, s.Ready)
+	}
+
+	if !s.Started || !s.Finished || s.Round != 1 || s.Winner != 1 || s.Seq != 12 {
+		t.Fatalf("unexpected state: %+v", s)
+	}
+
+	if len(s.Moves) != 1 || s.Moves[0] != (Move{Round: 1, Order: 1, Card: "AS"}) {
+		t.Fatalf("unexpected moves: %v", s.Moves)
+	}
+
+	// signature is kept from the last event carrying one
+	if s.Signature != "moved" || s.Tricks[1] != 1 || s.Rounds[1] != 1 {
+		t.Fatalf("unexpected progress: %+v", s)
+	}
+
+	if s.Payouts["a"] != 100 || s.Payouts["c"] != -100 {
+		t.Fatalf("unexpected payouts: %v", s.Payouts)
+	}
+}
+
+func TestReplayInvalid(t *testing.T) {
+	gap := log(
+		&model.TableEvent{Type: model.GAME_STARTED},
+		&model.TableEvent{Type: model.CARDS_DEALT},
+	)
+	gap[1].Seq = 3
+
+	cases := []struct {
+		events []*model.TableEvent
+		err    error
+	}{
+		{gap, ErrSequenceGap},
+		{log(&model.TableEvent{Type: model.MOVE, Card: "AS"}), ErrInvalidOrder},
+		{log(&model.TableEvent{Type: model.SEAT_TAKEN, Order: 5}), ErrInvalidOrder},
+		{log(&model.TableEvent{Type: model.ROUND_FINISHED, Team: 3}), ErrInvalidTeam},
+		{log(&model.TableEvent{Type: "unknown"}), ErrUnknownEvent},
+	}
+
+	for i, c := range cases {
+		s, err := Replay(c.events)
+		if err != c.err {
+			t.Fatalf("case %d: expected %v, got %v", i, c.err, err)
+		}
+
+		// state stops at the last valid event
+		if s.Seq != len(c.events)-1 {
+			t.Fatalf("case %d: unexpected seq %d", i, s.Seq)
+		}
+	}
+}
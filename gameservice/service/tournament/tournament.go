@@ -0,0 +1,171 @@
+package tournament
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+)
+
+// Template describes recurring tournament scheduled by the service.
+type Template struct {
+	Id         string
+	Title      string
+	Format     model.TournamentFormat
+	TeamSize   int
+	Currency   model.Currency
+	EntryFee   uint32
+	MinEntries int
+	MaxEntries int
+	// Rounds of swiss tournament, elimination lasts until winner is found
+	Rounds int
+	// Payouts are shares of prize pool paid by place
+	Payouts []float64
+	// Every is interval between tournaments, registration lasts until start
+	Every time.Duration
+}
+
+func Defaults() []*Template {
+	return []*Template{
+		{
+			Id:         "hourly_cup",
+			Title:      "Hourly cup",
+			Format:     model.ELIMINATION,
+			TeamSize:   1,
+			Currency:   model.NUTS,
+			EntryFee:   100,
+			MinEntries: 4,
+			MaxEntries: 64,
+			Payouts:    []float64{0.35, 0.35, 0.15, 0.15},
+			Every:      time.Hour,
+		},
+		{
+			Id:         "daily_pairs",
+			Title:      "Daily pairs",
+			Format:     model.SWISS,
+			TeamSize:   2,
+			Currency:   model.GOLD,
+			EntryFee:   10,
+			MinEntries: 4,
+			MaxEntries: 32,
+			Rounds:     4,
+			Payouts:    []float64{0.5, 0.3, 0.2},
+			Every:      24 * time.Hour,
+		},
+	}
+}
+
+// Next returns tournament following given time.
+func (t *Template) Next(now time.Time) *model.Tournament {
+	return &model.Tournament{
+		TemplateId: t.Id,
+		StartsAt:   now.Truncate(t.Every).Add(t.Every),
+		Title:      t.Title,
+		Format:     t.Format,
+		State:      model.REGISTRATION,
+		TeamSize:   t.TeamSize,
+		Currency:   t.Currency,
+		EntryFee:   t.EntryFee,
+		MinEntries: t.MinEntries,
+		MaxEntries: t.MaxEntries,
+		Rounds:     t.Rounds,
+		Payouts:    t.Payouts,
+	}
+}
+
+// Pair groups entries still playing into tables of slots entries. Swiss
+// tables are formed from entries with close points, skipping entries
+// which have already met while there are others to take. Entries left
+// without table get a bye.
+func Pair(tournament *model.Tournament, entries []*model.TournamentEntry) (tables [][]*model.TournamentEntry, byes []*model.TournamentEntry) {
+	playing := make([]*model.TournamentEntry, 0, len(entries))
+	for _, e := range entries {
+		if !e.Eliminated {
+			playing = append(playing, e)
+		}
+	}
+
+	sort.SliceStable(playing, func(i, j int) bool {
+		return playing[i].Points > playing[j].Points
+	})
+
+	slots := tournament.Slots()
+	for len(playing) >= slots {
+		table := []*model.TournamentEntry{playing[0]}
+		rest := playing[1:]
+
+		for len(table) < slots {
+			i := fresh(table, rest)
+			table = append(table, rest[i])
+			rest = append(rest[:i:i], rest[i+1:]...)
+		}
+
+		tables = append(tables, table)
+		playing = rest
+	}
+
+	return tables, playing
+}
+
+// fresh returns index of the first candidate who has met nobody seated
+// at the table, or the first candidate if everybody has.
+func fresh(table, candidates []*model.TournamentEntry) int {
+next:
+	for i, c := range candidates {
+		for _, e := range table {
+			if e.Met(c.Id) || c.Met(e.Id) {
+				continue next
+			}
+		}
+
+		return i
+	}
+
+	return 0
+}
+
+// Finished reports whether tournament has no more rounds to play.
+func Finished(tournament *model.Tournament, entries []*model.TournamentEntry) bool {
+	if tournament.Format == model.SWISS {
+		return tournament.Round >= tournament.Rounds
+	}
+
+	playing := 0
+	for _, e := range entries {
+		if !e.Eliminated {
+			playing++
+		}
+	}
+
+	return playing < tournament.Slots()
+}
+
+// Rank orders entries by final place. Entries eliminated later are
+// placed higher, points break ties.
+func Rank(entries []*model.TournamentEntry) []*model.TournamentEntry {
+	ranked := make([]*model.TournamentEntry, len(entries))
+	copy(ranked, entries)
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if a.Eliminated != b.Eliminated {
+			return !a.Eliminated
+		}
+		if a.EliminatedRound != b.EliminatedRound {
+			return a.EliminatedRound > b.EliminatedRound
+		}
+		return a.Points > b.Points
+	})
+
+	return ranked
+}
+
+// Prizes splits prize pool by payouts. Places without payout get nothing.
+func Prizes(pool uint64, payouts []float64, places int) []uint64 {
+	prizes := make([]uint64, places)
+	for i := 0; i < places && i < len(payouts); i++ {
+		prizes[i] = uint64(float64(pool) * payouts[i])
+	}
+
+	return prizes
+}
@@ -0,0 +1,116 @@
+package tournament
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+)
+
+func entries(points ...int) []*model.TournamentEntry {
+	es := make([]*model.TournamentEntry, len(points))
+	for i, p := range points {
+		es[i] = &model.TournamentEntry{Points: p}
+		es[i].Id = string(rune('a' + i))
+	}
+	return es
+}
+
+func TestPair(t *testing.T) {
+	solo := &model.Tournament{TeamSize: 1}
+	es := entries(0, 2, 1, 2, 0)
+
+	tables, byes := Pair(solo, es)
+	if len(tables) != 1 || len(tables[0]) != 4 || len(byes) != 1 {
+		t.Fatalf("unexpected pairing: %d tables, %d byes", len(tables), len(byes))
+	}
+
+	// swiss pairs by points, registration order breaks ties
+	if tables[0][0].Id != "b" || tables[0][1].Id != "d" || byes[0].Id != "e" {
+		t.Fatalf("unexpected order: %s %s bye %s", tables[0][0].Id, tables[0][1].Id, byes[0].Id)
+	}
+
+	teams := &model.Tournament{TeamSize: 2}
+	es[1].Eliminated = true
+	tables, byes = Pair(teams, es)
+	if len(tables) != 2 || len(byes) != 0 {
+		t.Fatalf("unexpected team pairing: %d tables, %d byes", len(tables), len(byes))
+	}
+}
+
+func TestPairAvoidsRematches(t *testing.T) {
+	teams := &model.Tournament{Format: model.SWISS, TeamSize: 2}
+	es := entries(2, 2, 1, 1)
+	es[0].Opponents = []string{"b"}
+	es[1].Opponents = []string{"a"}
+
+	tables, _ := Pair(teams, es)
+	if len(tables) != 2 || tables[0][0].Id != "a" || tables[0][1].Id != "c" || tables[1][0].Id != "b" || tables[1][1].Id != "d" {
+		t.Fatalf("unexpected pairing %v", ids(tables))
+	}
+
+	// entries which have met everybody still play
+	es[0].Opponents = []string{"b", "c", "d"}
+	tables, _ = Pair(teams, es)
+	if len(tables) != 2 || tables[0][0].Id != "a" || tables[0][1].Id != "b" {
+		t.Fatalf("unexpected pairing %v", ids(tables))
+	}
+}
+
+func ids(tables [][]*model.TournamentEntry) []string {
+	ts := make([]string, len(tables))
+	for i, table := range tables {
+		for _, e := range table {
+			ts[i] += e.Id
+		}
+	}
+	return ts
+}
+
+func TestFinished(t *testing.T) {
+	es := entries(1, 1, 0, 0)
+	elimination := &model.Tournament{Format: model.ELIMINATION, TeamSize: 1}
+
+	if Finished(elimination, es) {
+		t.Fatal("four players can still play")
+	}
+
+	es[2].Eliminated, es[3].Eliminated = true, true
+	if !Finished(elimination, es) {
+		t.Fatal("two players can not form a table")
+	}
+
+	swiss := &model.Tournament{Format: model.SWISS, TeamSize: 1, Round: 2, Rounds: 3}
+	if Finished(swiss, es) {
+		t.Fatal("swiss has rounds left")
+	}
+}
+
+func TestRankAndPrizes(t *testing.T) {
+	es := entries(1, 3, 2, 0)
+	es[0].Eliminated, es[0].EliminatedRound = true, 2
+	es[3].Eliminated, es[3].EliminatedRound = true, 1
+
+	ranked := Rank(es)
+	order := ""
+	for _, e := range ranked {
+		order += e.Id
+	}
+	if order != "bcad" {
+		t.Fatalf("unexpected ranking %s", order)
+	}
+
+	prizes := Prizes(1000, []float64{0.5, 0.3, 0.2}, 4)
+	if prizes[0] != 500 || prizes[1] != 300 || prizes[2] != 200 || prizes[3] != 0 {
+		t.Fatalf("unexpected prizes %v", prizes)
+	}
+}
+
+func TestNext(t *testing.T) {
+	tmpl := &Template{Id: "hourly", Every: time.Hour}
+	now := time.Date(2020, 6, 3, 10, 20, 0, 0, time.UTC)
+
+	if next := tmpl.Next(now); !next.StartsAt.Equal(now.Truncate(time.Hour).Add(time.Hour)) {
+		t.Fatalf("unexpected start %v", next.StartsAt)
+	}
+}
@@ -0,0 +1,468 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Handzo/gogame/common/log"
+	"github.com/Handzo/gogame/gameservice/code"
+	pb "github.com/Handzo/gogame/gameservice/proto"
+	"github.com/Handzo/gogame/gameservice/repository/model"
+	"github.com/Handzo/gogame/gameservice/service/pubsub"
+	"github.com/Handzo/gogame/gameservice/service/tournament"
+	"github.com/Handzo/gogame/rmq"
+)
+
+func (g *gameService) GetTournaments(ctx context.Context, req *pb.GetTournamentsRequest) (*pb.GetTournamentsResponse, error) {
+	tournaments, err := g.repo.GetTournaments(ctx, model.REGISTRATION, model.RUNNING)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*pb.Tournament, len(tournaments))
+	for i, t := range tournaments {
+		infos[i] = tournamentInfo(t)
+	}
+
+	return &pb.GetTournamentsResponse{
+		Tournaments: infos,
+	}, nil
+}
+
+func (g *gameService) GetTournament(ctx context.Context, req *pb.GetTournamentRequest) (*pb.GetTournamentResponse, error) {
+	t, err := g.repo.FindTournament(ctx, req.TournamentId)
+	if err != nil {
+		return nil, err
+	}
+
+	if t == nil {
+		return nil, code.TournamentNotFound
+	}
+
+	entries := make([]*pb.TournamentEntry, len(t.Entries))
+	for i, e := range t.Entries {
+		entries[i] = &pb.TournamentEntry{
+			Id:         e.Id,
+			Points:     uint32(e.Points),
+			Eliminated: e.Eliminated,
+			Place:      uint32(e.Place),
+			Prize:      e.Prize,
+			Accepted:   !e.Pending(),
+		}
+
+		if e.Player != nil {
+			entries[i].Player = playerInfo(e.Player, false)
+		}
+
+		if e.Partner != nil {
+			entries[i].Partner = playerInfo(e.Partner, false)
+		}
+	}
+
+	return &pb.GetTournamentResponse{
+		Tournament: tournamentInfo(t),
+		Entries:    entries,
+	}, nil
+}
+
+func (g *gameService) RegisterTournament(ctx context.Context, req *pb.RegisterTournamentRequest) (*pb.RegisterTournamentResponse, error) {
+	playerId := ctx.Value("player_id").(string)
+
+	t, err := g.repo.FindTournament(ctx, req.TournamentId)
+	if err != nil {
+		return nil, err
+	}
+
+	if t == nil {
+		return nil, code.TournamentNotFound
+	}
+
+	// teams register together with a friend
+	if (t.TeamSize == 2) != (req.PartnerId != "") || req.PartnerId == playerId {
+		return nil, code.InvalidPartner
+	}
+
+	if req.PartnerId != "" {
+		friendship, err := g.repo.FindFriendship(ctx, playerId, req.PartnerId)
+		if err != nil {
+			return nil, err
+		}
+
+		if friendship == nil || friendship.State != model.ACCEPTED {
+			return nil, code.InvalidPartner
+		}
+	}
+
+	entry := &model.TournamentEntry{
+		TournamentId: t.Id,
+		PlayerId:     playerId,
+		PartnerId:    req.PartnerId,
+	}
+
+	player, err := g.repo.RegisterTournament(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	// team entry is played only after partner registers back
+	switch {
+	case entry.Pending():
+		g.notify(ctx, entry.PartnerId, &pubsub.Event{
+			Event: "TournamentInvite",
+			Payload: &pubsub.TournamentInvite{
+				TournamentId: t.Id,
+				Title:        t.Title,
+				PlayerId:     playerId,
+			},
+		})
+	case entry.PartnerId != "":
+		g.notify(ctx, entry.PlayerId, &pubsub.Event{
+			Event: "TournamentInviteAccepted",
+			Payload: &pubsub.TournamentInviteAccepted{
+				TournamentId: t.Id,
+				Title:        t.Title,
+				PartnerId:    playerId,
+			},
+		})
+	}
+
+	g.logger.For(ctx).Info("Registered for tournament", log.String("player_id", playerId), log.String("tournament", t.Id), log.Bool("pending", entry.Pending()))
+
+	return &pb.RegisterTournamentResponse{
+		Nuts: player.Nuts,
+		Gold: player.Gold,
+	}, nil
+}
+
+func (g *gameService) UnregisterTournament(ctx context.Context, req *pb.UnregisterTournamentRequest) (*pb.UnregisterTournamentResponse, error) {
+	player, err := g.repo.UnregisterTournament(ctx, req.TournamentId, ctx.Value("player_id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UnregisterTournamentResponse{
+		Nuts: player.Nuts,
+		Gold: player.Gold,
+	}, nil
+}
+
+// planTournaments creates the next tournament of every template
+// and schedules its start.
+func (g *gameService) planTournaments(ctx context.Context, task *rmq.Task) error {
+	now := time.Now()
+	defer g.scheduleTournaments(now)
+
+	for _, tmpl := range g.config.Tournaments {
+		t := tmpl.Next(now)
+
+		created, err := g.repo.CreateTournament(ctx, t)
+		if err != nil {
+			return err
+		}
+
+		if !created {
+			continue
+		}
+
+		g.worker.AddTask(rmq.NewTask(
+			START_TOURNAMENT,
+			t.Id,
+			rmq.WithExecTime(t.StartsAt),
+			rmq.WithId(START_TOURNAMENT+":"+t.Id),
+		))
+
+		g.logger.For(ctx).Info("Tournament scheduled", log.String("tournament", t.Id), log.String("template", tmpl.Id))
+	}
+
+	return nil
+}
+
+// scheduleTournaments adds planning task at the next interval boundary.
+func (g *gameService) scheduleTournaments(now time.Time) {
+	interval := g.config.TournamentSchedule
+	at := now.Truncate(interval).Add(interval)
+
+	g.worker.AddTask(rmq.NewTask(
+		SCHEDULE_TOURNAMENTS,
+		"tournaments",
+		rmq.WithExecTime(at),
+		rmq.WithId(SCHEDULE_TOURNAMENTS+":"+at.UTC().Format(time.RFC3339)),
+	))
+}
+
+// startTournament closes registration. Team entries partner has not
+// accepted are dropped. Tournaments without enough entries are cancelled
+// with fees refunded.
+func (g *gameService) startTournament(ctx context.Context, task *rmq.Task) error {
+	t, err := g.repo.FindTournament(ctx, task.Topic)
+	if err != nil {
+		return err
+	}
+
+	if t == nil || t.State != model.REGISTRATION {
+		return nil
+	}
+
+	dropped, err := g.repo.DropPendingEntries(ctx, t.Id)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range dropped {
+		g.notify(ctx, e.PlayerId, &pubsub.Event{
+			Event: "TournamentEntryDropped",
+			Payload: &pubsub.TournamentEntryDropped{
+				TournamentId: t.Id,
+				Title:        t.Title,
+			},
+		})
+	}
+
+	if len(dropped) != 0 {
+		if t, err = g.repo.FindTournament(ctx, t.Id); err != nil {
+			return err
+		}
+	}
+
+	if len(t.Entries) < t.MinEntries || len(t.Entries) < t.Slots() {
+		if err = g.repo.CancelTournament(ctx, t.Id); err != nil {
+			return err
+		}
+
+		for _, e := range t.Entries {
+			for _, p := range e.Players() {
+				g.notify(ctx, p, &pubsub.Event{
+					Event: "TournamentCancelled",
+					Payload: &pubsub.TournamentCancelled{
+						TournamentId: t.Id,
+						Title:        t.Title,
+					},
+				})
+			}
+		}
+
+		g.logger.For(ctx).Info("Tournament cancelled", log.String("tournament", t.Id), log.Int("entries", len(t.Entries)))
+		return nil
+	}
+
+	t.State = model.RUNNING
+	return g.playTournamentRound(ctx, t)
+}
+
+// nextTournamentRound is scheduled when the last table of a round
+// has finished.
+func (g *gameService) nextTournamentRound(ctx context.Context, task *rmq.Task) error {
+	t, err := g.repo.FindTournament(ctx, task.Topic)
+	if err != nil {
+		return err
+	}
+
+	if t == nil || t.State != model.RUNNING {
+		return nil
+	}
+
+	playing, err := g.repo.CountTournamentTables(ctx, t.Id, t.Round)
+	if err != nil || playing != 0 {
+		return err
+	}
+
+	if !tournament.Finished(t, t.Entries) {
+		return g.playTournamentRound(ctx, t)
+	}
+
+	ranked := tournament.Rank(t.Entries)
+	prizes := tournament.Prizes(t.PrizePool, t.Payouts, len(ranked))
+	for i, e := range ranked {
+		e.Place = i + 1
+		e.Prize = prizes[i]
+	}
+
+	if err = g.repo.FinishTournament(ctx, t.Id, ranked); err != nil {
+		return err
+	}
+
+	for _, e := range ranked {
+		for _, p := range e.Players() {
+			g.notify(ctx, p, &pubsub.Event{
+				Event: "TournamentFinished",
+				Payload: &pubsub.TournamentFinished{
+					TournamentId: t.Id,
+					Title:        t.Title,
+					Place:        e.Place,
+					Prize:        e.Prize / uint64(len(e.Players())),
+					Currency:     string(t.Currency),
+				},
+			})
+		}
+	}
+
+	g.logger.For(ctx).Info("Tournament finished", log.String("tournament", t.Id), log.Int("rounds", t.Round))
+
+	return nil
+}
+
+// playTournamentRound pairs entries and creates tables of the next round.
+// Entries left without table get a bye counted as a win. Round, entries
+// and tables are saved at once, so round is never left half-created.
+func (g *gameService) playTournamentRound(ctx context.Context, t *model.Tournament) error {
+	pairs, byes := tournament.Pair(t, t.Entries)
+
+	changed := []*model.TournamentEntry{}
+	for _, e := range byes {
+		e.Points++
+		changed = append(changed, e)
+	}
+
+	t.Round++
+	tables := make([]*model.Table, len(pairs))
+	for i, entries := range pairs {
+		tables[i] = tournamentTable(t, entries)
+		changed = append(changed, entries...)
+	}
+
+	started, err := g.repo.StartTournamentRound(ctx, t, changed, tables)
+	if err != nil {
+		return err
+	}
+
+	// round has been started by another instance
+	if !started {
+		return nil
+	}
+
+	for _, table := range tables {
+		g.tournamentTableReady(ctx, t, table)
+	}
+
+	g.logger.For(ctx).Info("Tournament round started",
+		log.String("tournament", t.Id),
+		log.Int("round", t.Round),
+		log.Int("tables", len(tables)),
+	)
+
+	return nil
+}
+
+// tournamentTable seats entries at table starting the game. Individual
+// entries sit in registration order, so the first and the third make
+// a team. Team entries take opposite seats.
+func tournamentTable(t *model.Tournament, entries []*model.TournamentEntry) *model.Table {
+	seating := make([]string, 4)
+	for i, e := range entries {
+		if t.TeamSize == 2 {
+			seating[i], seating[i+2] = e.PlayerId, e.PartnerId
+		} else {
+			seating[i] = e.PlayerId
+		}
+	}
+
+	// swiss pairing avoids entries which have already met
+	for _, e := range entries {
+		for _, o := range entries {
+			if o != e && !e.Met(o.Id) {
+				e.Opponents = append(e.Opponents, o.Id)
+			}
+		}
+	}
+
+	table := &model.Table{
+		Currency:        t.Currency,
+		Variant:         model.CLASSIC,
+		Private:         true,
+		State:           model.STARTING,
+		CreatorId:       seating[0],
+		TournamentId:    t.Id,
+		TournamentRound: t.Round,
+	}
+
+	for i, playerId := range seating {
+		p := &model.Participant{Order: i + 1, PlayerId: playerId, State: model.READY}
+		table.Participants = append(table.Participants, p)
+
+		table.Events = append(table.Events,
+			&model.TableEvent{Type: model.SEAT_TAKEN, Order: p.Order, PlayerId: playerId},
+			&model.TableEvent{Type: model.PLAYER_READY, Order: p.Order, PlayerId: playerId},
+		)
+	}
+
+	return table
+}
+
+// tournamentTableReady brings players to created table and schedules
+// the game start.
+func (g *gameService) tournamentTableReady(ctx context.Context, t *model.Tournament, table *model.Table) {
+	for _, p := range table.Participants {
+		g.pubsub.AddToRoom(ctx, table.Id, p.PlayerId)
+		g.notify(ctx, p.PlayerId, &pubsub.Event{
+			Event: "TournamentTableReady",
+			Payload: &pubsub.TournamentTableReady{
+				TournamentId: t.Id,
+				TableId:      table.Id,
+				Round:        t.Round,
+			},
+		})
+	}
+
+	g.worker.AddTask(rmq.NewTask(START_GAME, table.Id, rmq.WithDelay(5*time.Second)))
+}
+
+// tournamentTableFinished advances entries of finished tournament table
+// and schedules next round once every table of the round has finished.
+// Zero winner means table has been abandoned and nobody has won it.
+func (g *gameService) tournamentTableFinished(ctx context.Context, table *model.Table, winner int) error {
+	t, err := g.repo.FindTournament(ctx, table.TournamentId)
+	if err != nil || t == nil {
+		return err
+	}
+
+	for _, e := range t.Entries {
+		p := seatOf(table, e.PlayerId)
+		if p == nil {
+			continue
+		}
+
+		if team(p.Order) == winner {
+			e.Points++
+		} else if t.Format == model.ELIMINATION {
+			e.Eliminated = true
+			e.EliminatedRound = table.TournamentRound
+		}
+
+		if err = g.repo.Update(ctx, e, "points", "eliminated", "eliminated_round"); err != nil {
+			return err
+		}
+	}
+
+	playing, err := g.repo.CountTournamentTables(ctx, t.Id, table.TournamentRound)
+	if err != nil || playing != 0 {
+		return err
+	}
+
+	g.worker.AddTask(rmq.NewTask(
+		TOURNAMENT_ROUND,
+		t.Id,
+		rmq.WithDelay(5*time.Second),
+		rmq.WithId(fmt.Sprintf("%s:%s:%d", TOURNAMENT_ROUND, t.Id, table.TournamentRound)),
+	))
+
+	return nil
+}
+
+func tournamentInfo(t *model.Tournament) *pb.Tournament {
+	return &pb.Tournament{
+		Id:         t.Id,
+		Title:      t.Title,
+		Format:     string(t.Format),
+		State:      string(t.State),
+		TeamSize:   uint32(t.TeamSize),
+		Currency:   string(t.Currency),
+		EntryFee:   t.EntryFee,
+		PrizePool:  t.PrizePool,
+		Entries:    uint32(len(t.Entries)),
+		MaxEntries: uint32(t.MaxEntries),
+		StartsAt:   t.StartsAt.Unix(),
+		Round:      uint32(t.Round),
+		Rounds:     uint32(t.Rounds),
+	}
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/Handzo/gogame/gameservice/repository/model"
+)
+
+func TestTournamentTable(t *testing.T) {
+	tournament := &model.Tournament{TeamSize: 2, Currency: model.GOLD, Round: 2}
+	tournament.Id = "tr"
+
+	a := &model.TournamentEntry{PlayerId: "a1", PartnerId: "a2"}
+	a.Id = "a"
+	b := &model.TournamentEntry{PlayerId: "b1", PartnerId: "b2", Opponents: []string{"a"}}
+	b.Id = "b"
+
+	table := tournamentTable(tournament, []*model.TournamentEntry{a, b})
+
+	if table.State != model.STARTING || table.TournamentId != "tr" || table.TournamentRound != 2 || table.CreatorId != "a1" {
+		t.Fatalf("unexpected table %+v", table)
+	}
+
+	// team entries take opposite seats
+	for i, expected := range []string{"a1", "b1", "a2", "b2"} {
+		p := table.Participants[i]
+		if p.Order != i+1 || p.PlayerId != expected || p.State != model.READY {
+			t.Fatalf("seat %d: unexpected participant %+v", i+1, p)
+		}
+	}
+
+	if len(table.Events) != 8 {
+		t.Fatalf("expected seat and ready events, got %d", len(table.Events))
+	}
+
+	if !a.Met("b") || len(b.Opponents) != 1 {
+		t.Fatalf("expected opponents recorded once, got %v and %v", a.Opponents, b.Opponents)
+	}
+}
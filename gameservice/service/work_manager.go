@@ -3,35 +3,263 @@ package service
 import (
 	"context"
 	"errors"
+	"sync"
+	"time"
 
 	"github.com/Handzo/gogame/common/log"
 	"github.com/Handzo/gogame/rmq"
 	"github.com/opentracing/opentracing-go"
 )
 
+// WorkManager runs tasks of different topics in parallel, while tasks
+// of the same topic run one by one in order they were received. Tasks
+// are queued per topic in redis and popped only by the instance holding
+// topic lease, so the order is kept across instances too.
 type WorkManager struct {
 	worker   *rmq.Worker
+	topics   topicQueue
 	tracer   opentracing.Tracer
 	logger   log.Factory
 	handlers map[string]taskHandler
+	// LeaseTTL is renewed while tasks of topic run
+	LeaseTTL time.Duration
+	// LeaseRetry is the first backoff while topic is leased by another
+	// instance, it doubles up to LeaseTTL on every try
+	LeaseRetry time.Duration
+	// LeaseWait bounds waiting for the lease. Tasks queued meanwhile are
+	// run by the lease holder.
+	LeaseWait time.Duration
+
+	mu      sync.Mutex
+	serving map[string]bool
 }
 
 type taskHandler func(context.Context, *rmq.Task) error
 
+// topicQueue is shared queue of tasks by topic with exclusive lease
+// on topic.
+type topicQueue interface {
+	PushTopic(*rmq.Task) error
+	PopTopic(string) (*rmq.Task, error)
+	TopicLen(string) (int64, error)
+	Topics() ([]string, error)
+	AcquireLease(string, time.Duration) (topicLease, error)
+}
+
+type topicLease interface {
+	Renew(time.Duration) (bool, error)
+	Release() error
+}
+
+// rmqTopics adapts rmq worker to topicQueue.
+type rmqTopics struct {
+	*rmq.Worker
+}
+
+func (t rmqTopics) AcquireLease(topic string, ttl time.Duration) (topicLease, error) {
+	lease, err := t.Worker.AcquireLease(topic, ttl)
+	if err != nil || lease == nil {
+		return nil, err
+	}
+
+	return lease, nil
+}
+
 func NewWorkManager(worker *rmq.Worker, tracer opentracing.Tracer, logger log.Factory) *WorkManager {
 	return &WorkManager{
-		worker:   worker,
-		tracer:   tracer,
-		logger:   logger,
-		handlers: make(map[string]taskHandler),
+		worker:     worker,
+		topics:     rmqTopics{worker},
+		tracer:     tracer,
+		logger:     logger,
+		handlers:   make(map[string]taskHandler),
+		LeaseTTL:   30 * time.Second,
+		LeaseRetry: 50 * time.Millisecond,
+		LeaseWait:  30 * time.Second,
+		serving:    make(map[string]bool),
 	}
 }
 
 func (w *WorkManager) Start() {
 	w.worker.Start()
+	w.resume()
 
 	for task := range w.worker.Channel() {
-		w.process(task)
+		w.dispatch(task)
+	}
+}
+
+// resume serves topics with tasks left queued, e.g. by instance which
+// has stopped in the middle of the queue.
+func (w *WorkManager) resume() {
+	topics, err := w.topics.Topics()
+	if err != nil {
+		w.logger.Bg().Error(err)
+		return
+	}
+
+	for _, topic := range topics {
+		w.startServing(topic)
+	}
+}
+
+// dispatch queues task after pending tasks of its topic. Each topic
+// with pending tasks is served by one goroutine of the instance. Task
+// which can't be queued is returned to rmq to be received again.
+func (w *WorkManager) dispatch(task *rmq.Task) {
+	if err := w.topics.PushTopic(task); err != nil {
+		w.logger.Bg().Error(err)
+
+		if err = w.AddTask(task); err != nil {
+			w.logger.Bg().Error(err)
+		}
+		return
+	}
+
+	w.startServing(task.Topic)
+}
+
+func (w *WorkManager) startServing(topic string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.serving[topic] {
+		w.serving[topic] = true
+		go w.serve(topic)
+	}
+}
+
+// serve runs queued tasks of topic holding its lease until there are
+// none left. Queue is checked once more after release, so task pushed
+// while the lease was still held is not left behind. Lease is waited
+// for again while tasks are queued, as its holder may have stopped.
+func (w *WorkManager) serve(topic string) {
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), w.LeaseWait)
+		lease := w.lease(ctx, topic)
+		cancel()
+
+		if lease != nil {
+			w.drain(topic, lease)
+
+			if err := lease.Release(); err != nil {
+				w.logger.Bg().Error(err)
+			}
+		}
+
+		if w.idle(topic) {
+			return
+		}
+	}
+}
+
+// idle stops serving topic if its queue is empty. Topic is kept served
+// if its queue can't be checked.
+func (w *WorkManager) idle(topic string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	n, err := w.topics.TopicLen(topic)
+	if err != nil {
+		w.logger.Bg().Error(err)
+		return false
+	}
+
+	if n == 0 {
+		delete(w.serving, topic)
+		return true
+	}
+
+	return false
+}
+
+// drain pops and runs tasks of topic while lease is kept.
+func (w *WorkManager) drain(topic string, lease topicLease) {
+	for {
+		task, err := w.topics.PopTopic(topic)
+		if err != nil {
+			w.logger.Bg().Error(err)
+			return
+		}
+
+		if task == nil {
+			return
+		}
+
+		if !w.run(task, lease) {
+			return
+		}
+	}
+}
+
+// run processes task renewing lease meanwhile. Context of the task is
+// cancelled and false is returned if lease has been lost, so the rest of
+// the queue is left to new holder.
+func (w *WorkManager) run(task *rmq.Task, lease topicLease) bool {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan struct{})
+	lost := make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(w.LeaseTTL / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				ok, err := lease.Renew(w.LeaseTTL)
+				if err != nil {
+					w.logger.Bg().Error(err)
+					continue
+				}
+
+				if !ok {
+					w.logger.Bg().Warn("Topic lease lost", log.String("topic", task.Topic))
+					close(lost)
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	w.process(ctx, task)
+	close(done)
+
+	select {
+	case <-lost:
+		return false
+	default:
+		return true
+	}
+}
+
+// lease waits with backoff until topic is released by other instance.
+// Nil lease is returned if it is not acquired until ctx is done.
+func (w *WorkManager) lease(ctx context.Context, topic string) topicLease {
+	backoff := w.LeaseRetry
+	for {
+		lease, err := w.topics.AcquireLease(topic, w.LeaseTTL)
+		if err != nil {
+			w.logger.Bg().Error(err)
+		}
+
+		if lease != nil {
+			return lease
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff):
+		}
+
+		if backoff *= 2; backoff > w.LeaseTTL {
+			backoff = w.LeaseTTL
+		}
 	}
 }
 
@@ -39,8 +267,8 @@ func (w *WorkManager) AddTask(task *rmq.Task) error {
 	return w.worker.AddTask(task)
 }
 
-func (w *WorkManager) process(task *rmq.Task) {
-	span, ctx, logger := w.logger.StartForWithTracer(context.Background(), w.tracer, "Worker/"+task.Callback)
+func (w *WorkManager) process(ctx context.Context, task *rmq.Task) {
+	span, ctx, logger := w.logger.StartForWithTracer(ctx, w.tracer, "Worker/"+task.Callback)
 	defer span.Finish()
 
 	logger.Info("New task received", log.String("task", task.Callback), log.String("topic", task.Topic))
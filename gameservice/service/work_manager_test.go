@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"io/ioutil"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Handzo/gogame/common/log"
+	"github.com/Handzo/gogame/rmq"
+	"github.com/opentracing/opentracing-go"
+)
+
+// memTopics is topicQueue shared by work managers standing for
+// different instances.
+type memTopics struct {
+	mu     sync.Mutex
+	queues map[string][]*rmq.Task
+	leases map[string]*memLease
+	renews int32
+}
+
+type memLease struct {
+	topics *memTopics
+	topic  string
+}
+
+func newMemTopics() *memTopics {
+	return &memTopics{
+		queues: make(map[string][]*rmq.Task),
+		leases: make(map[string]*memLease),
+	}
+}
+
+func (m *memTopics) PushTopic(task *rmq.Task) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.queues[task.Topic] = append(m.queues[task.Topic], task)
+	return nil
+}
+
+func (m *memTopics) PopTopic(topic string) (*rmq.Task, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	queue := m.queues[topic]
+	if len(queue) == 0 {
+		return nil, nil
+	}
+
+	m.queues[topic] = queue[1:]
+	return queue[0], nil
+}
+
+func (m *memTopics) TopicLen(topic string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return int64(len(m.queues[topic])), nil
+}
+
+func (m *memTopics) Topics() ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	topics := []string{}
+	for topic, queue := range m.queues {
+		if len(queue) != 0 {
+			topics = append(topics, topic)
+		}
+	}
+	return topics, nil
+}
+
+func (m *memTopics) AcquireLease(topic string, ttl time.Duration) (topicLease, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.leases[topic] != nil {
+		return nil, nil
+	}
+
+	lease := &memLease{topics: m, topic: topic}
+	m.leases[topic] = lease
+	return lease, nil
+}
+
+func (l *memLease) Renew(time.Duration) (bool, error) {
+	l.topics.mu.Lock()
+	defer l.topics.mu.Unlock()
+
+	atomic.AddInt32(&l.topics.renews, 1)
+	return l.topics.leases[l.topic] == l, nil
+}
+
+func (l *memLease) Release() error {
+	l.topics.mu.Lock()
+	defer l.topics.mu.Unlock()
+
+	if l.topics.leases[l.topic] == l {
+		delete(l.topics.leases, l.topic)
+	}
+	return nil
+}
+
+func testWorkManager(topics topicQueue) *WorkManager {
+	entry := log.NewEntry()
+	entry.Logger.Out = ioutil.Discard
+
+	return &WorkManager{
+		topics:     topics,
+		tracer:     opentracing.NoopTracer{},
+		logger:     log.NewFactory(entry),
+		handlers:   make(map[string]taskHandler),
+		LeaseTTL:   30 * time.Millisecond,
+		LeaseRetry: time.Millisecond,
+		LeaseWait:  time.Second,
+		serving:    make(map[string]bool),
+	}
+}
+
+func TestWorkManagerKeepsTopicOrderAcrossInstances(t *testing.T) {
+	topics := newMemTopics()
+	instances := []*WorkManager{testWorkManager(topics), testWorkManager(topics)}
+
+	const count = 40
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		running = map[string]int{}
+		order   = map[string][]string{}
+		overlap int32
+	)
+
+	for _, w := range instances {
+		w.Register("test", func(ctx context.Context, task *rmq.Task) error {
+			defer wg.Done()
+
+			mu.Lock()
+			running[task.Topic]++
+			if running[task.Topic] > 1 {
+				atomic.AddInt32(&overlap, 1)
+			}
+			mu.Unlock()
+
+			time.Sleep(time.Millisecond)
+
+			mu.Lock()
+			running[task.Topic]--
+			order[task.Topic] = append(order[task.Topic], task.Payload)
+			mu.Unlock()
+			return nil
+		})
+	}
+
+	wg.Add(count)
+	for i := 0; i < count; i++ {
+		topic := "t" + strconv.Itoa(i%2)
+		task := rmq.NewTask("test", topic, rmq.WithPayload(strconv.Itoa(i)))
+		// tasks of one topic reach both instances
+		instances[i%4/2].dispatch(task)
+	}
+	wg.Wait()
+
+	if overlap != 0 {
+		t.Fatalf("tasks of the same topic overlapped %d times", overlap)
+	}
+
+	for topic, payloads := range order {
+		for i, p := range payloads {
+			expected := strconv.Itoa(i*2 + int(topic[1]-'0'))
+			if p != expected {
+				t.Fatalf("topic %s: expected task %s at %d, got %s", topic, expected, i, p)
+			}
+		}
+	}
+}
+
+func TestWorkManagerRenewsLease(t *testing.T) {
+	topics := newMemTopics()
+	w := testWorkManager(topics)
+
+	done := make(chan struct{})
+	w.Register("slow", func(ctx context.Context, task *rmq.Task) error {
+		time.Sleep(5 * w.LeaseTTL)
+		close(done)
+		return nil
+	})
+
+	w.dispatch(rmq.NewTask("slow", "t"))
+	<-done
+
+	if atomic.LoadInt32(&topics.renews) < 3 {
+		t.Fatal("expected lease to be renewed while task runs")
+	}
+}
+
+func TestWorkManagerStopsOnLostLease(t *testing.T) {
+	topics := newMemTopics()
+	w := testWorkManager(topics)
+	w.LeaseWait = 10 * time.Millisecond
+
+	ran := make(chan bool, 2)
+	w.Register("slow", func(ctx context.Context, task *rmq.Task) error {
+		// another instance takes over expired lease
+		topics.mu.Lock()
+		topics.leases[task.Topic] = &memLease{topics: topics, topic: task.Topic}
+		topics.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			ran <- true
+		case <-time.After(time.Second):
+			ran <- false
+		}
+		return nil
+	})
+
+	topics.PushTopic(rmq.NewTask("slow", "t"))
+	w.dispatch(rmq.NewTask("slow", "t"))
+
+	if cancelled := <-ran; !cancelled {
+		t.Fatal("expected task to be cancelled on lost lease")
+	}
+	time.Sleep(2 * w.LeaseWait)
+
+	if n, _ := topics.TopicLen("t"); n != 1 || len(ran) != 0 {
+		t.Fatalf("expected the rest of topic to be left to new holder, %d queued", n)
+	}
+}
+
+func TestWorkManagerWaitsForLeaseWhileQueued(t *testing.T) {
+	topics := newMemTopics()
+	w := testWorkManager(topics)
+	w.LeaseWait = 10 * time.Millisecond
+
+	done := make(chan struct{})
+	w.Register("task", func(ctx context.Context, task *rmq.Task) error {
+		close(done)
+		return nil
+	})
+
+	// holder stops without serving the queue
+	held, _ := topics.AcquireLease("t", w.LeaseTTL)
+	w.dispatch(rmq.NewTask("task", "t"))
+
+	time.Sleep(3 * w.LeaseWait)
+	held.Release()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected queued task to be run once lease is free")
+	}
+}
+
+func TestWorkManagerResumesQueuedTopics(t *testing.T) {
+	topics := newMemTopics()
+	w := testWorkManager(topics)
+
+	done := make(chan struct{})
+	w.Register("task", func(ctx context.Context, task *rmq.Task) error {
+		close(done)
+		return nil
+	})
+
+	// left queued by stopped instance
+	topics.PushTopic(rmq.NewTask("task", "t"))
+	w.resume()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected queued topic to be resumed")
+	}
+}
+
+func TestWorkManagerLeaseWaitIsBounded(t *testing.T) {
+	topics := newMemTopics()
+	w := testWorkManager(topics)
+
+	held, _ := topics.AcquireLease("t", w.LeaseTTL)
+	defer held.Release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if lease := w.lease(ctx, "t"); lease != nil {
+		t.Fatal("expected no lease")
+	}
+
+	if elapsed := time.Since(start); elapsed > 20*time.Millisecond+w.LeaseTTL {
+		t.Fatalf("lease wait took %s", elapsed)
+	}
+}
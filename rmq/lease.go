@@ -0,0 +1,63 @@
+package rmq
+
+import (
+	"time"
+
+	"github.com/go-redis/redis"
+	uuid "github.com/satori/go.uuid"
+)
+
+const LeasePrefix = "rmq_lease:"
+
+// releaseScript deletes lease only if it is still held by the same owner,
+// so expired lease taken over by another worker is kept.
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// renewScript prolongs lease only if it is still held by the same owner.
+var renewScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// Lease is an exclusive right to process tasks of a topic. It expires
+// after its ttl in case the holder dies without releasing it.
+type Lease struct {
+	redis *redis.Client
+	key   string
+	owner string
+}
+
+// AcquireLease takes lease on topic. It returns nil lease if topic is
+// leased by someone else.
+func (w *Worker) AcquireLease(topic string, ttl time.Duration) (*Lease, error) {
+	lease := &Lease{
+		redis: w.redis,
+		key:   LeasePrefix + topic,
+		owner: uuid.Must(uuid.NewV4()).String(),
+	}
+
+	ok, err := w.redis.SetNX(lease.key, lease.owner, ttl).Result()
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return lease, nil
+}
+
+// Renew prolongs lease by ttl. False is returned if lease has expired
+// and may be held by someone else.
+func (l *Lease) Renew(ttl time.Duration) (bool, error) {
+	n, err := renewScript.Run(l.redis, []string{l.key}, l.owner, ttl.Milliseconds()).Int()
+	return n == 1, err
+}
+
+func (l *Lease) Release() error {
+	return releaseScript.Run(l.redis, []string{l.key}, l.owner).Err()
+}
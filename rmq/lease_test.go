@@ -0,0 +1,128 @@
+package rmq
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	uuid "github.com/satori/go.uuid"
+)
+
+func testWorker(t *testing.T) *Worker {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	if err := client.Ping().Err(); err != nil {
+		t.Skip("redis is not available:", err)
+	}
+
+	return &Worker{redis: client}
+}
+
+func TestLease(t *testing.T) {
+	w := testWorker(t)
+	topic := "test:" + uuid.Must(uuid.NewV4()).String()
+
+	lease, err := w.AcquireLease(topic, time.Second)
+	if err != nil || lease == nil {
+		t.Fatalf("expected lease, got %v", err)
+	}
+
+	if other, err := w.AcquireLease(topic, time.Second); err != nil || other != nil {
+		t.Fatalf("expected topic to be leased, got %v", err)
+	}
+
+	if ok, err := lease.Renew(time.Minute); err != nil || !ok {
+		t.Fatalf("expected lease to be renewed, got %v", err)
+	}
+
+	if ttl := w.redis.PTTL(LeasePrefix + topic).Val(); ttl <= time.Second {
+		t.Fatalf("expected renewed ttl, got %s", ttl)
+	}
+
+	if err = lease.Release(); err != nil {
+		t.Fatal(err)
+	}
+
+	other, err := w.AcquireLease(topic, time.Second)
+	if err != nil || other == nil {
+		t.Fatalf("expected released topic to be leased, got %v", err)
+	}
+	defer other.Release()
+
+	// lease taken over by someone else is neither renewed nor released
+	if ok, err := lease.Renew(time.Minute); err != nil || ok {
+		t.Fatalf("expected lost lease not to be renewed, got %v", err)
+	}
+
+	if err = lease.Release(); err != nil {
+		t.Fatal(err)
+	}
+
+	if owner := w.redis.Get(LeasePrefix + topic).Val(); owner != other.owner {
+		t.Fatalf("expected lease to be kept by %s, got %s", other.owner, owner)
+	}
+}
+
+func TestTopicQueue(t *testing.T) {
+	w := testWorker(t)
+	topic := "test:" + uuid.Must(uuid.NewV4()).String()
+
+	for _, callback := range []string{"a", "b", "c"} {
+		if err := w.PushTopic(NewTask(callback, topic)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n, err := w.TopicLen(topic); err != nil || n != 3 {
+		t.Fatalf("expected 3 queued tasks, got %d %v", n, err)
+	}
+
+	order := ""
+	for {
+		task, err := w.PopTopic(topic)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if task == nil {
+			break
+		}
+
+		order += task.Callback
+	}
+
+	if order != "abc" {
+		t.Fatalf("unexpected order %s", order)
+	}
+}
+
+func TestTopics(t *testing.T) {
+	w := testWorker(t)
+	topic := "test:" + uuid.Must(uuid.NewV4()).String()
+
+	if err := w.PushTopic(NewTask("a", topic)); err != nil {
+		t.Fatal(err)
+	}
+
+	found := func() bool {
+		topics, err := w.Topics()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, tp := range topics {
+			if tp == topic {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !found() {
+		t.Fatal("expected queued topic to be found")
+	}
+
+	w.PopTopic(topic)
+	if found() {
+		t.Fatal("expected emptied topic not to be found")
+	}
+}
@@ -22,6 +22,7 @@ const (
 )
 
 type option struct {
+	Id          string
 	ExecuteTime time.Time
 	Payload     string
 }
@@ -37,9 +38,12 @@ func NewTask(callback, topic string, opts ...TaskOption) *Task {
 		opt(defaultOpt)
 	}
 
-	id := uuid.Must(uuid.NewV4())
+	if defaultOpt.Id == "" {
+		defaultOpt.Id = uuid.Must(uuid.NewV4()).String()
+	}
+
 	return &Task{
-		Id:          id.String(),
+		Id:          defaultOpt.Id,
 		Topic:       topic,
 		Callback:    callback,
 		Payload:     defaultOpt.Payload,
@@ -65,3 +69,11 @@ func WithPayload(payload string) TaskOption {
 		o.Payload = payload
 	}
 }
+
+// WithId sets task id. Adding task with the same id replaces
+// scheduled one, so it may be used for singleton tasks.
+func WithId(id string) TaskOption {
+	return func(o *option) {
+		o.Id = id
+	}
+}
@@ -0,0 +1,63 @@
+package rmq
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/go-redis/redis"
+)
+
+const TopicPrefix = "rmq_topic:"
+
+// PushTopic appends task to queue of its topic shared by all workers,
+// so tasks of the topic are popped in the same order on every instance.
+func (w *Worker) PushTopic(task *Task) error {
+	data, err := json.Marshal(task)
+	if err != nil {
+		return err
+	}
+
+	return w.redis.RPush(TopicPrefix+task.Topic, data).Err()
+}
+
+// PopTopic removes the first task of topic queue. It returns nil task
+// if queue is empty.
+func (w *Worker) PopTopic(topic string) (*Task, error) {
+	data, err := w.redis.LPop(TopicPrefix + topic).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var task Task
+	return &task, json.Unmarshal([]byte(data), &task)
+}
+
+// TopicLen returns number of tasks waiting in topic queue.
+func (w *Worker) TopicLen(topic string) (int64, error) {
+	return w.redis.LLen(TopicPrefix + topic).Result()
+}
+
+// Topics returns topics with tasks waiting in their queues. Emptied
+// queues are removed by redis, so every queue found has tasks.
+func (w *Worker) Topics() ([]string, error) {
+	topics := []string{}
+
+	var cursor uint64
+	for {
+		keys, next, err := w.redis.Scan(cursor, TopicPrefix+"*", 100).Result()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, key := range keys {
+			topics = append(topics, strings.TrimPrefix(key, TopicPrefix))
+		}
+
+		if cursor = next; cursor == 0 {
+			return topics, nil
+		}
+	}
+}